@@ -15,9 +15,15 @@
 package api
 
 import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/OpenIMSDK/chat/pkg/common/apicall"
 	"github.com/OpenIMSDK/chat/pkg/common/apistruct"
-	"github.com/OpenIMSDK/chat/pkg/common/config"
+	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
@@ -32,6 +38,7 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func NewAdmin(chatConn, adminConn grpc.ClientConnInterface) *AdminApi {
@@ -58,12 +65,60 @@ func (o *AdminApi) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, err) // 参数校验失败
 		return
 	}
-	loginResp, err := o.adminClient.Login(c, &req)
+	ip, err := getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	var trailer metadata.MD
+	loginResp, err := o.adminClient.Login(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	if loginResp.ChallengeToken != "" {
+		resp.AdminAccount = loginResp.AdminAccount
+		resp.AdminUserID = loginResp.AdminUserID
+		resp.ChallengeToken = loginResp.ChallengeToken
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	o.adminLoginSuccess(c, loginResp)
+}
+
+func (o *AdminApi) AdminVerifyTOTPLogin(c *gin.Context) {
+	var req admin.VerifyTOTPLoginReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	var trailer metadata.MD
+	loginResp, err := o.adminClient.VerifyTOTPLogin(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	o.adminLoginSuccess(c, loginResp)
+}
+
+func (o *AdminApi) adminLoginSuccess(c *gin.Context, loginResp *admin.LoginResp) {
+	var resp apistruct.AdminLoginResp
+	imAdminUserID, err := getIMAdmin(c, o.adminClient, loginResp.AdminUserID)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	imAdminUserID := config.GetIMAdmin(loginResp.AdminUserID)
 	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -80,10 +135,93 @@ func (o *AdminApi) AdminLogin(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *AdminApi) AdminRefreshToken(c *gin.Context) {
+	var (
+		req  admin.RefreshTokenReq
+		resp apistruct.AdminRefreshTokenResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UserType = constant2.AdminUser
+	resp1, err := o.adminClient.RefreshToken(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imAdminUserID, err := getIMAdmin(c, o.adminClient, resp1.UserID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp.AdminToken = resp1.Token
+	resp.RefreshToken = resp1.RefreshToken
+	resp.AdminUserID = resp1.UserID
+	resp.ImUserID = imAdminUserID
+	resp.ImToken = imToken
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *AdminApi) AdminLogout(c *gin.Context) {
+	req := &admin.InvalidateTokenReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token")}
+	if _, err := o.adminClient.InvalidateToken(c, req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *AdminApi) AdminLogoutAll(c *gin.Context) {
+	userID := mctx.GetOpUserID(c)
+	if _, err := o.adminClient.InvalidateToken(c, &admin.InvalidateTokenReq{UserID: userID}); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imAdminUserID, err := getIMAdmin(c, o.adminClient, userID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), imAdminUserID); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
 func (o *AdminApi) ResetUserPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ChangePassword, o.chatClient, c)
 }
 
+func (o *AdminApi) DisableUserTOTP(c *gin.Context) {
+	a2r.Call(chat.ChatClient.DisableTOTP, o.chatClient, c)
+}
+
+func (o *AdminApi) UnlockUserLogin(c *gin.Context) {
+	a2r.Call(chat.ChatClient.UnlockUserLogin, o.chatClient, c)
+}
+
 func (o *AdminApi) AdminUpdateInfo(c *gin.Context) {
 	var req admin.AdminUpdateInfoReq
 	if err := c.BindJSON(&req); err != nil {
@@ -100,7 +238,11 @@ func (o *AdminApi) AdminUpdateInfo(c *gin.Context) {
 		return
 	}
 	apiresp.GinSuccess(c, nil)
-	imAdminUserID := config.GetIMAdmin(resp.UserID)
+	imAdminUserID, err := getIMAdmin(c, o.adminClient, resp.UserID)
+	if err != nil {
+		log.ZError(c, "AdminUpdateInfo GetIMAdmin", err)
+		return
+	}
 	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
 	if err != nil {
 		log.ZError(c, "AdminUpdateInfo ImAdminTokenWithDefaultAdmin", err)
@@ -115,6 +257,18 @@ func (o *AdminApi) AdminInfo(c *gin.Context) {
 	a2r.Call(admin.AdminClient.GetAdminInfo, o.adminClient, c)
 }
 
+func (o *AdminApi) EnrollTOTP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.EnrollTOTP, o.adminClient, c)
+}
+
+func (o *AdminApi) ConfirmTOTP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.ConfirmTOTP, o.adminClient, c)
+}
+
+func (o *AdminApi) DisableTOTP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DisableTOTP, o.adminClient, c)
+}
+
 func (o *AdminApi) AddDefaultFriend(c *gin.Context) {
 	a2r.Call(admin.AdminClient.AddDefaultFriend, o.adminClient, c)
 }
@@ -141,7 +295,7 @@ func (o *AdminApi) AddDefaultGroup(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+	imToken, err := o.imAdminToken(c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -193,7 +347,7 @@ func (o *AdminApi) SearchDefaultGroup(c *gin.Context) {
 		Groups: make([]*sdkws.GroupInfo, 0, len(searchResp.GroupIDs)),
 	}
 	if len(searchResp.GroupIDs) > 0 {
-		imToken, err := o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+		imToken, err := o.imAdminToken(c)
 		if err != nil {
 			apiresp.GinError(c, err)
 			return
@@ -280,7 +434,7 @@ func (o *AdminApi) BlockUser(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imToken, err := o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+	imToken, err := o.imAdminToken(c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -301,6 +455,10 @@ func (o *AdminApi) SearchBlockUser(c *gin.Context) {
 	a2r.Call(admin.AdminClient.SearchBlockUser, o.adminClient, c)
 }
 
+func (o *AdminApi) SearchBlockHistory(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchBlockHistory, o.adminClient, c)
+}
+
 func (o *AdminApi) SetClientConfig(c *gin.Context) {
 	a2r.Call(admin.AdminClient.SetClientConfig, o.adminClient, c)
 }
@@ -345,7 +503,7 @@ func (o *AdminApi) NewUserCount(c *gin.Context) {
 		apiresp.GinError(c, err) // 参数校验失败
 		return
 	}
-	imToken, err := o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+	imToken, err := o.imAdminToken(c)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -360,6 +518,10 @@ func (o *AdminApi) NewUserCount(c *gin.Context) {
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *AdminApi) SearchVerifyCode(c *gin.Context) {
+	a2r.Call(chat.ChatClient.SearchVerifyCode, o.chatClient, c)
+}
+
 func (o *AdminApi) SearchLogs(c *gin.Context) {
 	a2r.Call(chat.ChatClient.SearchLogs, o.chatClient, c)
 }
@@ -367,3 +529,147 @@ func (o *AdminApi) SearchLogs(c *gin.Context) {
 func (o *AdminApi) DeleteLogs(c *gin.Context) {
 	a2r.Call(chat.ChatClient.DeleteLogs, o.chatClient, c)
 }
+
+func (o *AdminApi) FindRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.FindRole, o.adminClient, c)
+}
+
+func (o *AdminApi) SearchAdmin(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAdmin, o.adminClient, c)
+}
+
+func (o *AdminApi) SetAdminLevel(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetAdminLevel, o.adminClient, c)
+}
+
+func (o *AdminApi) ChangePassword(c *gin.Context) {
+	var req admin.ChangePasswordReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	req.Token = c.GetHeader("token")
+	var trailer metadata.MD
+	resp, err := o.adminClient.ChangePassword(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *AdminApi) AddAdminAccount(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddAdminAccount, o.adminClient, c)
+}
+
+func (o *AdminApi) DelAdminAccount(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelAdminAccount, o.adminClient, c)
+}
+
+func (o *AdminApi) SetAdminStatus(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetAdminStatus, o.adminClient, c)
+}
+
+func (o *AdminApi) BindIMAdmin(c *gin.Context) {
+	a2r.Call(admin.AdminClient.BindIMAdmin, o.adminClient, c)
+}
+
+func (o *AdminApi) AddAdminIPAllow(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddAdminIPAllow, o.adminClient, c)
+}
+
+func (o *AdminApi) DelAdminIPAllow(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelAdminIPAllow, o.adminClient, c)
+}
+
+func (o *AdminApi) SearchAdminIPAllow(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAdminIPAllow, o.adminClient, c)
+}
+
+// imAdminToken 当前管理员绑定的openIM管理员token
+func (o *AdminApi) imAdminToken(c *gin.Context) (string, error) {
+	imAdminUserID, err := getIMAdmin(c, o.adminClient, mctx.GetOpUserID(c))
+	if err != nil {
+		return "", err
+	}
+	return o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+}
+
+// getIMAdmin 管理员绑定的openIM管理员userID
+func getIMAdmin(c *gin.Context, client admin.AdminClient, userID string) (string, error) {
+	resp, err := client.GetIMAdmin(c, &admin.GetIMAdminReq{UserID: userID})
+	if err != nil {
+		return "", err
+	}
+	return resp.ImUserID, nil
+}
+
+func (o *AdminApi) SearchAuditLog(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAuditLog, o.adminClient, c)
+}
+
+const (
+	auditExportPageSize = 500
+	auditExportMax      = 10000
+)
+
+// ExportAuditLog 按搜索条件导出csv, 最多导出auditExportMax条
+func (o *AdminApi) ExportAuditLog(c *gin.Context) {
+	var req admin.SearchAuditLogReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	var logs []*admin.AuditLogInfo
+	// 按id游标分页, 导出过程中新增的记录不会使后续页重复
+	req.Pagination = &sdkws.RequestPagination{PageNumber: 1, ShowNumber: auditExportPageSize}
+	for len(logs) < auditExportMax {
+		resp, err := o.adminClient.SearchAuditLog(c, &req)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		logs = append(logs, resp.Logs...)
+		if len(resp.Logs) < auditExportPageSize {
+			break
+		}
+		req.BeforeID = resp.Logs[len(resp.Logs)-1].Id
+	}
+	if len(logs) > auditExportMax {
+		logs = logs[:auditExportMax]
+	}
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=audit_log_%s.csv", time.Now().Format("20060102150405")))
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write([]string{"id", "time", "opUserID", "action", "target", "ip", "errCode", "errMsg", "request", "previous"})
+	for _, l := range logs {
+		_ = w.Write([]string{
+			strconv.FormatUint(l.Id, 10),
+			time.UnixMilli(l.CreateTime).Format(time.RFC3339),
+			csvCell(l.OpUserID),
+			csvCell(l.Action),
+			csvCell(l.Target),
+			csvCell(l.Ip),
+			strconv.Itoa(int(l.ErrCode)),
+			csvCell(l.ErrMsg),
+			csvCell(l.Request),
+			csvCell(l.Previous),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.ZError(c, "ExportAuditLog write csv", err)
+	}
+}
+
+// csvCell 防止以=+-@开头的内容在表格软件中被当作公式执行
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
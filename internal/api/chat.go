@@ -15,9 +15,15 @@
 package api
 
 import (
+	"context"
+	"crypto/subtle"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/apicall"
@@ -34,10 +40,12 @@ import (
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+	"github.com/OpenIMSDK/chat/pkg/sms"
 )
 
 func NewChat(chatConn, adminConn grpc.ClientConnInterface) *ChatApi {
@@ -64,14 +72,26 @@ func (o *ChatApi) SendVerifyCode(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
-	resp, err := o.chatClient.SendVerifyCode(c, &req)
+	if req.Language == "" {
+		req.Language = acceptLanguage(c.GetHeader("Accept-Language"))
+	}
+	var trailer metadata.MD
+	resp, err := o.chatClient.SendVerifyCode(c, &req, grpc.Trailer(&trailer))
 	if err != nil {
-		apiresp.GinError(c, err)
+		ginErrorWithRetryAfter(c, err, trailer)
 		return
 	}
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *ChatApi) GetCaptcha(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetCaptcha, o.chatClient, c)
+}
+
+func (o *ChatApi) VerifyCaptcha(c *gin.Context) {
+	a2r.Call(chat.ChatClient.VerifyCaptcha, o.chatClient, c)
+}
+
 func (o *ChatApi) VerifyCode(c *gin.Context) {
 	a2r.Call(chat.ChatClient.VerifyCode, o.chatClient, c)
 }
@@ -133,6 +153,7 @@ func (o *ChatApi) RegisterUser(c *gin.Context) {
 		}
 	}
 	resp.ChatToken = respRegisterUser.ChatToken
+	resp.RefreshToken = respRegisterUser.RefreshToken
 	resp.UserID = respRegisterUser.UserID
 	log.ZInfo(c, "registerUser api", "resp", &resp)
 	apiresp.GinSuccess(c, &resp)
@@ -157,11 +178,55 @@ func (o *ChatApi) Login(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
-	resp1, err := o.chatClient.Login(c, &req)
+	var trailer metadata.MD
+	resp1, err := o.chatClient.Login(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	if resp1.ChallengeToken != "" {
+		resp.UserID = resp1.UserID
+		resp.ChallengeToken = resp1.ChallengeToken
+		apiresp.GinSuccess(c, resp)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, req.Platform)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	resp.ImToken = imToken
+	resp.UserID = resp1.UserID
+	resp.ChatToken = resp1.ChatToken
+	resp.RefreshToken = resp1.RefreshToken
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) VerifyTOTPLogin(c *gin.Context) {
+	var (
+		req  chat.VerifyTOTPLoginReq
+		resp apistruct.LoginResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	var trailer metadata.MD
+	resp1, err := o.chatClient.VerifyTOTPLogin(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
 	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, req.Platform)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -170,9 +235,238 @@ func (o *ChatApi) Login(c *gin.Context) {
 	resp.ImToken = imToken
 	resp.UserID = resp1.UserID
 	resp.ChatToken = resp1.ChatToken
+	resp.RefreshToken = resp1.RefreshToken
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) RefreshToken(c *gin.Context) {
+	var (
+		req  admin.RefreshTokenReq
+		resp apistruct.RefreshTokenResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	req.UserType = constant2.NormalUser
+	resp1, err := o.adminClient.RefreshToken(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, resp1.Platform)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp.ImToken = imToken
+	resp.UserID = resp1.UserID
+	resp.ChatToken = resp1.Token
+	resp.RefreshToken = resp1.RefreshToken
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) CreateQRLogin(c *gin.Context) {
+	var req chat.CreateQRLoginReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	ip, err := o.getClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.Ip = ip
+	var trailer metadata.MD
+	resp, err := o.chatClient.CreateQRLogin(c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// GetQRLoginStatus 支持长轮询, wait秒内状态与客户端已知状态相同时等待
+func (o *ChatApi) GetQRLoginStatus(c *gin.Context) {
+	const maxWait = 30
+	var (
+		req struct {
+			chat.GetQRLoginStatusReq
+			Status int32 `json:"status"`
+			Wait   int32 `json:"wait"`
+		}
+		resp apistruct.QRLoginStatusResp
+	)
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req.GetQRLoginStatusReq); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	if req.Wait > maxWait {
+		req.Wait = maxWait
+	}
+	deadline := time.Now().Add(time.Duration(req.Wait) * time.Second)
+	var resp1 *chat.GetQRLoginStatusResp
+	for {
+		var err error
+		resp1, err = o.chatClient.GetQRLoginStatus(c, &req.GetQRLoginStatusReq)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		// 已签发token时立即返回, 获取im token失败时客户端重新查询仍能拿到同一token
+		if resp1.ChatToken != "" || resp1.Status != req.Status || !time.Now().Before(deadline) {
+			break
+		}
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+	resp.Status = resp1.Status
+	if resp1.ChatToken != "" {
+		imToken, err := o.imApiCaller.UserToken(c, resp1.UserID, resp1.Platform)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		resp.ImToken = imToken
+		resp.UserID = resp1.UserID
+		resp.ChatToken = resp1.ChatToken
+		resp.RefreshToken = resp1.RefreshToken
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *ChatApi) ScanQRLogin(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ScanQRLogin, o.chatClient, c)
+}
+
+func (o *ChatApi) ConfirmQRLogin(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ConfirmQRLogin, o.chatClient, c)
+}
+
+func (o *ChatApi) BeginPasskeyLogin(c *gin.Context) {
+	a2r.Call(chat.ChatClient.BeginPasskeyLogin, o.chatClient, c)
+}
+
+func (o *ChatApi) BeginPasskeyRegistration(c *gin.Context) {
+	callWithRetryAfter(chat.ChatClient.BeginPasskeyRegistration, o.chatClient, c)
+}
+
+func (o *ChatApi) FinishPasskeyRegistration(c *gin.Context) {
+	a2r.Call(chat.ChatClient.FinishPasskeyRegistration, o.chatClient, c)
+}
+
+func (o *ChatApi) FindPasskey(c *gin.Context) {
+	a2r.Call(chat.ChatClient.FindPasskey, o.chatClient, c)
+}
+
+func (o *ChatApi) DelPasskey(c *gin.Context) {
+	a2r.Call(chat.ChatClient.DelPasskey, o.chatClient, c)
+}
+
+func (o *ChatApi) EnrollTOTP(c *gin.Context) {
+	a2r.Call(chat.ChatClient.EnrollTOTP, o.chatClient, c)
+}
+
+func (o *ChatApi) ConfirmTOTP(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ConfirmTOTP, o.chatClient, c)
+}
+
+func (o *ChatApi) DisableTOTP(c *gin.Context) {
+	a2r.Call(chat.ChatClient.DisableTOTP, o.chatClient, c)
+}
+
+func (o *ChatApi) Logout(c *gin.Context) {
+	req := &admin.InvalidateTokenReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token")}
+	if _, err := o.adminClient.InvalidateToken(c, req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *ChatApi) LogoutAll(c *gin.Context) {
+	userID := mctx.GetOpUserID(c)
+	if _, err := o.adminClient.InvalidateToken(c, &admin.InvalidateTokenReq{UserID: userID}); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), userID); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *ChatApi) ListSessions(c *gin.Context) {
+	req := &admin.GetUserSessionsReq{UserID: mctx.GetOpUserID(c), Token: c.GetHeader("token")}
+	resp, err := o.adminClient.GetUserSessions(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *ChatApi) RevokeSession(c *gin.Context) {
+	var req admin.RevokeUserSessionReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	req.UserID = mctx.GetOpUserID(c)
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	resp, err := o.adminClient.RevokeUserSession(c, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// 只吊销了refresh token时该平台在线的可能是其他会话, 不能踢下线
+	if !resp.RevokedToken {
+		apiresp.GinSuccess(c, nil)
+		return
+	}
+	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), req.UserID, resp.Platform); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
 func (o *ChatApi) ResetPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ResetPassword, o.chatClient, c)
 }
@@ -181,6 +475,65 @@ func (o *ChatApi) ChangePassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ChangePassword, o.chatClient, c)
 }
 
+// ChangeContact 更换手机号或邮箱后踢出当前设备以外的登录
+func (o *ChatApi) ChangeContact(c *gin.Context) {
+	var req chat.ChangeContactReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	if _, err := o.chatClient.ChangeContact(c, &req); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	// 更换已经生效, 踢出其他设备失败时只记录日志
+	userID := mctx.GetOpUserID(c)
+	resp, err := o.adminClient.InvalidateToken(c, &admin.InvalidateTokenReq{UserID: userID, ExceptToken: c.GetHeader("token")})
+	if err != nil {
+		log.ZError(c, "InvalidateToken", err, "userID", userID)
+		apiresp.GinSuccess(c, nil)
+		return
+	}
+	if len(resp.Platforms) > 0 {
+		imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
+		if err != nil {
+			log.ZError(c, "ImAdminTokenWithDefaultAdmin", err, "userID", userID)
+			apiresp.GinSuccess(c, nil)
+			return
+		}
+		for _, platform := range resp.Platforms {
+			if err := o.imApiCaller.ForceOffLinePlatform(mctx.WithApiToken(c, imToken), userID, platform); err != nil {
+				log.ZError(c, "ForceOffLinePlatform", err, "userID", userID, "platform", platform)
+			}
+		}
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+func (o *ChatApi) BindIdentifier(c *gin.Context) {
+	callWithRetryAfter(chat.ChatClient.BindIdentifier, o.chatClient, c)
+}
+
+func (o *ChatApi) UnbindIdentifier(c *gin.Context) {
+	callWithRetryAfter(chat.ChatClient.UnbindIdentifier, o.chatClient, c)
+}
+
+func (o *ChatApi) DeleteAccount(c *gin.Context) {
+	callWithRetryAfter(chat.ChatClient.DeleteAccount, o.chatClient, c)
+}
+
+func (o *ChatApi) CancelDeleteAccount(c *gin.Context) {
+	a2r.Call(chat.ChatClient.CancelDeleteAccount, o.chatClient, c)
+}
+
+func (o *ChatApi) GetDeleteAccountStatus(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetDeleteAccountStatus, o.chatClient, c)
+}
+
 // ################## USER ##################
 
 func (o *ChatApi) UpdateUserInfo(c *gin.Context) {
@@ -211,7 +564,11 @@ func (o *ChatApi) UpdateUserInfo(c *gin.Context) {
 	if opUserType == constant2.NormalUser {
 		imToken, err = o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	} else if opUserType == constant2.AdminUser {
-		imToken, err = o.imApiCaller.UserToken(c, config.GetIMAdmin(mctx.GetOpUserID(c)), constant.AdminPlatformID)
+		var imAdminUserID string
+		imAdminUserID, err = getIMAdmin(c, o.adminClient, mctx.GetOpUserID(c))
+		if err == nil {
+			imToken, err = o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+		}
 	} else {
 		apiresp.GinError(c, errs.ErrArgs.Wrap("opUserType unknown"))
 		return
@@ -293,19 +650,173 @@ func (o *ChatApi) OpenIMCallback(c *gin.Context) {
 	apiresp.GinSuccess(c, nil)
 }
 
+// SMSReceipt 短信服务商推送的发送回执, 使用配置的receiptToken校验
+func (o *ChatApi) SMSReceipt(c *gin.Context) {
+	token := config.Config.VerifyCode.ReceiptToken
+	if token == "" || subtle.ConstantTimeCompare([]byte(c.Query("token")), []byte(token)) != 1 {
+		apiresp.GinError(c, errs.ErrNoPermission.Wrap("receipt token is invalid"))
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	use := c.Param("provider")
+	receipts, err := sms.ParseReceipts(use, body)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if len(receipts) > 0 {
+		req := &chat.UpdateVerifyCodeStatusReq{Receipts: make([]*chat.VerifyCodeReceipt, 0, len(receipts))}
+		for _, receipt := range receipts {
+			req.Receipts = append(req.Receipts, &chat.VerifyCodeReceipt{
+				Provider:  receipt.Provider,
+				MessageID: receipt.MessageID,
+				Status:    receipt.Status,
+				Desc:      receipt.Desc,
+			})
+		}
+		if _, err := o.chatClient.UpdateVerifyCodeStatus(c, req); err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	if ack := sms.ReceiptAck(use); ack != nil {
+		c.JSON(http.StatusOK, ack)
+		return
+	}
+	apiresp.GinSuccess(c, nil)
+}
+
+// callWithRetryAfter 同a2r.Call, 失败时带上rpc通过trailer返回的Retry-After
+func callWithRetryAfter[A, B, C any](rpc func(client C, ctx context.Context, req *A, options ...grpc.CallOption) (*B, error), client C, c *gin.Context) {
+	var req A
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap()) // 参数错误
+		return
+	}
+	if err := checker.Validate(&req); err != nil {
+		apiresp.GinError(c, err) // 参数校验失败
+		return
+	}
+	var trailer metadata.MD
+	data, err := rpc(client, c, &req, grpc.Trailer(&trailer))
+	if err != nil {
+		ginErrorWithRetryAfter(c, err, trailer)
+		return
+	}
+	apiresp.GinSuccess(c, data)
+}
+
+// ginErrorWithRetryAfter 被限流时设置Retry-After响应头, 并在data中返回需要等待的秒数
+func ginErrorWithRetryAfter(c *gin.Context, err error, trailer metadata.MD) {
+	values := trailer.Get(constant2.RpcRetryAfter)
+	if len(values) == 0 {
+		apiresp.GinError(c, err)
+		return
+	}
+	seconds, parseErr := strconv.ParseInt(values[0], 10, 64)
+	if parseErr != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	c.Header("Retry-After", values[0])
+	resp := apiresp.ParseError(err)
+	resp.Data = &apistruct.RetryAfterResp{RetryAfter: seconds}
+	c.JSON(http.StatusOK, resp)
+}
+
 func (o *ChatApi) getClientIP(c *gin.Context) (string, error) {
-	if config.Config.ProxyHeader == "" {
-		ip, _, err := net.SplitHostPort(c.Request.RemoteAddr)
-		return ip, err
+	return getClientIP(c)
+}
+
+// getClientIP 远程地址是可信代理时才读取proxyHeader, 否则客户端可以伪造header绕过ip白名单和限流
+func getClientIP(c *gin.Context) (string, error) {
+	remoteIP, _, err := net.SplitHostPort(c.Request.RemoteAddr)
+	if err != nil {
+		return "", errs.Wrap(err)
 	}
-	ip := c.Request.Header.Get(config.Config.ProxyHeader)
-	if ip == "" {
+	if config.Config.ProxyHeader == "" || !trustedProxy(remoteIP) {
+		return remoteIP, nil
+	}
+	header := c.Request.Header.Get(config.Config.ProxyHeader)
+	if header == "" {
 		return "", errs.ErrInternalServer.Wrap()
 	}
-	if ip := net.ParseIP(ip); ip == nil {
-		return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("parse proxy ip header %s failed", ip))
+	// X-Forwarded-For 从右往左取第一个不是可信代理的地址, 左侧的地址可以被客户端伪造
+	ips := strings.Split(header, ",")
+	for i := len(ips) - 1; i >= 0; i-- {
+		ip := strings.TrimSpace(ips[i])
+		if net.ParseIP(ip) == nil {
+			return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("parse proxy ip header %s failed", header))
+		}
+		if i == 0 || !trustedProxy(ip) {
+			return ip, nil
+		}
+	}
+	return remoteIP, nil
+}
+
+var (
+	trustedProxyOnce sync.Once
+	trustedProxyNets []*net.IPNet
+)
+
+func trustedProxy(ip string) bool {
+	trustedProxyOnce.Do(func() {
+		for _, proxy := range config.Config.TrustedProxies {
+			if !strings.Contains(proxy, "/") {
+				if net.ParseIP(proxy).To4() != nil {
+					proxy += "/32"
+				} else {
+					proxy += "/128"
+				}
+			}
+			_, ipNet, err := net.ParseCIDR(proxy)
+			if err != nil {
+				log.ZError(context.Background(), "invalid trusted proxy", err, "proxy", proxy)
+				continue
+			}
+			trustedProxyNets = append(trustedProxyNets, ipNet)
+		}
+	})
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, ipNet := range trustedProxyNets {
+		if ipNet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptLanguage 取Accept-Language中权重最高的语言, 如 "zh-CN,zh;q=0.9,en;q=0.8" 返回 zh-CN
+func acceptLanguage(header string) string {
+	var (
+		language string
+		weight   = -1.0
+	)
+	for _, part := range strings.Split(header, ",") {
+		tag, q := part, 1.0
+		if i := strings.Index(part, ";"); i >= 0 {
+			tag = part[:i]
+			if v := strings.TrimSpace(part[i+1:]); strings.HasPrefix(v, "q=") {
+				if f, err := strconv.ParseFloat(v[2:], 64); err == nil {
+					q = f
+				}
+			}
+		}
+		tag = strings.TrimSpace(tag)
+		if tag == "" || tag == "*" || q <= weight {
+			continue
+		}
+		language, weight = tag, q
 	}
-	return ip, nil
+	return language
 }
 
 func (o *ChatApi) UploadLogs(c *gin.Context) {
@@ -25,6 +25,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
 
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
@@ -65,7 +67,8 @@ func (o *MW) parseTokenType(c *gin.Context, userType int32) (string, string, err
 }
 
 func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
-	resp, err := o.client.GetUserToken(c, &admin.GetUserTokenReq{UserID: userID})
+	ip, _ := getClientIP(c)
+	resp, err := o.client.GetUserToken(c, &admin.GetUserTokenReq{UserID: userID, Token: token, Ip: ip})
 	m := resp.TokensMap
 	if err != nil {
 		log.ZWarn(c, "cache get token error", errs.ErrTokenNotExist.Wrap())
@@ -94,7 +97,12 @@ func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
 func (o *MW) setToken(c *gin.Context, userID string, userType int32) {
 	c.Set(constant.RpcOpUserID, userID)
 	c.Set(constant.RpcOpUserType, []string{strconv.Itoa(int(userType))})
-	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType})
+	headers := []string{constant.RpcOpUserType}
+	if ip, err := getClientIP(c); err == nil && ip != "" {
+		c.Set(constant.RpcOpUserIP, []string{ip})
+		headers = append(headers, constant.RpcOpUserIP)
+	}
+	c.Set(constant.RpcCustomHeader, headers)
 }
 
 func (o *MW) CheckToken(c *gin.Context) {
@@ -109,6 +117,14 @@ func (o *MW) CheckToken(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
+	// chat-api也接受管理员token, 同样需要校验管理员ip白名单
+	if userType == constant.AdminUser {
+		if err := o.checkAdminIP(c); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+			return
+		}
+	}
 	o.setToken(c, userID, userType)
 }
 
@@ -127,6 +143,41 @@ func (o *MW) CheckAdmin(c *gin.Context) {
 	o.setToken(c, userID, constant.AdminUser)
 }
 
+// CheckAdminIP 校验请求ip是否在管理后台白名单中, 配置ipAllowlistBypass时跳过
+func (o *MW) CheckAdminIP(c *gin.Context) {
+	if err := o.checkAdminIP(c); err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+}
+
+func (o *MW) checkAdminIP(c *gin.Context) error {
+	if config.Config.AdminApi.IPAllowlistBypass {
+		return nil
+	}
+	ip, err := getClientIP(c)
+	if err != nil {
+		return err
+	}
+	_, err = o.client.CheckAdminIP(c, &admin.CheckAdminIPReq{Ip: ip})
+	return err
+}
+
+// CheckPermission 校验管理员token, 并校验管理员角色是否拥有当前路由的权限
+func (o *MW) CheckPermission(c *gin.Context) {
+	o.CheckAdmin(c)
+	if c.IsAborted() {
+		return
+	}
+	req := &admin.CheckPermissionReq{Permission: string(rbac.RoutePermission(c.FullPath()))}
+	if _, err := o.client.CheckPermission(c, req); err != nil {
+		c.Abort()
+		apiresp.GinError(c, err)
+		return
+	}
+}
+
 func (o *MW) CheckUser(c *gin.Context) {
 	userID, token, err := o.parseTokenType(c, constant.NormalUser)
 	if err != nil {
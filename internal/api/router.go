@@ -38,8 +38,29 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 	account.POST("/code/verify", chat.VerifyCode)                        // 校验验证码
 	account.POST("/register", chat.RegisterUser)                         // 注册
 	account.POST("/login", chat.Login)                                   // 登录
+	account.POST("/login/totp", chat.VerifyTOTPLogin)                    // 二次验证登录
+	account.POST("/login/passkey/begin", chat.BeginPasskeyLogin)         // 获取通行密钥登录challenge
+	account.POST("/login/qrcode/create", chat.CreateQRLogin)             // 生成扫码登录二维码
+	account.POST("/login/qrcode/status", chat.GetQRLoginStatus)          // 查询扫码登录状态
 	account.POST("/password/reset", chat.ResetPassword)                  // 忘记密码
 	account.POST("/password/change", mw.CheckToken, chat.ChangePassword) // 修改密码
+	account.POST("/contact/change", mw.CheckToken, chat.ChangeContact)   // 更换手机号或邮箱
+	account.POST("/logout", mw.CheckToken, chat.Logout)                  // 退出登录
+	account.POST("/logout/all", mw.CheckToken, chat.LogoutAll)           // 退出所有设备
+	account.POST("/token/refresh", chat.RefreshToken)                    // 刷新token
+
+	captcha := account.Group("/captcha")
+	captcha.POST("/get", chat.GetCaptcha)       // 获取图片人机验证
+	captcha.POST("/verify", chat.VerifyCaptcha) // 提交人机验证答案
+
+	identifier := account.Group("/identifier", mw.CheckToken)
+	identifier.POST("/bind", chat.BindIdentifier)     // 绑定手机号、邮箱或账号
+	identifier.POST("/unbind", chat.UnbindIdentifier) // 解绑手机号、邮箱或账号
+
+	deletion := account.Group("/delete", mw.CheckToken)
+	deletion.POST("", chat.DeleteAccount)                 // 申请注销账号
+	deletion.POST("/cancel", chat.CancelDeleteAccount)    // 撤销注销申请
+	deletion.POST("/status", chat.GetDeleteAccountStatus) // 查询注销申请
 
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)              // 编辑个人资料
@@ -47,6 +68,21 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 	user.POST("/find/full", chat.FindUserFullInfo)         // 获取用户所有信息
 	user.POST("/search/full", chat.SearchUserFullInfo)     // 搜索用户公开信息
 	user.POST("/search/public", chat.SearchUserPublicInfo) // 搜索用户所有信息
+	user.POST("/totp/enroll", chat.EnrollTOTP)             // 生成二次验证密钥
+	user.POST("/totp/confirm", chat.ConfirmTOTP)           // 确认开启二次验证
+	user.POST("/totp/disable", chat.DisableTOTP)           // 关闭二次验证
+	user.POST("/sessions/list", chat.ListSessions)         // 登录设备列表
+	user.POST("/sessions/revoke", chat.RevokeSession)      // 踢出登录设备
+
+	passkey := user.Group("/passkey")
+	passkey.POST("/register/begin", chat.BeginPasskeyRegistration)   // 获取通行密钥注册challenge
+	passkey.POST("/register/finish", chat.FinishPasskeyRegistration) // 注册通行密钥
+	passkey.POST("/find", chat.FindPasskey)                          // 通行密钥列表
+	passkey.POST("/del", chat.DelPasskey)                            // 删除通行密钥
+
+	qrcode := user.Group("/qrcode")
+	qrcode.POST("/scan", chat.ScanQRLogin)       // 扫描登录二维码
+	qrcode.POST("/confirm", chat.ConfirmQRLogin) // 确认扫码登录
 
 	router.POST("/friend/search", mw.CheckToken, chat.SearchFriend)
 
@@ -54,7 +90,9 @@ func NewChatRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegis
 
 	router.Group("/client_config").POST("/get", chat.GetClientConfig) // 获取客户端初始化配置
 
-	router.Group("/callback").POST("/open_im", chat.OpenIMCallback) // 回调
+	callback := router.Group("/callback")
+	callback.POST("/open_im", chat.OpenIMCallback)   // 回调
+	callback.POST("/sms/:provider", chat.SMSReceipt) // 短信发送回执
 
 	logs := router.Group("/logs", mw.CheckToken)
 	logs.POST("/upload", chat.UploadLogs)
@@ -81,12 +119,22 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	}
 	mw := NewMW(adminConn)
 	admin := NewAdmin(chatConn, adminConn)
+	router.Use(mw.CheckAdminIP)
 	adminRouterGroup := router.Group("/account")
-	adminRouterGroup.POST("/login", admin.AdminLogin)                      // 登录
-	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo) // 修改信息
-	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)         // 获取信息
-
-	defaultRouter := router.Group("/default", mw.CheckAdmin)
+	adminRouterGroup.POST("/login", admin.AdminLogin)                         // 登录
+	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)    // 修改信息
+	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)            // 获取信息
+	adminRouterGroup.POST("/login/totp", admin.AdminVerifyTOTPLogin)          // 二次验证登录
+	adminRouterGroup.POST("/logout", mw.CheckAdmin, admin.AdminLogout)        // 退出登录
+	adminRouterGroup.POST("/logout/all", mw.CheckAdmin, admin.AdminLogoutAll) // 退出所有设备
+	adminRouterGroup.POST("/token/refresh", admin.AdminRefreshToken)          // 刷新token
+	adminRouterGroup.POST("/totp/enroll", mw.CheckAdmin, admin.EnrollTOTP)    // 生成二次验证密钥
+	adminRouterGroup.POST("/totp/confirm", mw.CheckAdmin, admin.ConfirmTOTP)  // 确认开启二次验证
+	adminRouterGroup.POST("/totp/disable", mw.CheckAdmin, admin.DisableTOTP)  // 关闭二次验证
+
+	adminRouterGroup.POST("/password/change", mw.CheckAdmin, admin.ChangePassword) // 修改密码
+
+	defaultRouter := router.Group("/default", mw.CheckPermission)
 	defaultUserRouter := defaultRouter.Group("/user")
 	defaultUserRouter.POST("/add", admin.AddDefaultFriend)       // 添加注册时默认好友
 	defaultUserRouter.POST("/del", admin.DelDefaultFriend)       // 删除注册时默认好友
@@ -98,13 +146,13 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	defaultGroupRouter.POST("/find", admin.FindDefaultGroup)     // 获取注册时默认群列表
 	defaultGroupRouter.POST("/search", admin.SearchDefaultGroup) // 获取注册时默认群列表
 
-	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin)
+	invitationCodeRouter := router.Group("/invitation_code", mw.CheckPermission)
 	invitationCodeRouter.POST("/add", admin.AddInvitationCode)       // 添加邀请码
 	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)       // 生成邀请码
 	invitationCodeRouter.POST("/del", admin.DelInvitationCode)       // 删除邀请码
 	invitationCodeRouter.POST("/search", admin.SearchInvitationCode) // 搜索邀请码
 
-	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin)
+	forbiddenRouter := router.Group("/forbidden", mw.CheckPermission)
 	ipForbiddenRouter := forbiddenRouter.Group("/ip")
 	ipForbiddenRouter.POST("/add", admin.AddIPForbidden)       // 添加禁止注册登录IP
 	ipForbiddenRouter.POST("/del", admin.DelIPForbidden)       // 删除禁止注册登录IP
@@ -114,30 +162,55 @@ func NewAdminRoute(router gin.IRouter, discov discoveryregistry.SvcDiscoveryRegi
 	userForbiddenRouter.POST("/del", admin.DelUserIPLimitLogin)       // 删除用户在指定IP登录
 	userForbiddenRouter.POST("/search", admin.SearchUserIPLimitLogin) // 搜索限制用户在指定ip登录
 
-	appletRouterGroup := router.Group("/applet", mw.CheckAdmin)
+	appletRouterGroup := router.Group("/applet", mw.CheckPermission)
 	appletRouterGroup.POST("/add", admin.AddApplet)       // 添加小程序
 	appletRouterGroup.POST("/del", admin.DelApplet)       // 删除小程序
 	appletRouterGroup.POST("/update", admin.UpdateApplet) // 修改小程序
 	appletRouterGroup.POST("/search", admin.SearchApplet) // 搜索小程序
 
-	blockRouter := router.Group("/block", mw.CheckAdmin)
+	blockRouter := router.Group("/block", mw.CheckPermission)
 	blockRouter.POST("/add", admin.BlockUser)          // 封号
 	blockRouter.POST("/del", admin.UnblockUser)        // 解封
 	blockRouter.POST("/search", admin.SearchBlockUser) // 搜索封号用户
 
-	userRouter := router.Group("/user", mw.CheckAdmin)
+	blockRouter.POST("/history", admin.SearchBlockHistory) // 搜索封号历史
+
+	userRouter := router.Group("/user", mw.CheckPermission)
 	userRouter.POST("/password/reset", admin.ResetUserPassword) // 重置用户密码
+	userRouter.POST("/totp/disable", admin.DisableUserTOTP)     // 关闭用户二次验证
+	userRouter.POST("/login/unlock", admin.UnlockUserLogin)     // 解除用户登录锁定
 
-	initGroup := router.Group("/client_config", mw.CheckAdmin)
+	initGroup := router.Group("/client_config", mw.CheckPermission)
 	initGroup.POST("/get", admin.GetClientConfig) // 获取客户端初始化配置
 	initGroup.POST("/set", admin.SetClientConfig) // 设置客户端初始化配置
 	initGroup.POST("/del", admin.DelClientConfig) // 删除客户端初始化配置
 
-	statistic := router.Group("/statistic", mw.CheckAdmin)
+	statistic := router.Group("/statistic", mw.CheckPermission)
 	statistic.POST("/new_user_count", admin.NewUserCount)
 	statistic.POST("/login_user_count", admin.LoginUserCount)
 
-	logs := router.Group("/logs", mw.CheckAdmin)
+	router.Group("/verify_code", mw.CheckPermission).POST("/search", admin.SearchVerifyCode) // 搜索验证码发送记录
+
+	logs := router.Group("/logs", mw.CheckPermission)
 	logs.POST("/search", admin.SearchLogs)
 	logs.POST("/delete", admin.DeleteLogs)
+
+	role := router.Group("/role", mw.CheckPermission)
+	role.POST("/find", admin.FindRole)           // 角色及权限列表
+	role.POST("/admin/set", admin.SetAdminLevel) // 设置管理员角色
+
+	adminAccount := router.Group("/admin", mw.CheckPermission)
+	adminAccount.POST("/search", admin.SearchAdmin)        // 搜索管理员
+	adminAccount.POST("/add", admin.AddAdminAccount)       // 添加管理员
+	adminAccount.POST("/del", admin.DelAdminAccount)       // 删除管理员
+	adminAccount.POST("/status/set", admin.SetAdminStatus) // 禁用或启用管理员
+	adminAccount.POST("/im/bind", admin.BindIMAdmin)       // 绑定openIM管理员
+
+	adminAccount.POST("/ip/add", admin.AddAdminIPAllow)       // 添加管理后台ip白名单
+	adminAccount.POST("/ip/del", admin.DelAdminIPAllow)       // 删除管理后台ip白名单
+	adminAccount.POST("/ip/search", admin.SearchAdminIPAllow) // 搜索管理后台ip白名单
+
+	audit := router.Group("/audit", mw.CheckPermission)
+	audit.POST("/search", admin.SearchAuditLog) // 搜索管理员操作日志
+	audit.POST("/export", admin.ExportAuditLog) // 导出管理员操作日志
 }
@@ -0,0 +1,211 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAccountReq) (*admin.AddAdminAccountResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AdminWrite); err != nil {
+		return nil, err
+	}
+	if req.Level == 0 {
+		req.Level = constant.AdminLevelAuditor
+	}
+	if rbac.GetRole(req.Level) == nil {
+		return nil, errs.ErrArgs.Wrap("level is not a role")
+	}
+	if err := checkIMAdminUserID(req.ImUserID); err != nil {
+		return nil, err
+	}
+	if err := passwd.CheckPolicy(req.Password); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdmin(ctx, req.Account); err == nil {
+		return nil, eerrs.ErrAccountAlreadyRegister.Wrap()
+	} else if !dbutil.IsGormNotFound(err) {
+		return nil, err
+	}
+	password, err := passwd.Hash(req.Password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	userID, err := genAdminUserID()
+	if err != nil {
+		return nil, err
+	}
+	a := &admin2.Admin{
+		Account:    req.Account,
+		Password:   password,
+		FaceURL:    req.FaceURL,
+		Nickname:   req.Nickname,
+		UserID:     userID,
+		Level:      req.Level,
+		CreateTime: time.Now(),
+		IMUserID:   req.ImUserID,
+		Status:     constant.AdminStatusNormal,
+	}
+	if a.Nickname == "" {
+		a.Nickname = a.Account
+	}
+	if err := o.Database.CreateAdmin(ctx, a); err != nil {
+		return nil, err
+	}
+	return &admin.AddAdminAccountResp{UserID: userID}, nil
+}
+
+func (o *adminServer) DelAdminAccount(ctx context.Context, req *admin.DelAdminAccountReq) (*admin.DelAdminAccountResp, error) {
+	defer log.ZDebug(ctx, "return")
+	a, err := o.manageAdmin(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelAdmin(ctx, a.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: a.UserID}); err != nil {
+		return nil, err
+	}
+	return &admin.DelAdminAccountResp{}, nil
+}
+
+func (o *adminServer) SetAdminStatus(ctx context.Context, req *admin.SetAdminStatusReq) (*admin.SetAdminStatusResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if req.Status != constant.AdminStatusNormal && req.Status != constant.AdminStatusDisabled {
+		return nil, errs.ErrArgs.Wrap("status is invalid")
+	}
+	a, err := o.manageAdmin(ctx, req.Account)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, a.UserID, map[string]any{"status": req.Status}); err != nil {
+		return nil, err
+	}
+	if req.Status == constant.AdminStatusDisabled {
+		if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: a.UserID}); err != nil {
+			return nil, err
+		}
+	}
+	return &admin.SetAdminStatusResp{}, nil
+}
+
+func (o *adminServer) BindIMAdmin(ctx context.Context, req *admin.BindIMAdminReq) (*admin.BindIMAdminResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AdminWrite); err != nil {
+		return nil, err
+	}
+	if err := checkIMAdminUserID(req.ImUserID); err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdmin(ctx, req.Account)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if err := o.Database.UpdateAdmin(ctx, a.UserID, map[string]any{"im_user_id": req.ImUserID}); err != nil {
+		return nil, err
+	}
+	return &admin.BindIMAdminResp{}, nil
+}
+
+func (o *adminServer) GetIMAdmin(ctx context.Context, req *admin.GetIMAdminReq) (*admin.GetIMAdminResp, error) {
+	var imUserID string
+	a, err := o.Database.GetAdminUserID(ctx, req.UserID)
+	if err == nil {
+		imUserID = imAdminUserID(a)
+	} else if dbutil.IsGormNotFound(err) {
+		imUserID = config.GetIMAdmin(req.UserID)
+	} else {
+		return nil, err
+	}
+	if imUserID == "" {
+		return nil, errs.ErrRecordNotFound.Wrap("admin is not bound to openIM admin")
+	}
+	return &admin.GetIMAdminResp{ImUserID: imUserID}, nil
+}
+
+// manageAdmin 校验权限并获取被管理的管理员, 不能管理自己
+func (o *adminServer) manageAdmin(ctx context.Context, account string) (*admin2.Admin, error) {
+	if err := o.checkPermission(ctx, rbac.AdminWrite); err != nil {
+		return nil, err
+	}
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdmin(ctx, account)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap()
+		}
+		return nil, err
+	}
+	if a.UserID == opUserID {
+		return nil, errs.ErrArgs.Wrap("can not manage own account")
+	}
+	return a, nil
+}
+
+// checkAdminStatus 禁用的管理员不能登录
+func checkAdminStatus(a *admin2.Admin) error {
+	if a.Status == constant.AdminStatusDisabled {
+		return eerrs.ErrAdminDisabled.Wrap()
+	}
+	return nil
+}
+
+// imAdminUserID 未绑定时使用配置adminList中的openIM管理员
+func imAdminUserID(a *admin2.Admin) string {
+	if a.IMUserID != "" {
+		return a.IMUserID
+	}
+	return config.GetIMAdmin(a.UserID)
+}
+
+// checkIMAdminUserID 绑定的openIM账号必须是应用管理员, 为空表示使用配置adminList
+func checkIMAdminUserID(imUserID string) error {
+	if imUserID != "" && !config.IsIMAdmin(imUserID) {
+		return errs.ErrArgs.Wrap("imUserID is not an openIM app manager")
+	}
+	return nil
+}
+
+func genAdminUserID() (string, error) {
+	data := make([]byte, 8)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return "admin_" + hex.EncodeToString(data), nil
+}
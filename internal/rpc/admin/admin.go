@@ -19,17 +19,21 @@ import (
 
 	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
 	"github.com/OpenIMSDK/tools/mcontext"
 	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
-	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/database"
 	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/geoip"
+	"github.com/OpenIMSDK/chat/pkg/notify"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/rpclient/chat"
 )
@@ -43,12 +47,17 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		admin2.Admin{},
 		admin2.Applet{},
 		admin2.ForbiddenAccount{},
+		admin2.ForbiddenAccountHistory{},
 		admin2.InvitationRegister{},
 		admin2.IPForbidden{},
 		admin2.LimitUserLoginIP{},
 		admin2.RegisterAddFriend{},
 		admin2.RegisterAddGroup{},
 		admin2.ClientConfig{},
+		admin2.AdminTOTP{},
+		admin2.AuditLog{},
+		admin2.AdminIPAllow{},
+		admin2.AdminLoginRecord{},
 	}
 	if err := db.AutoMigrate(tables...); err != nil {
 		return err
@@ -64,16 +73,27 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		panic(err)
 	}
 
-	admin.RegisterAdminServer(server, &adminServer{
+	notifier, err := notify.New()
+	if err != nil {
+		return err
+	}
+	srv := &adminServer{
 		Database: database.NewAdminDatabase(db, rdb),
 		Chat:     chat.NewChatClient(discov),
-	})
+		Notifier: notifier,
+		Locator:  geoip.New(),
+	}
+	auditor.Init(srv.auditRules(), srv.recordAudit)
+	go srv.blockExpireLoop()
+	admin.RegisterAdminServer(server, srv)
 	return nil
 }
 
 type adminServer struct {
 	Database database.AdminDatabaseInterface
 	Chat     *chat.ChatClient
+	Notifier notify.Notifier
+	Locator  geoip.Locator
 }
 
 func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoReq) (*admin.GetAdminInfoResp, error) {
@@ -86,13 +106,13 @@ func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoR
 		return nil, err
 	}
 	return &admin.GetAdminInfoResp{
-		Account:    a.Account,
-		Password:   a.Password,
-		FaceURL:    a.FaceURL,
-		Nickname:   a.Nickname,
-		UserID:     a.UserID,
-		Level:      a.Level,
-		CreateTime: a.CreateTime.UnixMilli(),
+		Account:     a.Account,
+		FaceURL:     a.FaceURL,
+		Nickname:    a.Nickname,
+		UserID:      a.UserID,
+		Level:       a.Level,
+		CreateTime:  a.CreateTime.UnixMilli(),
+		Permissions: permissionList(a.Level),
 	}, nil
 }
 
@@ -101,6 +121,9 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 	if err != nil {
 		return nil, err
 	}
+	if req.Level != nil {
+		return nil, errs.ErrNoPermission.Wrap("level can only be changed by SetAdminLevel")
+	}
 	update, err := ToDBAdminUpdate(req)
 	if err != nil {
 		return nil, err
@@ -134,21 +157,36 @@ func (o *adminServer) Login(ctx context.Context, req *admin.LoginReq) (*admin.Lo
 		}
 		return nil, err
 	}
-	if a.Password != req.Password {
+	if err := o.checkLoginLocked(ctx, a.Account); err != nil {
+		return nil, err
+	}
+	ok, rehash := passwd.Verify(a.Password, req.Password)
+	if !ok {
+		o.loginFailed(ctx, a.Account)
 		return nil, eerrs.ErrPassword.Wrap()
 	}
-	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
+	if err := checkAdminStatus(a); err != nil {
+		return nil, err
+	}
+	if rehash {
+		if update, err := ToDBAdminUpdatePassword(req.Password); err != nil {
+			log.ZError(ctx, "rehash admin password failed", err, "account", a.Account)
+		} else if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
+			log.ZError(ctx, "update rehashed admin password failed", err, "account", a.Account)
+		}
+	}
+	challengeToken, err := o.loginChallenge(ctx, a.UserID)
 	if err != nil {
 		return nil, err
 	}
-	return &admin.LoginResp{
-		AdminUserID:  a.UserID,
-		AdminAccount: a.Account,
-		AdminToken:   adminToken.Token,
-		Nickname:     a.Nickname,
-		FaceURL:      a.FaceURL,
-		Level:        a.Level,
-	}, nil
+	if challengeToken != "" {
+		return &admin.LoginResp{
+			AdminUserID:    a.UserID,
+			AdminAccount:   a.Account,
+			ChallengeToken: challengeToken,
+		}, nil
+	}
+	return o.loginSuccess(ctx, a, req.Ip)
 }
 
 func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePasswordReq) (*admin.ChangePasswordResp, error) {
@@ -156,10 +194,23 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err != nil {
 		return nil, err
 	}
-	a, err := o.Database.GetAdmin(ctx, userID)
+	a, err := o.Database.GetAdminUserID(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if err := o.checkLoginLocked(ctx, a.Account); err != nil {
+		return nil, err
+	}
+	if ok, _ := passwd.Verify(a.Password, req.CurrentPassword); !ok {
+		o.loginFailed(ctx, a.Account)
+		return nil, eerrs.ErrPassword.Wrap()
+	}
+	if err := o.verifyTOTPOwner(ctx, a, req.TotpCode); err != nil {
+		return nil, err
+	}
+	if err := passwd.CheckPolicy(req.Password); err != nil {
+		return nil, err
+	}
 	update, err := ToDBAdminUpdatePassword(req.Password)
 	if err != nil {
 		return nil, err
@@ -167,5 +218,9 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
 		return nil, err
 	}
+	// 会话被盗用时修改密码后仍可继续使用, 其他设备的token和refresh token一并失效
+	if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: a.UserID, ExceptToken: req.Token}); err != nil {
+		return nil, err
+	}
 	return &admin.ChangePasswordResp{}, nil
 }
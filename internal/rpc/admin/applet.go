@@ -28,13 +28,14 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/common"
 )
 
 func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*admin.AddAppletResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.AppletWrite); err != nil {
 		return nil, err
 	}
 	if req.Name == "" {
@@ -70,7 +71,7 @@ func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*
 
 func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*admin.DelAppletResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.AppletWrite); err != nil {
 		return nil, err
 	}
 	if len(req.AppletIds) == 0 {
@@ -91,7 +92,7 @@ func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*
 
 func (o *adminServer) UpdateApplet(ctx context.Context, req *admin.UpdateAppletReq) (*admin.UpdateAppletResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.AppletWrite); err != nil {
 		return nil, err
 	}
 	_, err := o.Database.GetApplet(ctx, req.Id)
@@ -138,7 +139,7 @@ func (o *adminServer) FindApplet(ctx context.Context, req *admin.FindAppletReq)
 
 func (o *adminServer) SearchApplet(ctx context.Context, req *admin.SearchAppletReq) (*admin.SearchAppletResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.AppletRead); err != nil {
 		return nil, err
 	}
 	total, applets, err := o.Database.SearchApplet(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -0,0 +1,206 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"google.golang.org/grpc"
+
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/mw"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+var auditor = &mw.Auditor{}
+
+// AuditServer 记录管理员操作, 规则在Start中设置
+func AuditServer() grpc.ServerOption {
+	return auditor.GrpcServer()
+}
+
+// previous 将具体请求类型的函数转换为AuditRule.Previous
+func previous[R any](fn func(ctx context.Context, req R) (any, error)) func(ctx context.Context, req any) (any, error) {
+	return func(ctx context.Context, req any) (any, error) {
+		return fn(ctx, req.(R))
+	}
+}
+
+func (o *adminServer) auditRules() map[string]*mw.AuditRule {
+	adminByAccount := func(ctx context.Context, account string) (any, error) {
+		a, err := o.Database.GetAdmin(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		return DbToPbAdminInfo(a), nil
+	}
+	config := func(ctx context.Context, keys []string) (any, error) {
+		cs, err := o.Database.GetConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res := make(map[string]string)
+		for _, key := range keys {
+			if v, ok := cs[key]; ok {
+				res[key] = v
+			}
+		}
+		return res, nil
+	}
+	return map[string]*mw.AuditRule{
+		"AdminUpdateInfo": {Previous: previous(func(ctx context.Context, req *admin.AdminUpdateInfoReq) (any, error) {
+			a, err := o.Database.GetAdminUserID(ctx, mctx.GetOpUserID(ctx))
+			if err != nil {
+				return nil, err
+			}
+			return DbToPbAdminInfo(a), nil
+		})},
+		"ChangePassword": {},
+		"ConfirmTOTP":    {},
+		"DisableTOTP":    {},
+
+		"SetAdminLevel": {Targets: []string{"account"}, Previous: previous(func(ctx context.Context, req *admin.SetAdminLevelReq) (any, error) {
+			return adminByAccount(ctx, req.Account)
+		})},
+		"AddAdminAccount": {Targets: []string{"account"}},
+		"DelAdminAccount": {Targets: []string{"account"}, Previous: previous(func(ctx context.Context, req *admin.DelAdminAccountReq) (any, error) {
+			return adminByAccount(ctx, req.Account)
+		})},
+		"SetAdminStatus": {Targets: []string{"account"}, Previous: previous(func(ctx context.Context, req *admin.SetAdminStatusReq) (any, error) {
+			return adminByAccount(ctx, req.Account)
+		})},
+		"BindIMAdmin": {Targets: []string{"account"}, Previous: previous(func(ctx context.Context, req *admin.BindIMAdminReq) (any, error) {
+			return adminByAccount(ctx, req.Account)
+		})},
+		"AddAdminIPAllow": {Targets: []string{"cidr"}},
+		"DelAdminIPAllow": {Targets: []string{"cidrs"}},
+
+		"AddDefaultFriend": {Targets: []string{"userIDs"}},
+		"DelDefaultFriend": {Targets: []string{"userIDs"}},
+		"AddDefaultGroup":  {Targets: []string{"groupIDs"}},
+		"DelDefaultGroup":  {Targets: []string{"groupIDs"}},
+
+		"AddInvitationCode": {Targets: []string{"codes"}},
+		"GenInvitationCode": {Targets: []string{"num"}},
+		"DelInvitationCode": {Targets: []string{"codes"}, Previous: previous(func(ctx context.Context, req *admin.DelInvitationCodeReq) (any, error) {
+			return o.Database.FindInvitationRegister(ctx, req.Codes)
+		})},
+
+		"AddIPForbidden": {Targets: []string{"forbiddens"}},
+		"DelIPForbidden": {Targets: []string{"ips"}, Previous: previous(func(ctx context.Context, req *admin.DelIPForbiddenReq) (any, error) {
+			return o.Database.FindIPForbidden(ctx, req.Ips)
+		})},
+		"AddUserIPLimitLogin": {Targets: []string{"limits"}},
+		"DelUserIPLimitLogin": {Targets: []string{"limits"}},
+
+		"CancellationUser": {Targets: []string{"userID"}},
+		"BlockUser":        {Targets: []string{"userID"}},
+		"UnblockUser": {Targets: []string{"userIDs"}, Previous: previous(func(ctx context.Context, req *admin.UnblockUserReq) (any, error) {
+			return o.Database.FindBlockInfo(ctx, req.UserIDs)
+		})},
+
+		"SetClientConfig": {Targets: []string{"config"}, Previous: previous(func(ctx context.Context, req *admin.SetClientConfigReq) (any, error) {
+			keys := make([]string, 0, len(req.Config))
+			for key := range req.Config {
+				keys = append(keys, key)
+			}
+			return config(ctx, keys)
+		})},
+		"DelClientConfig": {Targets: []string{"keys"}, Previous: previous(func(ctx context.Context, req *admin.DelClientConfigReq) (any, error) {
+			return config(ctx, req.Keys)
+		})},
+
+		"AddApplet": {Targets: []string{"id", "name"}},
+		"DelApplet": {Targets: []string{"appletIds"}},
+		"UpdateApplet": {Targets: []string{"id"}, Previous: previous(func(ctx context.Context, req *admin.UpdateAppletReq) (any, error) {
+			return o.Database.GetApplet(ctx, req.Id)
+		})},
+	}
+}
+
+func (o *adminServer) recordAudit(ctx context.Context, record *mw.AuditRecord) error {
+	return o.Database.AddAuditLog(ctx, &admin2.AuditLog{
+		OpUserID:   record.OpUserID,
+		Action:     record.Action,
+		Target:     record.Target,
+		Request:    record.Request,
+		Previous:   record.Previous,
+		IP:         record.IP,
+		ErrCode:    record.ErrCode,
+		ErrMsg:     record.ErrMsg,
+		CreateTime: record.Time,
+	})
+}
+
+func (o *adminServer) AddAuditLog(ctx context.Context, req *admin.AddAuditLogReq) (*admin.AddAuditLogResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	createTime := time.UnixMilli(req.CreateTime)
+	if req.CreateTime == 0 {
+		createTime = time.Now()
+	}
+	if err := o.recordAudit(ctx, &mw.AuditRecord{
+		OpUserID: opUserID,
+		Action:   req.Action,
+		Target:   req.Target,
+		Request:  req.Request,
+		Previous: req.Previous,
+		IP:       mctx.GetOpUserIP(ctx),
+		ErrCode:  req.ErrCode,
+		ErrMsg:   req.ErrMsg,
+		Time:     createTime,
+	}); err != nil {
+		return nil, err
+	}
+	return &admin.AddAuditLogResp{}, nil
+}
+
+func (o *adminServer) SearchAuditLog(ctx context.Context, req *admin.SearchAuditLogReq) (*admin.SearchAuditLogResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AuditRead); err != nil {
+		return nil, err
+	}
+	total, logs, err := o.Database.SearchAuditLog(ctx, req.OpUserID, req.Action, req.Keyword, time.UnixMilli(req.Start), time.UnixMilli(req.End), req.BeforeID, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAuditLogResp{Total: total, Logs: make([]*admin.AuditLogInfo, 0, len(logs))}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, DbToPbAuditLogInfo(l))
+	}
+	return resp, nil
+}
+
+func DbToPbAuditLogInfo(l *admin2.AuditLog) *admin.AuditLogInfo {
+	return &admin.AuditLogInfo{
+		Id:         l.ID,
+		OpUserID:   l.OpUserID,
+		Action:     l.Action,
+		Target:     l.Target,
+		Request:    l.Request,
+		Previous:   l.Previous,
+		Ip:         l.IP,
+		ErrCode:    l.ErrCode,
+		ErrMsg:     l.ErrMsg,
+		CreateTime: l.CreateTime.UnixMilli(),
+	}
+}
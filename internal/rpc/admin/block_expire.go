@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const (
+	defaultBlockExpireInterval = 60
+	blockExpireBatch           = 100
+)
+
+// blockExpireLoop 定时移除已到期的临时封禁, 并写入封号历史
+func (o *adminServer) blockExpireLoop() {
+	interval := config.Config.Block.ExpireInterval
+	if interval <= 0 {
+		interval = defaultBlockExpireInterval
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "blockExpire_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+		o.expireBlocks(ctx)
+	}
+}
+
+func (o *adminServer) expireBlocks(ctx context.Context) {
+	now := time.Now()
+	fs, err := o.Database.FindExpiredBlockUser(ctx, now, blockExpireBatch)
+	if err != nil {
+		log.ZError(ctx, "FindExpiredBlockUser", err)
+		return
+	}
+	for _, f := range fs {
+		deleted, err := o.Database.ExpireBlockUser(ctx, f, now)
+		if err != nil {
+			log.ZError(ctx, "ExpireBlockUser", err, "userID", f.UserID)
+			continue
+		}
+		if deleted {
+			log.ZInfo(ctx, "block expired", "userID", f.UserID, "category", f.Category, "expireTime", f.ExpireTime)
+		}
+	}
+}
@@ -17,6 +17,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/OpenIMSDK/tools/log"
 
@@ -63,7 +64,10 @@ func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckL
 		}
 	}
 	if forbiddenAccount, err := o.Database.GetBlockInfo(ctx, req.UserID); err == nil {
-		return nil, eerrs.ErrForbidden.Wrap(fmt.Sprintf("account forbidden: %s", forbiddenAccount.Reason))
+		// 已到期的临时封禁视为已解封, 由blockExpireLoop移入历史
+		if !forbiddenAccount.Expired(time.Now()) {
+			return nil, eerrs.ErrForbidden.Wrap(fmt.Sprintf("account forbidden: %s", forbiddenAccount.Reason))
+		}
 	} else if !dbutil.IsGormNotFound(err) {
 		return nil, err
 	}
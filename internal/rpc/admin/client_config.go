@@ -21,7 +21,7 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
@@ -36,7 +36,7 @@ func (o *adminServer) GetClientConfig(ctx context.Context, req *admin.GetClientC
 
 func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientConfigReq) (*admin.SetClientConfigResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.ClientConfigWrite); err != nil {
 		return nil, err
 	}
 	if len(req.Config) == 0 {
@@ -50,7 +50,7 @@ func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientC
 
 func (o *adminServer) DelClientConfig(ctx context.Context, req *admin.DelClientConfigReq) (*admin.DelClientConfigResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.ClientConfigWrite); err != nil {
 		return nil, err
 	}
 	if err := o.Database.DelConfig(ctx, req.Keys); err != nil {
@@ -27,13 +27,14 @@ import (
 
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvitationCodeReq) (*admin.AddInvitationCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.InvitationCodeWrite); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -67,7 +68,7 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 
 func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvitationCodeReq) (*admin.GenInvitationCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.InvitationCodeWrite); err != nil {
 		return nil, err
 	}
 	if req.Num <= 0 || req.Len <= 0 {
@@ -166,7 +167,7 @@ func (o *adminServer) UseInvitationCode(ctx context.Context, req *admin.UseInvit
 
 func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvitationCodeReq) (*admin.DelInvitationCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.InvitationCodeWrite); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -191,7 +192,7 @@ func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvit
 
 func (o *adminServer) SearchInvitationCode(ctx context.Context, req *admin.SearchInvitationCodeReq) (*admin.SearchInvitationCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.InvitationCodeRead); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.SearchInvitationRegister(ctx, req.Keyword, req.Status, req.UserIDs, req.Codes, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -0,0 +1,226 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/notify"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+func (o *adminServer) AddAdminIPAllow(ctx context.Context, req *admin.AddAdminIPAllowReq) (*admin.AddAdminIPAllowResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AdminWrite); err != nil {
+		return nil, err
+	}
+	cidr, err := normalizeCIDR(req.Cidr)
+	if err != nil {
+		return nil, err
+	}
+	allows, err := o.Database.FindAdminIPAllow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cidrs := make([]string, 0, len(allows)+1)
+	for _, allow := range allows {
+		if allow.CIDR == cidr {
+			return nil, errs.ErrDuplicateKey.Wrap("cidr already exists")
+		}
+		cidrs = append(cidrs, allow.CIDR)
+	}
+	if err := checkSelfLockout(ctx, append(cidrs, cidr)); err != nil {
+		return nil, err
+	}
+	if err := o.Database.AddAdminIPAllow(ctx, []*admin2.AdminIPAllow{{CIDR: cidr, Remark: req.Remark, CreateTime: time.Now()}}); err != nil {
+		return nil, err
+	}
+	return &admin.AddAdminIPAllowResp{}, nil
+}
+
+func (o *adminServer) DelAdminIPAllow(ctx context.Context, req *admin.DelAdminIPAllowReq) (*admin.DelAdminIPAllowResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AdminWrite); err != nil {
+		return nil, err
+	}
+	allows, err := o.Database.FindAdminIPAllow(ctx)
+	if err != nil {
+		return nil, err
+	}
+	del := make(map[string]struct{}, len(req.Cidrs))
+	for _, cidr := range req.Cidrs {
+		del[cidr] = struct{}{}
+	}
+	cidrs := make([]string, 0, len(allows))
+	for _, allow := range allows {
+		if _, ok := del[allow.CIDR]; !ok {
+			cidrs = append(cidrs, allow.CIDR)
+		}
+	}
+	if err := checkSelfLockout(ctx, cidrs); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelAdminIPAllow(ctx, req.Cidrs); err != nil {
+		return nil, err
+	}
+	return &admin.DelAdminIPAllowResp{}, nil
+}
+
+func (o *adminServer) SearchAdminIPAllow(ctx context.Context, req *admin.SearchAdminIPAllowReq) (*admin.SearchAdminIPAllowResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.AdminRead); err != nil {
+		return nil, err
+	}
+	total, allows, err := o.Database.SearchAdminIPAllow(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAdminIPAllowResp{
+		Total: total,
+		Items: make([]*admin.AdminIPAllowInfo, 0, len(allows)),
+	}
+	for _, allow := range allows {
+		resp.Items = append(resp.Items, &admin.AdminIPAllowInfo{
+			Cidr:       allow.CIDR,
+			Remark:     allow.Remark,
+			CreateTime: allow.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// CheckAdminIP 校验ip是否在管理后台白名单中, 白名单为空时不限制.
+func (o *adminServer) CheckAdminIP(ctx context.Context, req *admin.CheckAdminIPReq) (*admin.CheckAdminIPResp, error) {
+	cidrs, err := o.Database.FindAdminIPAllowCIDR(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !ipAllowed(req.Ip, cidrs) {
+		return nil, eerrs.ErrAdminIPNotAllowed.Wrap(req.Ip)
+	}
+	return &admin.CheckAdminIPResp{}, nil
+}
+
+// normalizeCIDR 单个ip转换为/32或/128的网段.
+func normalizeCIDR(s string) (string, error) {
+	if ip := net.ParseIP(s); ip != nil {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4.String() + "/32", nil
+		}
+		return ip.String() + "/128", nil
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		return "", errs.ErrArgs.Wrap("invalid cidr " + s)
+	}
+	return ipNet.String(), nil
+}
+
+func ipAllowed(ip string, cidrs []string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			continue
+		}
+		if ipNet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkSelfLockout 修改后的白名单不能把当前操作者排除在外.
+func checkSelfLockout(ctx context.Context, cidrs []string) error {
+	ip := mctx.GetOpUserIP(ctx)
+	if ip == "" {
+		return nil
+	}
+	if !ipAllowed(ip, cidrs) {
+		return errs.ErrArgs.Wrap(fmt.Sprintf("current ip %s would be blocked by the allowlist", ip))
+	}
+	return nil
+}
+
+// recordLogin 记录管理员登录, 从新的ip或国家登录时发送提醒, 首次登录不提醒.
+func (o *adminServer) recordLogin(ctx context.Context, a *admin2.Admin, ip string) {
+	if ip == "" {
+		return
+	}
+	ctx = mcontext.SetOperationID(context.Background(), mcontext.GetOperationID(ctx))
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				log.ZError(ctx, "recordLogin panic", nil, "panic", r)
+			}
+		}()
+		country, err := o.Locator.Country(ctx, ip)
+		if err != nil {
+			log.ZWarn(ctx, "geoip lookup failed", err, "ip", ip)
+		}
+		total, ipCount, countryCount, err := o.Database.CountAdminLogin(ctx, a.UserID, ip, country)
+		if err != nil {
+			log.ZError(ctx, "CountAdminLogin", err, "userID", a.UserID)
+			return
+		}
+		now := time.Now()
+		record := &admin2.AdminLoginRecord{UserID: a.UserID, IP: ip, Country: country, LoginTime: now}
+		if err := o.Database.AddAdminLoginRecord(ctx, record); err != nil {
+			log.ZError(ctx, "AddAdminLoginRecord", err, "userID", a.UserID)
+		}
+		if total == 0 {
+			return
+		}
+		newIP := ipCount == 0
+		newCountry := country != "" && countryCount == 0
+		if !newIP && !newCountry {
+			return
+		}
+		event := &notify.Event{
+			Type:    notify.EventAdminLoginAnomaly,
+			Title:   "admin login from new location",
+			Content: fmt.Sprintf("admin %s logged in from ip %s (country %q)", a.Account, ip, country),
+			Data: map[string]any{
+				"userID":     a.UserID,
+				"account":    a.Account,
+				"ip":         ip,
+				"country":    country,
+				"newIP":      newIP,
+				"newCountry": newCountry,
+			},
+			Time: now.UnixMilli(),
+		}
+		if err := o.Notifier.Notify(ctx, event); err != nil {
+			log.ZError(ctx, "notify admin login anomaly failed", err, "notifier", o.Notifier.Name(), "userID", a.UserID)
+		}
+	}()
+}
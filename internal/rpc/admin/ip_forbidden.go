@@ -21,13 +21,13 @@ import (
 	"github.com/OpenIMSDK/tools/log"
 
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIPForbiddenReq) (*admin.SearchIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.ForbiddenRead); err != nil {
 		return nil, err
 	}
 	total, forbiddens, err := o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -51,7 +51,7 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 
 func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbiddenReq) (*admin.AddIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.ForbiddenWrite); err != nil {
 		return nil, err
 	}
 	now := time.Now()
@@ -72,7 +72,7 @@ func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbid
 
 func (o *adminServer) DelIPForbidden(ctx context.Context, req *admin.DelIPForbiddenReq) (*admin.DelIPForbiddenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.ForbiddenWrite); err != nil {
 		return nil, err
 	}
 	if err := o.Database.DelIPForbidden(ctx, req.Ips); err != nil {
@@ -26,13 +26,14 @@ import (
 
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/common"
 )
 
 func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaultFriendReq) (*admin.AddDefaultFriendResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.DefaultWrite); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -71,7 +72,7 @@ func (o *adminServer) AddDefaultFriend(ctx context.Context, req *admin.AddDefaul
 
 func (o *adminServer) DelDefaultFriend(ctx context.Context, req *admin.DelDefaultFriendReq) (*admin.DelDefaultFriendResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.DefaultWrite); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -115,7 +116,7 @@ func (o *adminServer) FindDefaultFriend(ctx context.Context, req *admin.FindDefa
 
 func (o *adminServer) SearchDefaultFriend(ctx context.Context, req *admin.SearchDefaultFriendReq) (*admin.SearchDefaultFriendResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.DefaultRead); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchDefaultFriend(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
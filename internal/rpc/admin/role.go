@@ -0,0 +1,142 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+// checkPermission 校验当前管理员角色是否拥有权限, 服务内部使用配置的管理员身份调用时不受限制
+func (o *adminServer) checkPermission(ctx context.Context, permission rbac.Permission) error {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) && isSystemAdmin(userID) {
+			return nil
+		}
+		return err
+	}
+	if !rbac.Allowed(a.Level, permission) {
+		return errs.ErrNoPermission.Wrap(fmt.Sprintf("admin level %d has no permission %s", a.Level, permission))
+	}
+	return nil
+}
+
+// isSystemAdmin mctx.WithAdminUser使用的管理员身份
+func isSystemAdmin(userID string) bool {
+	for _, a := range config.Config.AdminList {
+		if a.AdminID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *adminServer) CheckPermission(ctx context.Context, req *admin.CheckPermissionReq) (*admin.CheckPermissionResp, error) {
+	if err := o.checkPermission(ctx, rbac.Permission(req.Permission)); err != nil {
+		return nil, err
+	}
+	return &admin.CheckPermissionResp{}, nil
+}
+
+func (o *adminServer) FindRole(ctx context.Context, req *admin.FindRoleReq) (*admin.FindRoleResp, error) {
+	if err := o.checkPermission(ctx, rbac.RoleRead); err != nil {
+		return nil, err
+	}
+	roles := rbac.Roles()
+	resp := &admin.FindRoleResp{Roles: make([]*admin.RoleInfo, 0, len(roles))}
+	for _, r := range roles {
+		resp.Roles = append(resp.Roles, &admin.RoleInfo{Level: r.Level, Name: r.Name, Permissions: r.PermissionList()})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) SearchAdmin(ctx context.Context, req *admin.SearchAdminReq) (*admin.SearchAdminResp, error) {
+	if err := o.checkPermission(ctx, rbac.AdminRead); err != nil {
+		return nil, err
+	}
+	total, admins, err := o.Database.SearchAdmin(ctx, req.Keyword, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAdminResp{Total: total, Admins: make([]*admin.AdminInfo, 0, len(admins))}
+	for _, a := range admins {
+		resp.Admins = append(resp.Admins, DbToPbAdminInfo(a))
+	}
+	return resp, nil
+}
+
+func (o *adminServer) SetAdminLevel(ctx context.Context, req *admin.SetAdminLevelReq) (*admin.SetAdminLevelResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.RoleWrite); err != nil {
+		return nil, err
+	}
+	if rbac.GetRole(req.Level) == nil {
+		return nil, errs.ErrArgs.Wrap(fmt.Sprintf("level %d is not a role", req.Level))
+	}
+	a, err := o.Database.GetAdmin(ctx, req.Account)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap()
+		}
+		return nil, err
+	}
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if a.UserID == opUserID {
+		return nil, errs.ErrArgs.Wrap("can not change own level")
+	}
+	if err := o.Database.UpdateAdmin(ctx, a.UserID, map[string]any{"level": req.Level}); err != nil {
+		return nil, err
+	}
+	return &admin.SetAdminLevelResp{}, nil
+}
+
+func permissionList(level int32) []string {
+	if r := rbac.GetRole(level); r != nil {
+		return r.PermissionList()
+	}
+	return []string{}
+}
+
+func DbToPbAdminInfo(a *admin2.Admin) *admin.AdminInfo {
+	return &admin.AdminInfo{
+		Account:    a.Account,
+		FaceURL:    a.FaceURL,
+		Nickname:   a.Nickname,
+		UserID:     a.UserID,
+		Level:      a.Level,
+		CreateTime: a.CreateTime.UnixMilli(),
+		ImUserID:   imAdminUserID(a),
+		Status:     a.Status,
+	}
+}
@@ -16,17 +16,30 @@ package admin
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"time"
 
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
 	"github.com/OpenIMSDK/chat/pkg/common/tokenverify"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 func (o *adminServer) CreateToken(ctx context.Context, req *admin.CreateTokenReq) (*admin.CreateTokenResp, error) {
 	defer log.ZDebug(ctx, "return")
-	token, err := tokenverify.CreateToken(req.UserID, req.UserType, *config.Config.TokenPolicy.Expire)
+	token, err := tokenverify.CreateTokenWithTTL(req.UserID, req.UserType, accessTokenTTL())
 	if err != nil {
 		return nil, err
 	}
@@ -34,11 +47,187 @@ func (o *adminServer) CreateToken(ctx context.Context, req *admin.CreateTokenReq
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now().UnixMilli()
+	session := &cache.TokenSession{LoginTime: now, LastSeen: now}
+	if req.Session != nil {
+		session.Platform = req.Session.Platform
+		session.DeviceID = req.Session.DeviceID
+		session.IP = req.Session.Ip
+	}
+	var refreshToken string
+	if refreshTokenTTL() > 0 {
+		session.RefreshFamily, refreshToken, err = o.newRefreshFamily(ctx, req.UserID, req.UserType, token, session)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.SetTokenSession(ctx, req.UserID, token, session); err != nil {
+		return nil, err
+	}
+	o.pruneTokens(ctx, req.UserID)
 	return &admin.CreateTokenResp{
-		Token: token,
+		Token:        token,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// accessTokenTTL 未配置accessExpire时与之前一致, 使用expire(天)
+func accessTokenTTL() time.Duration {
+	if config.Config.TokenPolicy.AccessExpire > 0 {
+		return time.Duration(config.Config.TokenPolicy.AccessExpire) * time.Minute
+	}
+	return time.Duration(*config.Config.TokenPolicy.Expire) * 24 * time.Hour
+}
+
+func refreshTokenTTL() time.Duration {
+	return time.Duration(config.Config.TokenPolicy.RefreshExpire) * 24 * time.Hour
+}
+
+// refreshToken 格式为 familyID.secret, 服务端只保存摘要
+func genRefreshToken(familyID string) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return familyID + "." + hex.EncodeToString(secret), nil
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (o *adminServer) newRefreshFamily(ctx context.Context, userID string, userType int32, token string, session *cache.TokenSession) (string, string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", errs.Wrap(err)
+	}
+	familyID := hex.EncodeToString(id)
+	refreshToken, err := genRefreshToken(familyID)
+	if err != nil {
+		return "", "", err
+	}
+	family := &cache.RefreshFamily{
+		UserID:    userID,
+		UserType:  userType,
+		Refresh:   hashRefreshToken(refreshToken),
+		Token:     token,
+		Platform:  session.Platform,
+		DeviceID:  session.DeviceID,
+		IP:        session.IP,
+		LoginTime: session.LoginTime,
+	}
+	if err := o.Database.SetRefreshFamily(ctx, familyID, family, refreshTokenTTL()); err != nil {
+		return "", "", err
+	}
+	return familyID, refreshToken, nil
+}
+
+func (o *adminServer) RefreshToken(ctx context.Context, req *admin.RefreshTokenReq) (*admin.RefreshTokenResp, error) {
+	defer log.ZDebug(ctx, "return")
+	familyID, _, ok := strings.Cut(req.RefreshToken, ".")
+	if !ok || familyID == "" {
+		return nil, errs.ErrTokenMalformed.Wrap("refresh token malformed")
+	}
+	family, err := o.Database.GetRefreshFamily(ctx, familyID)
+	if err != nil {
+		return nil, err
+	}
+	if family == nil {
+		return nil, errs.ErrTokenExpired.Wrap("refresh token expired")
+	}
+	if family.UserType != req.UserType {
+		return nil, errs.ErrTokenInvalid.Wrap("refresh token type error")
+	}
+	if err := o.checkRefreshUser(ctx, family, req.Ip); err != nil {
+		// 账号状态不允许登录时吊销整条链, 基础设施错误不吊销
+		if _, ok := errs.Unwrap(err).(errs.CodeError); ok {
+			if err := o.revokeRefreshFamily(ctx, family, familyID); err != nil {
+				log.ZError(ctx, "revokeRefreshFamily", err, "userID", family.UserID, "familyID", familyID)
+			}
+		}
+		return nil, err
+	}
+	token, err := tokenverify.CreateTokenWithTTL(family.UserID, family.UserType, accessTokenTTL())
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := genRefreshToken(familyID)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := o.Database.RotateRefreshFamily(ctx, family.UserID, familyID, hashRefreshToken(req.RefreshToken), hashRefreshToken(refreshToken), token, refreshTokenTTL())
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		// 已经轮换过的refresh token被再次使用, 可能已泄露, 吊销整条链
+		log.ZWarn(ctx, "refresh token reused", nil, "userID", family.UserID, "familyID", familyID)
+		if err := o.revokeRefreshFamily(ctx, family, familyID); err != nil {
+			return nil, err
+		}
+		return nil, errs.ErrTokenKicked.Wrap("refresh token reused")
+	}
+	if err := o.Database.CacheToken(ctx, family.UserID, token); err != nil {
+		return nil, err
+	}
+	session := &cache.TokenSession{
+		Platform:      family.Platform,
+		DeviceID:      family.DeviceID,
+		IP:            family.IP,
+		LoginTime:     family.LoginTime,
+		LastSeen:      time.Now().UnixMilli(),
+		RefreshFamily: familyID,
+	}
+	if req.Ip != "" {
+		session.IP = req.Ip
+	}
+	if err := o.Database.SetTokenSession(ctx, family.UserID, token, session); err != nil {
+		return nil, err
+	}
+	if family.Token != "" {
+		if err := o.Database.KickTokens(ctx, family.UserID, []string{family.Token}); err != nil {
+			log.ZError(ctx, "refresh token KickTokens", err, "userID", family.UserID)
+		}
+	}
+	o.pruneTokens(ctx, family.UserID)
+	return &admin.RefreshTokenResp{
+		UserID:       family.UserID,
+		Token:        token,
+		RefreshToken: refreshToken,
+		Platform:     family.Platform,
 	}, nil
 }
 
+// checkRefreshUser 刷新前重新校验账号状态, 与登录时的校验一致
+func (o *adminServer) checkRefreshUser(ctx context.Context, family *cache.RefreshFamily, ip string) error {
+	if family.UserType == constant.AdminUser {
+		a, err := o.Database.GetAdminUserID(ctx, family.UserID)
+		if err != nil {
+			if dbutil.IsGormNotFound(err) {
+				return errs.ErrTokenInvalid.Wrap("admin not found")
+			}
+			return err
+		}
+		return checkAdminStatus(a)
+	}
+	if _, err := o.CheckLoginForbidden(ctx, &admin.CheckLoginForbiddenReq{Ip: ip, UserID: family.UserID}); err != nil {
+		return err
+	}
+	return o.Chat.CheckUserActive(ctx, family.UserID)
+}
+
+// revokeRefreshFamily 吊销refresh token链及其当前access token
+func (o *adminServer) revokeRefreshFamily(ctx context.Context, family *cache.RefreshFamily, familyID string) error {
+	if err := o.Database.DelRefreshFamilies(ctx, family.UserID, []string{familyID}); err != nil {
+		return err
+	}
+	if family.Token == "" {
+		return nil
+	}
+	return o.Database.KickTokens(ctx, family.UserID, []string{family.Token})
+}
+
 func (*adminServer) ParseToken(ctx context.Context, req *admin.ParseTokenReq) (*admin.ParseTokenResp, error) {
 	userID, userType, err := tokenverify.GetToken(req.Token)
 	if err != nil {
@@ -55,6 +244,262 @@ func (o *adminServer) GetUserToken(ctx context.Context, req *admin.GetUserTokenR
 	if err != nil {
 		return nil, err
 	}
-
+	if req.Token != "" && tokensMap[req.Token] == constant2.NormalToken {
+		o.touchTokenSession(ctx, req.UserID, req.Token, req.Ip)
+	}
 	return &admin.GetUserTokenResp{TokensMap: tokensMap}, nil
 }
+
+// touchTokenSession 更新登录设备的最后活跃时间, 一分钟内最多写一次
+func (o *adminServer) touchTokenSession(ctx context.Context, userID string, token string, ip string) {
+	session, err := o.Database.GetTokenSession(ctx, userID, token)
+	if err != nil {
+		log.ZError(ctx, "touch token session GetTokenSession", err, "userID", userID)
+		return
+	}
+	if session == nil {
+		return
+	}
+	now := time.Now().UnixMilli()
+	if now-session.LastSeen < time.Minute.Milliseconds() && (ip == "" || ip == session.IP) {
+		return
+	}
+	session.LastSeen = now
+	if ip != "" {
+		session.IP = ip
+	}
+	if err := o.Database.SetTokenSession(ctx, userID, token, session); err != nil {
+		log.ZError(ctx, "touch token session SetTokenSession", err, "userID", userID)
+	}
+}
+
+func (o *adminServer) GetUserSessions(ctx context.Context, req *admin.GetUserSessionsReq) (*admin.GetUserSessionsResp, error) {
+	if err := o.checkSessionOwner(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	tokensMap, err := o.Database.GetTokens(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessionsMap, err := o.Database.GetTokenSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]*admin.SessionInfo, 0, len(sessionsMap))
+	listed := make(map[string]struct{})
+	for token, session := range sessionsMap {
+		if flag, ok := tokensMap[token]; !ok || flag != constant2.NormalToken {
+			continue
+		}
+		if _, _, err := tokenverify.GetToken(token); err != nil {
+			continue
+		}
+		if session.RefreshFamily != "" {
+			listed[session.RefreshFamily] = struct{}{}
+		}
+		sessions = append(sessions, &admin.SessionInfo{
+			SessionID:    sessionID(token, session),
+			Platform:     session.Platform,
+			DeviceID:     session.DeviceID,
+			Ip:           session.IP,
+			LoginTime:    session.LoginTime,
+			LastSeenTime: session.LastSeen,
+			Current:      token == req.Token,
+		})
+	}
+	// access token已过期但refresh token仍有效的设备
+	familyIDs, err := o.Database.GetRefreshFamilyIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, familyID := range familyIDs {
+		if _, ok := listed[familyID]; ok {
+			continue
+		}
+		family, err := o.Database.GetRefreshFamily(ctx, familyID)
+		if err != nil {
+			return nil, err
+		}
+		if family == nil {
+			continue
+		}
+		sessions = append(sessions, &admin.SessionInfo{
+			SessionID:    familyID,
+			Platform:     family.Platform,
+			DeviceID:     family.DeviceID,
+			Ip:           family.IP,
+			LoginTime:    family.LoginTime,
+			LastSeenTime: family.LoginTime,
+		})
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenTime > sessions[j].LastSeenTime
+	})
+	return &admin.GetUserSessionsResp{Sessions: sessions}, nil
+}
+
+func (o *adminServer) RevokeUserSession(ctx context.Context, req *admin.RevokeUserSessionReq) (*admin.RevokeUserSessionResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkSessionOwner(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	sessionsMap, err := o.Database.GetTokenSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	for token, session := range sessionsMap {
+		if sessionID(token, session) != req.SessionID {
+			continue
+		}
+		if err := o.revokeTokens(ctx, req.UserID, []string{token}); err != nil {
+			return nil, err
+		}
+		o.pruneTokens(ctx, req.UserID)
+		return &admin.RevokeUserSessionResp{Platform: session.Platform, RevokedToken: true}, nil
+	}
+	family, err := o.Database.GetRefreshFamily(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if family == nil || family.UserID != req.UserID {
+		return nil, errs.ErrRecordNotFound.Wrap("session not found")
+	}
+	if err := o.Database.DelRefreshFamilies(ctx, req.UserID, []string{req.SessionID}); err != nil {
+		return nil, err
+	}
+	return &admin.RevokeUserSessionResp{Platform: family.Platform}, nil
+}
+
+// revokeTokens 踢下线token, 并吊销其refresh token
+func (o *adminServer) revokeTokens(ctx context.Context, userID string, tokens []string) error {
+	sessionsMap, err := o.Database.GetTokenSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+	var familyIDs []string
+	for _, token := range tokens {
+		if session, ok := sessionsMap[token]; ok && session.RefreshFamily != "" {
+			familyIDs = append(familyIDs, session.RefreshFamily)
+		}
+	}
+	if err := o.Database.DelRefreshFamilies(ctx, userID, familyIDs); err != nil {
+		return err
+	}
+	return o.Database.KickTokens(ctx, userID, tokens)
+}
+
+func (o *adminServer) checkSessionOwner(ctx context.Context, userID string) error {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if userType != constant.AdminUser && userID != opUserID {
+		return errs.ErrNoPermission.Wrap("no permission access other user session")
+	}
+	return nil
+}
+
+// sessionID 对外暴露的设备标识, 避免直接返回token. 有refresh token时刷新前后保持不变
+func sessionID(token string, session *cache.TokenSession) string {
+	if session.RefreshFamily != "" {
+		return session.RefreshFamily
+	}
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (o *adminServer) InvalidateToken(ctx context.Context, req *admin.InvalidateTokenReq) (*admin.InvalidateTokenResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserID == "" {
+		req.UserID = opUserID
+	}
+	if userType != constant.AdminUser && req.UserID != opUserID {
+		return nil, errs.ErrNoPermission.Wrap("no permission invalidate other user token")
+	}
+	var (
+		tokens    []string
+		platforms []int32
+	)
+	if req.Token == "" {
+		tokensMap, err := o.Database.GetTokens(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		sessionsMap, err := o.Database.GetTokenSessions(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		var keep *cache.TokenSession
+		if req.ExceptToken != "" {
+			keep = sessionsMap[req.ExceptToken]
+		}
+		for token, flag := range tokensMap {
+			if flag != constant2.NormalToken || token == req.ExceptToken {
+				continue
+			}
+			tokens = append(tokens, token)
+			if session, ok := sessionsMap[token]; ok && (keep == nil || session.Platform != keep.Platform) && !utils.Contain(session.Platform, platforms...) {
+				platforms = append(platforms, session.Platform)
+			}
+		}
+		familyIDs, err := o.Database.GetRefreshFamilyIDs(ctx, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if keep != nil && keep.RefreshFamily != "" {
+			ids := familyIDs[:0]
+			for _, id := range familyIDs {
+				if id != keep.RefreshFamily {
+					ids = append(ids, id)
+				}
+			}
+			familyIDs = ids
+		}
+		if err := o.Database.DelRefreshFamilies(ctx, req.UserID, familyIDs); err != nil {
+			return nil, err
+		}
+	} else {
+		userID, _, err := tokenverify.GetToken(req.Token)
+		if err != nil {
+			return nil, err
+		}
+		if userID != req.UserID {
+			return nil, errs.ErrNoPermission.Wrap("token not belong to user")
+		}
+		tokens = []string{req.Token}
+	}
+	if err := o.revokeTokens(ctx, req.UserID, tokens); err != nil {
+		return nil, err
+	}
+	o.pruneTokens(ctx, req.UserID)
+	return &admin.InvalidateTokenResp{Platforms: platforms}, nil
+}
+
+// pruneTokens 删除已过期的token, 并让整个hash在最后一个token过期后自动删除
+func (o *adminServer) pruneTokens(ctx context.Context, userID string) {
+	tokensMap, err := o.Database.GetTokens(ctx, userID)
+	if err != nil {
+		log.ZError(ctx, "prune tokens GetTokens", err, "userID", userID)
+		return
+	}
+	var expired []string
+	for token := range tokensMap {
+		if _, _, err := tokenverify.GetToken(token); err != nil {
+			expired = append(expired, token)
+		}
+	}
+	if err := o.Database.DeleteTokens(ctx, userID, expired); err != nil {
+		log.ZError(ctx, "prune tokens DeleteTokens", err, "userID", userID, "expired", len(expired))
+	}
+	if len(expired) == len(tokensMap) {
+		return
+	}
+	expire := accessTokenTTL()
+	if err := o.Database.ExpireTokens(ctx, userID, expire); err != nil {
+		log.ZError(ctx, "prune tokens ExpireTokens", err, "userID", userID)
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
+	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/totp"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+)
+
+const (
+	defaultMaxLoginFailed = 5
+	defaultLoginLockTime  = 900
+)
+
+func (o *adminServer) EnrollTOTP(ctx context.Context, req *admin.EnrollTOTPReq) (*admin.EnrollTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err == nil {
+		if t.Enabled {
+			return nil, errs.ErrArgs.Wrap("totp already enabled")
+		}
+	} else if !dbutil.IsGormNotFound(err) {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now()
+	if err := o.Database.SaveTOTP(ctx, &admin2.AdminTOTP{
+		UserID:     userID,
+		Secret:     secret,
+		Enabled:    false,
+		CreateTime: now,
+		ChangeTime: now,
+	}); err != nil {
+		return nil, err
+	}
+	return &admin.EnrollTOTPResp{
+		Secret: secret,
+		Url:    totp.URL(config.Config.TOTP.Issuer, a.Account, secret),
+	}, nil
+}
+
+func (o *adminServer) ConfirmTOTP(ctx context.Context, req *admin.ConfirmTOTPReq) (*admin.ConfirmTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil, errs.ErrArgs.Wrap("totp not enrolled")
+		}
+		return nil, err
+	}
+	if t.Enabled {
+		return nil, errs.ErrArgs.Wrap("totp already enabled")
+	}
+	update, codes, err := totp.Confirm(t.Secret, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTOTP(ctx, userID, update); err != nil {
+		return nil, err
+	}
+	return &admin.ConfirmTOTPResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) DisableTOTP(ctx context.Context, req *admin.DisableTOTPReq) (*admin.DisableTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return &admin.DisableTOTPResp{}, nil
+		}
+		return nil, err
+	}
+	if t.Enabled {
+		if err := totp.CheckCode(ctx, o.Database, t.UserID, t.Secret, t.RecoveryCodes, req.Code); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.DelTOTP(ctx, userID); err != nil {
+		return nil, err
+	}
+	return &admin.DisableTOTPResp{}, nil
+}
+
+func (o *adminServer) VerifyTOTPLogin(ctx context.Context, req *admin.VerifyTOTPLoginReq) (*admin.LoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := totp.TakeChallenge(ctx, o.Database, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLocked(ctx, a.Account); err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := totp.VerifyChallenge(ctx, o.Database, req.ChallengeToken, userID, t.Secret, t.RecoveryCodes, req.Code); err != nil {
+		if eerrs.ErrTOTPNotMatch.Is(err) {
+			o.loginFailed(ctx, a.Account)
+		}
+		return nil, err
+	}
+	return o.loginSuccess(ctx, a, req.Ip)
+}
+
+// verifyTOTPOwner 开启了二次验证的管理员修改密码等敏感操作时还需要校验动态码或恢复码
+func (o *adminServer) verifyTOTPOwner(ctx context.Context, a *admin2.Admin, code string) error {
+	t, err := o.Database.TakeTOTP(ctx, a.UserID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !t.Enabled {
+		return nil
+	}
+	if code == "" {
+		return errs.ErrArgs.Wrap("totp code is empty")
+	}
+	if err := totp.CheckCode(ctx, o.Database, t.UserID, t.Secret, t.RecoveryCodes, code); err != nil {
+		if eerrs.ErrTOTPNotMatch.Is(err) {
+			o.loginFailed(ctx, a.Account)
+		}
+		return err
+	}
+	return nil
+}
+
+// loginChallenge 管理员开启二次验证时生成登录凭证, 未开启返回空
+func (o *adminServer) loginChallenge(ctx context.Context, userID string) (string, error) {
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if dbutil.IsGormNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !t.Enabled {
+		return "", nil
+	}
+	return totp.NewChallenge(ctx, o.Database, userID)
+}
+
+func (o *adminServer) loginLockKey(account string) string {
+	return "admin:" + account
+}
+
+func (o *adminServer) loginLockTime() time.Duration {
+	lockTime := config.Config.AdminLogin.LockTime
+	if lockTime <= 0 {
+		lockTime = defaultLoginLockTime
+	}
+	return time.Duration(lockTime) * time.Second
+}
+
+// checkLoginLocked 账号处于锁定期时返回剩余锁定时间
+func (o *adminServer) checkLoginLocked(ctx context.Context, account string) error {
+	ttl, err := o.Database.LoginLockTTL(ctx, o.loginLockKey(account))
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		return eerrs.ErrAccountLocked.Wrap(fmt.Sprintf("account locked, retry after %d seconds", mctx.SetRetryAfter(ctx, ttl)))
+	}
+	return nil
+}
+
+// loginFailed 记录一次失败, 达到上限后锁定账号
+func (o *adminServer) loginFailed(ctx context.Context, account string) {
+	maxFailed := config.Config.AdminLogin.MaxFailed
+	if maxFailed <= 0 {
+		maxFailed = defaultMaxLoginFailed
+	}
+	key := o.loginLockKey(account)
+	count, err := o.Database.IncrLoginFailed(ctx, key, o.loginLockTime())
+	if err != nil {
+		log.ZError(ctx, "IncrLoginFailed", err, "account", account)
+		return
+	}
+	if count < int64(maxFailed) {
+		return
+	}
+	if err := o.Database.LockLogin(ctx, key, o.loginLockTime()); err != nil {
+		log.ZError(ctx, "LockLogin", err, "account", account)
+		return
+	}
+	log.ZWarn(ctx, "admin account locked", nil, "account", account, "failed", count)
+}
+
+func (o *adminServer) loginSuccess(ctx context.Context, a *admin2.Admin, ip string) (*admin.LoginResp, error) {
+	if err := checkAdminStatus(a); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UnlockLogin(ctx, o.loginLockKey(a.Account)); err != nil {
+		log.ZError(ctx, "UnlockLogin", err, "account", a.Account)
+	}
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{
+		UserID:   a.UserID,
+		UserType: constant.AdminUser,
+		Session:  &admin.TokenSession{Platform: constant2.AdminPlatformID, Ip: ip},
+	})
+	if err != nil {
+		return nil, err
+	}
+	o.recordLogin(ctx, a, ip)
+	return &admin.LoginResp{
+		AdminUserID:  a.UserID,
+		AdminAccount: a.Account,
+		AdminToken:   adminToken.Token,
+		Nickname:     a.Nickname,
+		FaceURL:      a.FaceURL,
+		Level:        a.Level,
+		RefreshToken: adminToken.RefreshToken,
+	}, nil
+}
@@ -21,12 +21,13 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
 
 type Admin struct {
 	Account    string    `gorm:"column:account;primary_key;type:char(64)"`
-	Password   string    `gorm:"column:password;type:char(64)"`
+	Password   string    `gorm:"column:password;type:varchar(255)"`
 	FaceURL    string    `gorm:"column:face_url;type:char(64)"`
 	Nickname   string    `gorm:"column:nickname;type:char(64)"`
 	UserID     string    `gorm:"column:user_id;type:char(64)"` // openIM userID
@@ -46,7 +47,14 @@ func ToDBAdminUpdate(req *admin.AdminUpdateInfoReq) (map[string]any, error) {
 		if req.Password.Value == "" {
 			return nil, errs.ErrArgs.Wrap("password is empty")
 		}
-		update["password"] = req.Password.Value
+		if err := passwd.CheckPolicy(req.Password.Value); err != nil {
+			return nil, err
+		}
+		password, err := passwd.Hash(req.Password.Value)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		update["password"] = password
 	}
 	if req.FaceURL != nil {
 		update["face_url"] = req.FaceURL.Value
@@ -73,7 +81,11 @@ func ToDBAdminUpdatePassword(password string) (map[string]any, error) {
 	if password == "" {
 		return nil, errs.ErrArgs.Wrap("password is empty")
 	}
-	return map[string]any{"password": password}, nil
+	hashed, err := passwd.Hash(password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return map[string]any{"password": hashed}, nil
 }
 
 func ToDBAppletUpdate(req *admin.UpdateAppletReq) (map[string]any, error) {
@@ -29,14 +29,14 @@ import (
 
 	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 )
 
 func (o *adminServer) CancellationUser(ctx context.Context, req *admin.CancellationUserReq) (*admin.CancellationUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	_, err := mctx.CheckAdmin(ctx)
+	err := o.checkPermission(ctx, rbac.BlockWrite)
 	if err != nil {
 		return nil, err
 	}
@@ -61,13 +61,24 @@ func (o *adminServer) CancellationUser(ctx context.Context, req *admin.Cancellat
 
 func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*admin.BlockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	_, err := mctx.CheckAdmin(ctx)
+	err := o.checkPermission(ctx, rbac.BlockWrite)
 	if err != nil {
 		return nil, err
 	}
-	_, err = o.Database.GetBlockInfo(ctx, req.UserID)
+	category, err := blockCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	f, err := o.Database.GetBlockInfo(ctx, req.UserID)
 	if err == nil {
-		return nil, errs.ErrArgs.Wrap("user already blocked")
+		if !f.Expired(now) {
+			return nil, errs.ErrArgs.Wrap("user already blocked")
+		}
+		// 已到期但还未被清理的封禁, 先移入历史
+		if _, err := o.Database.ExpireBlockUser(ctx, f, now); err != nil {
+			return nil, err
+		}
 	} else if !dbutil.IsGormNotFound(err) {
 		return nil, err
 	}
@@ -76,7 +87,12 @@ func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*
 		UserID:         req.UserID,
 		Reason:         req.Reason,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
-		CreateTime:     time.Now(),
+		CreateTime:     now,
+		Category:       category,
+	}
+	if req.Days > 0 {
+		expireTime := now.AddDate(0, 0, int(req.Days))
+		t.ExpireTime = &expireTime
 	}
 	if err := o.Database.BlockUser(ctx, []*admin2.ForbiddenAccount{t}); err != nil {
 		return nil, err
@@ -86,7 +102,7 @@ func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*
 
 func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq) (*admin.UnblockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.BlockWrite); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -103,7 +119,7 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 		ids := utils.Single(req.UserIDs, utils.Slice(bs, func(info *admin2.ForbiddenAccount) string { return info.UserID }))
 		return nil, errs.ErrArgs.Wrap("user not blocked " + strings.Join(ids, ", "))
 	}
-	if err := o.Database.DelBlockUser(ctx, req.UserIDs); err != nil {
+	if err := o.Database.DelBlockUser(ctx, req.UserIDs, mcontext.GetOpUserID(ctx)); err != nil {
 		return nil, err
 	}
 	return &admin.UnblockUserResp{}, nil
@@ -111,7 +127,7 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 
 func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBlockUserReq) (*admin.SearchBlockUserResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.BlockRead); err != nil {
 		return nil, err
 	}
 	log.ZInfo(ctx, "SearchBlockUser", "RpcOpUserID", ctx.Value(constant.RpcOpUserID), "RpcOpUserType", ctx.Value(constant.RpcOpUserType))
@@ -124,13 +140,18 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	users := make([]*admin.BlockUserInfo, 0, len(infos))
 	for _, info := range infos {
+		expireTime, remaining := blockRemaining(info, now)
 		user := &admin.BlockUserInfo{
-			UserID:     info.UserID,
-			Reason:     info.Reason,
-			OpUserID:   info.OperatorUserID,
-			CreateTime: info.CreateTime.UnixMilli(),
+			UserID:           info.UserID,
+			Reason:           info.Reason,
+			OpUserID:         info.OperatorUserID,
+			CreateTime:       info.CreateTime.UnixMilli(),
+			Category:         info.Category,
+			ExpireTime:       expireTime,
+			RemainingSeconds: remaining,
 		}
 		if userFull := userMap[info.UserID]; userFull != nil {
 			user.Account = userFull.Account
@@ -148,21 +169,84 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 
 func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUserBlockInfoReq) (*admin.FindUserBlockInfoResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if err := o.checkPermission(ctx, rbac.BlockRead); err != nil {
 		return nil, err
 	}
 	list, err := o.Database.FindBlockUser(ctx, req.UserIDs)
 	if err != nil {
 		return nil, err
 	}
+	now := time.Now()
 	blocks := make([]*admin.BlockInfo, 0, len(list))
 	for _, info := range list {
+		if info.Expired(now) {
+			continue
+		}
+		expireTime, remaining := blockRemaining(info, now)
 		blocks = append(blocks, &admin.BlockInfo{
-			UserID:     info.UserID,
-			Reason:     info.Reason,
-			OpUserID:   info.OperatorUserID,
-			CreateTime: info.CreateTime.UnixMilli(),
+			UserID:           info.UserID,
+			Reason:           info.Reason,
+			OpUserID:         info.OperatorUserID,
+			CreateTime:       info.CreateTime.UnixMilli(),
+			Category:         info.Category,
+			ExpireTime:       expireTime,
+			RemainingSeconds: remaining,
 		})
 	}
 	return &admin.FindUserBlockInfoResp{Blocks: blocks}, nil
 }
+
+func (o *adminServer) SearchBlockHistory(ctx context.Context, req *admin.SearchBlockHistoryReq) (*admin.SearchBlockHistoryResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkPermission(ctx, rbac.BlockRead); err != nil {
+		return nil, err
+	}
+	total, histories, err := o.Database.SearchBlockHistory(ctx, req.UserID, req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchBlockHistoryResp{
+		Total:     total,
+		Histories: make([]*admin.BlockHistoryInfo, 0, len(histories)),
+	}
+	for _, history := range histories {
+		info := &admin.BlockHistoryInfo{
+			UserID:          history.UserID,
+			Reason:          history.Reason,
+			Category:        history.Category,
+			OpUserID:        history.OperatorUserID,
+			CreateTime:      history.CreateTime.UnixMilli(),
+			UnblockType:     history.UnblockType,
+			UnblockOpUserID: history.UnblockOpUserID,
+			UnblockTime:     history.UnblockTime.UnixMilli(),
+		}
+		if history.ExpireTime != nil {
+			info.ExpireTime = history.ExpireTime.UnixMilli()
+		}
+		resp.Histories = append(resp.Histories, info)
+	}
+	return resp, nil
+}
+
+func blockCategory(category string) (string, error) {
+	switch category {
+	case "":
+		return constant.BlockCategoryOther, nil
+	case constant.BlockCategorySpam, constant.BlockCategoryFraud, constant.BlockCategoryAbuse, constant.BlockCategoryOther, constant.BlockCategoryDeleted:
+		return category, nil
+	default:
+		return "", errs.ErrArgs.Wrap("invalid block category " + category)
+	}
+}
+
+// blockRemaining 返回到期时间和剩余秒数, 永久封禁都为0
+func blockRemaining(f *admin2.ForbiddenAccount, now time.Time) (int64, int64) {
+	if f.ExpireTime == nil {
+		return 0, 0
+	}
+	remaining := int64(f.ExpireTime.Sub(now) / time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	return f.ExpireTime.UnixMilli(), remaining
+}
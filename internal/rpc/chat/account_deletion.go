@@ -0,0 +1,281 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
+	"github.com/OpenIMSDK/chat/pkg/common/totp"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	defaultDeletionGracePeriod = 15
+	defaultDeletionInterval    = 60
+	defaultDeletedNickname     = "已注销用户"
+	accountDeletionBatch       = 100
+)
+
+func deletionGracePeriod() time.Duration {
+	days := config.Config.AccountDeletion.GracePeriod
+	if days <= 0 {
+		days = defaultDeletionGracePeriod
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func (o *chatSvr) DeleteAccount(ctx context.Context, req *chat.DeleteAccountReq) (*chat.DeleteAccountResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLocked(ctx, userLoginLockKey(userID)); err != nil {
+		return nil, err
+	}
+	if d, err := o.Database.TakeAccountDeletion(ctx, userID); err == nil {
+		return &chat.DeleteAccountResp{ExecuteTime: d.ExecuteTime.UnixMilli()}, nil
+	} else if !o.Database.IsNotFound(err) {
+		return nil, err
+	}
+	if err := o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForDeleteAccount, req.Password, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode); err != nil {
+		return nil, err
+	}
+	if err := o.verifyTOTPOwner(ctx, userID, req.TotpCode); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	d := &chat2.AccountDeletion{
+		UserID:      userID,
+		CreateTime:  now,
+		ExecuteTime: now.Add(deletionGracePeriod()),
+	}
+	if err := o.Database.CreateAccountDeletion(ctx, d); err != nil {
+		return nil, err
+	}
+	return &chat.DeleteAccountResp{ExecuteTime: d.ExecuteTime.UnixMilli()}, nil
+}
+
+func (o *chatSvr) CancelDeleteAccount(ctx context.Context, req *chat.CancelDeleteAccountReq) (*chat.CancelDeleteAccountResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := o.Database.DelAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, errs.ErrArgs.Wrap("account deletion not requested")
+	}
+	return &chat.CancelDeleteAccountResp{}, nil
+}
+
+func (o *chatSvr) GetDeleteAccountStatus(ctx context.Context, req *chat.GetDeleteAccountStatusReq) (*chat.GetDeleteAccountStatusResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d, err := o.Database.TakeAccountDeletion(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return &chat.GetDeleteAccountStatusResp{}, nil
+		}
+		return nil, err
+	}
+	return &chat.GetDeleteAccountStatusResp{
+		CreateTime:  d.CreateTime.UnixMilli(),
+		ExecuteTime: d.ExecuteTime.UnixMilli(),
+	}, nil
+}
+
+func (o *chatSvr) CheckUserActive(ctx context.Context, req *chat.CheckUserActiveReq) (*chat.CheckUserActiveResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if _, err := o.Database.GetAccount(ctx, req.UserID); err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.Wrap("account deleted")
+		}
+		return nil, err
+	}
+	// 冷静期内仍可登录以便撤销, 到期未执行的申请按已注销处理
+	if d, err := o.Database.TakeAccountDeletion(ctx, req.UserID); err == nil {
+		if !d.ExecuteTime.After(time.Now()) {
+			return nil, eerrs.ErrAccountNotFound.Wrap("account deletion pending")
+		}
+	} else if !o.Database.IsNotFound(err) {
+		return nil, err
+	}
+	return &chat.CheckUserActiveResp{}, nil
+}
+
+// verifyAccountOwner 敏感操作前重新校验密码或本人手机号/邮箱的验证码, 失败计入登录失败次数.
+// usedFor为验证码用途, 0为不限制
+func (o *chatSvr) verifyAccountOwner(ctx context.Context, userID string, usedFor int32, password, areaCode, phoneNumber, email, verifyCode string) error {
+	if password != "" {
+		account, err := o.Database.GetAccount(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if ok, _ := passwd.Verify(account.Password, password); !ok {
+			o.loginFailed(ctx, userID, "")
+			return eerrs.ErrPassword.Wrap()
+		}
+		return nil
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if phoneNumber == "" {
+		if attribute.Email == "" || attribute.Email != email {
+			return errs.ErrArgs.Wrap("email does not belong to the user")
+		}
+	} else if attribute.PhoneNumber == "" || attribute.AreaCode != areaCode || attribute.PhoneNumber != phoneNumber {
+		return errs.ErrArgs.Wrap("phone number does not belong to the user")
+	}
+	id, err := o.verifyCodeFor(ctx, usedFor, areaCode, phoneNumber, email, verifyCode)
+	if err != nil {
+		if eerrs.ErrVerifyCodeNotMatch.Is(err) {
+			o.loginFailed(ctx, userID, "")
+		}
+		return err
+	}
+	if id != 0 {
+		if err := o.Database.DelVerifyCode(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyTOTPOwner 开启了二次验证的用户还需要校验动态码或恢复码
+func (o *chatSvr) verifyTOTPOwner(ctx context.Context, userID string, code string) error {
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !t.Enabled {
+		return nil
+	}
+	if code == "" {
+		return errs.ErrArgs.Wrap("totp code is empty")
+	}
+	if err := totp.CheckCode(ctx, o.Database, userID, t.Secret, t.RecoveryCodes, code); err != nil {
+		if eerrs.ErrTOTPNotMatch.Is(err) {
+			o.loginFailed(ctx, userID, "")
+		}
+		return err
+	}
+	return nil
+}
+
+// accountDeletionLoop 定时执行冷静期已结束的注销申请
+func (o *chatSvr) accountDeletionLoop() {
+	interval := config.Config.AccountDeletion.Interval
+	if interval <= 0 {
+		interval = defaultDeletionInterval
+	}
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		ctx := mcontext.SetOperationID(context.Background(), "accountDeletion_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+		o.executeAccountDeletions(mctx.WithAdminUser(ctx))
+	}
+}
+
+func (o *chatSvr) executeAccountDeletions(ctx context.Context) {
+	ds, err := o.Database.FindDueAccountDeletion(ctx, time.Now(), accountDeletionBatch)
+	if err != nil {
+		log.ZError(ctx, "FindDueAccountDeletion", err)
+		return
+	}
+	for _, d := range ds {
+		if err := o.executeAccountDeletion(ctx, d.UserID); err != nil {
+			log.ZError(ctx, "executeAccountDeletion", err, "userID", d.UserID)
+		}
+	}
+}
+
+// executeAccountDeletion 可重复执行, 失败的申请保留到下次重试
+func (o *chatSvr) executeAccountDeletion(ctx context.Context, userID string) error {
+	if _, err := o.Database.TakeAccountDeletion(ctx, userID); err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	// im没有注销接口, 永久封禁后chat和im都无法再登录, 强制下线使已签发的im token失效
+	if err := o.Admin.ForbidUser(ctx, userID, constant.BlockCategoryDeleted, "account deleted"); err != nil {
+		return err
+	}
+	if err := o.Admin.InvalidateToken(ctx, userID); err != nil {
+		return err
+	}
+	nickname := config.Config.AccountDeletion.Nickname
+	if nickname == "" {
+		nickname = defaultDeletedNickname
+	}
+	imToken, err := o.Caller.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	if err := o.Caller.ForceOffLine(imCtx, userID); err != nil {
+		return err
+	}
+	if err := o.Caller.UpdateUserInfo(imCtx, userID, nickname, ""); err != nil {
+		return err
+	}
+	attribute := map[string]any{
+		"account":      "",
+		"phone_number": "",
+		"area_code":    "",
+		"email":        "",
+		"nickname":     nickname,
+		"face_url":     "",
+		"gender":       0,
+		"birth_time":   time.UnixMilli(0),
+		"change_time":  time.Now(),
+	}
+	register := map[string]any{
+		"ip":        "",
+		"device_id": "",
+	}
+	deleted, err := o.Database.DeleteAccount(ctx, userID, attribute, register)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		log.ZInfo(ctx, "account deleted", "userID", userID)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/chat/pkg/common/mw"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+var auditor = &mw.Auditor{}
+
+// AuditServer 记录管理员通过chat-rpc执行的操作, 日志由admin-rpc保存
+func AuditServer() grpc.ServerOption {
+	return auditor.GrpcServer()
+}
+
+func (o *chatSvr) auditRules() map[string]*mw.AuditRule {
+	return map[string]*mw.AuditRule{
+		"UpdateUserInfo": {Targets: []string{"userID"}, Previous: func(ctx context.Context, req any) (any, error) {
+			return o.Database.GetAttribute(ctx, req.(*chat.UpdateUserInfoReq).UserID)
+		}},
+		"ChangePassword":  {Targets: []string{"userID"}},
+		"DisableTOTP":     {Targets: []string{"userID"}},
+		"UnlockUserLogin": {Targets: []string{"userID", "ip"}},
+		"DeleteLogs":      {Targets: []string{"logIDs"}},
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/OpenIMSDK/chat/pkg/common/captcha"
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	defaultCaptchaLength     = 4
+	defaultCaptchaExpire     = 120
+	defaultCaptchaPassExpire = 300
+	defaultCaptchaWindow     = 3600
+)
+
+func captchaSeconds(v int, def int) time.Duration {
+	if v <= 0 {
+		v = def
+	}
+	return time.Duration(v) * time.Second
+}
+
+func (o *chatSvr) GetCaptcha(ctx context.Context, req *chat.GetCaptchaReq) (*chat.GetCaptchaResp, error) {
+	defer log.ZDebug(ctx, "return")
+	length := config.Config.Captcha.Length
+	if length <= 0 {
+		length = defaultCaptchaLength
+	}
+	answer, img, err := captcha.New(length)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	id, err := randHex(16)
+	if err != nil {
+		return nil, err
+	}
+	expire := captchaSeconds(config.Config.Captcha.Expire, defaultCaptchaExpire)
+	if err := o.Database.SetCaptcha(ctx, id, answer, expire); err != nil {
+		return nil, err
+	}
+	return &chat.GetCaptchaResp{
+		CaptchaID:  id,
+		Image:      "data:image/png;base64," + base64.StdEncoding.EncodeToString(img),
+		ExpireTime: time.Now().Add(expire).UnixMilli(),
+	}, nil
+}
+
+func (o *chatSvr) VerifyCaptcha(ctx context.Context, req *chat.VerifyCaptchaReq) (*chat.VerifyCaptchaResp, error) {
+	defer log.ZDebug(ctx, "return")
+	answer, err := o.Database.TakeCaptcha(ctx, req.CaptchaID)
+	if err != nil {
+		return nil, err
+	}
+	if answer == "" || answer != strings.TrimSpace(req.Answer) {
+		return nil, eerrs.ErrCaptchaNotMatch.Wrap()
+	}
+	token, err := randHex(16)
+	if err != nil {
+		return nil, err
+	}
+	expire := captchaSeconds(config.Config.Captcha.PassExpire, defaultCaptchaPassExpire)
+	if err := o.Database.SetCaptchaPass(ctx, token, expire); err != nil {
+		return nil, err
+	}
+	return &chat.VerifyCaptchaResp{CaptchaToken: token, ExpireTime: time.Now().Add(expire).UnixMilli()}, nil
+}
+
+// checkCaptcha 使用人机验证通过凭证, 未提交或已失效时返回ErrCaptchaRequired
+func (o *chatSvr) checkCaptcha(ctx context.Context, token string) error {
+	if token == "" {
+		return eerrs.ErrCaptchaRequired.Wrap()
+	}
+	ok, err := o.Database.TakeCaptchaPass(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return eerrs.ErrCaptchaRequired.Wrap("captcha token expired")
+	}
+	return nil
+}
+
+// captchaByIP 统计IP的请求次数, 超过管理员配置的次数后需要人机验证
+func (o *chatSvr) captchaByIP(ctx context.Context, conf map[string]string, configKey string, ip string) (bool, error) {
+	limit, _ := strconv.ParseInt(conf[configKey], 10, 64)
+	if limit <= 0 || ip == "" {
+		return false, nil
+	}
+	count, err := o.Database.IncrCaptchaRisk(ctx, configKey+":"+ip, captchaSeconds(config.Config.Captcha.Window, defaultCaptchaWindow))
+	if err != nil {
+		return false, err
+	}
+	return count > limit, nil
+}
+
+// captchaByDevice 开启后使用未登录过的设备登录时需要人机验证
+func (o *chatSvr) captchaByDevice(ctx context.Context, conf map[string]string, userID string, deviceID string) (bool, error) {
+	if !utils.Contain(strings.ToLower(conf[constant.CaptchaNewDeviceConfigKey]), "1", "true", "yes") {
+		return false, nil
+	}
+	if deviceID == "" {
+		return true, nil
+	}
+	exist, err := o.Database.ExistLoginDevice(ctx, userID, deviceID)
+	if err != nil {
+		return false, err
+	}
+	return !exist, nil
+}
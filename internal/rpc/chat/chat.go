@@ -18,10 +18,13 @@ import (
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"google.golang.org/grpc"
 
+	"github.com/OpenIMSDK/chat/pkg/common/apicall"
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/chat/pkg/common/db/database"
 	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+	"github.com/OpenIMSDK/chat/pkg/email"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	chatClient "github.com/OpenIMSDK/chat/pkg/rpclient/chat"
 	"github.com/OpenIMSDK/chat/pkg/sms"
@@ -39,22 +42,39 @@ func Start(discov discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		chat2.VerifyCode{},
 		chat2.UserLoginRecord{},
 		chat2.Log{},
+		chat2.TOTP{},
+		chat2.WebAuthnCredential{},
+		chat2.AccountDeletion{},
+		chat2.PasswordHistory{},
 	}
 	if err := db.AutoMigrate(tables...); err != nil {
 		return err
 	}
+	rdb, err := cache.NewRedis()
+	if err != nil {
+		return err
+	}
 	s, err := sms.New()
 	if err != nil {
 		return err
 	}
+	m, err := email.New()
+	if err != nil {
+		return err
+	}
 	if err := discov.CreateRpcRootNodes([]string{config.Config.RpcRegisterName.OpenImAdminName, config.Config.RpcRegisterName.OpenImChatName}); err != nil {
 		panic(err)
 	}
-	chat.RegisterChatServer(server, &chatSvr{
-		Database: database.NewChatDatabase(db),
+	svr := &chatSvr{
+		Database: database.NewChatDatabase(db, rdb),
 		Admin:    chatClient.NewAdminClient(discov),
 		SMS:      s,
-	})
+		Mail:     m,
+		Caller:   apicall.NewCallerInterface(),
+	}
+	auditor.Init(svr.auditRules(), svr.Admin.AddAuditLog)
+	go svr.accountDeletionLoop()
+	chat.RegisterChatServer(server, svr)
 	return nil
 }
 
@@ -62,4 +82,6 @@ type chatSvr struct {
 	Database database.ChatDatabaseInterface
 	Admin    *chatClient.AdminClient
 	SMS      sms.SMS
+	Mail     email.Mail
+	Caller   apicall.CallerInterface
 }
@@ -0,0 +1,85 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+func (o *chatSvr) ChangeContact(ctx context.Context, req *chat.ChangeContactReq) (*chat.ChangeContactResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		oldAreaCode, oldPhoneNumber, oldEmail string
+		update                                map[string]any
+	)
+	if req.PhoneNumber != "" {
+		if req.AreaCode == attribute.AreaCode && req.PhoneNumber == attribute.PhoneNumber {
+			return nil, errs.ErrArgs.Wrap("phone number not changed")
+		}
+		oldAreaCode, oldPhoneNumber = attribute.AreaCode, attribute.PhoneNumber
+		update = map[string]any{"area_code": req.AreaCode, "phone_number": req.PhoneNumber}
+	} else {
+		if req.Email == attribute.Email {
+			return nil, errs.ErrArgs.Wrap("email not changed")
+		}
+		oldEmail = attribute.Email
+		update = map[string]any{"email": req.Email}
+	}
+	if err := o.checkContactUnused(ctx, req.AreaCode, req.PhoneNumber, req.Email); err != nil {
+		return nil, err
+	}
+	var codes []uint
+	if config.Config.ChangeContact.VerifyOld && (oldPhoneNumber != "" || oldEmail != "") {
+		if req.OldVerifyCode == "" {
+			return nil, errs.ErrArgs.Wrap("old verify code is empty")
+		}
+		id, err := o.verifyCodeFor(ctx, constant.VerificationCodeForConfirmOld, oldAreaCode, oldPhoneNumber, oldEmail, req.OldVerifyCode)
+		if err != nil {
+			return nil, err
+		}
+		if id != 0 {
+			codes = append(codes, id)
+		}
+	}
+	id, err := o.verifyCodeFor(ctx, constant.VerificationCodeForChangeContact, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
+	if err != nil {
+		return nil, err
+	}
+	if id != 0 {
+		codes = append(codes, id)
+	}
+	update["change_time"] = time.Now()
+	if err := o.Database.UpdateContactAndDeleteVerifyCode(ctx, userID, update, codes); err != nil {
+		return nil, contactConflict(err, req.PhoneNumber, req.Email)
+	}
+	return &chat.ChangeContactResp{}, nil
+}
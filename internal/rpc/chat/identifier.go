@@ -0,0 +1,210 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+// accountType 计算Register.AccountType, 原类型对应的登录标识仍存在时保持不变, 否则按手机号、邮箱、账号的顺序选择
+func accountType(current string, account, phoneNumber, email string) string {
+	switch {
+	case current == constant.Phone && phoneNumber != "",
+		current == constant.Email && email != "",
+		current == constant.Account && account != "":
+		return current
+	case phoneNumber != "":
+		return constant.Phone
+	case email != "":
+		return constant.Email
+	case account != "":
+		return constant.Account
+	default:
+		return ""
+	}
+}
+
+func (o *chatSvr) BindIdentifier(ctx context.Context, req *chat.BindIdentifierReq) (*chat.BindIdentifierResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	register, err := o.Database.TakeRegister(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	account, phoneNumber, email := attribute.Account, attribute.PhoneNumber, attribute.Email
+	var (
+		update   map[string]any
+		password string
+		codes    []uint
+	)
+	switch req.Type {
+	case constant.Phone, constant.Email:
+		if req.Type == constant.Phone {
+			if attribute.PhoneNumber != "" {
+				return nil, errs.ErrArgs.Wrap("phone number already bound, use change instead")
+			}
+			phoneNumber = req.PhoneNumber
+			update = map[string]any{"area_code": req.AreaCode, "phone_number": req.PhoneNumber}
+		} else {
+			if attribute.Email != "" {
+				return nil, errs.ErrArgs.Wrap("email already bound, use change instead")
+			}
+			req.AreaCode, req.PhoneNumber = "", ""
+			email = req.Email
+			update = map[string]any{"email": req.Email}
+		}
+		if err := o.checkContactUnused(ctx, req.AreaCode, req.PhoneNumber, req.Email); err != nil {
+			return nil, err
+		}
+		id, err := o.verifyCodeFor(ctx, constant.VerificationCodeForChangeContact, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
+		if err != nil {
+			return nil, err
+		}
+		if id != 0 {
+			codes = append(codes, id)
+		}
+	case constant.Account:
+		if attribute.Account != "" {
+			return nil, errs.ErrArgs.Wrap("account already bound")
+		}
+		if err := o.checkLoginLocked(ctx, userLoginLockKey(userID)); err != nil {
+			return nil, err
+		}
+		if _, err := o.Database.TakeAttributeByAccount(ctx, req.Account); err == nil {
+			return nil, eerrs.ErrAccountAlreadyRegister.Wrap()
+		} else if !o.Database.IsNotFound(err) {
+			return nil, err
+		}
+		user, err := o.Database.GetAccount(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user.Password != "" {
+			if err := o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForVerifyOwner, req.Password, "", "", "", ""); err != nil {
+				return nil, err
+			}
+		} else {
+			// 未设置密码时账号无法登录, 校验本人手机号或邮箱后设置密码
+			if req.VerifyCode == "" {
+				return nil, errs.ErrArgs.Wrap("verify code is empty")
+			}
+			if err := passwd.CheckPolicy(req.Password); err != nil {
+				return nil, err
+			}
+			if attribute.PhoneNumber != "" {
+				err = o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForVerifyOwner, "", attribute.AreaCode, attribute.PhoneNumber, "", req.VerifyCode)
+			} else {
+				err = o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForVerifyOwner, "", "", "", attribute.Email, req.VerifyCode)
+			}
+			if err != nil {
+				return nil, err
+			}
+			password, err = passwd.Hash(req.Password)
+			if err != nil {
+				return nil, errs.Wrap(err)
+			}
+		}
+		account = req.Account
+		update = map[string]any{"account": req.Account}
+	default:
+		return nil, errs.ErrArgs.Wrap("type must be phone, email or account")
+	}
+	update["change_time"] = time.Now()
+	if err := o.Database.UpdateLoginIdentifier(ctx, userID, update, password, accountType(register.AccountType, account, phoneNumber, email), codes); err != nil {
+		if req.Type == constant.Account {
+			return nil, err
+		}
+		return nil, contactConflict(err, req.PhoneNumber, req.Email)
+	}
+	return &chat.BindIdentifierResp{}, nil
+}
+
+func (o *chatSvr) UnbindIdentifier(ctx context.Context, req *chat.UnbindIdentifierReq) (*chat.UnbindIdentifierResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLocked(ctx, userLoginLockKey(userID)); err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	register, err := o.Database.TakeRegister(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	account, phoneNumber, email := attribute.Account, attribute.PhoneNumber, attribute.Email
+	var update map[string]any
+	switch req.Type {
+	case constant.Phone:
+		if phoneNumber == "" {
+			return nil, errs.ErrArgs.Wrap("phone number not bound")
+		}
+		phoneNumber = ""
+		update = map[string]any{"area_code": "", "phone_number": ""}
+	case constant.Email:
+		if email == "" {
+			return nil, errs.ErrArgs.Wrap("email not bound")
+		}
+		email = ""
+		update = map[string]any{"email": ""}
+	case constant.Account:
+		if account == "" {
+			return nil, errs.ErrArgs.Wrap("account not bound")
+		}
+		account = ""
+		update = map[string]any{"account": ""}
+	default:
+		return nil, errs.ErrArgs.Wrap("type must be phone, email or account")
+	}
+	// 手机号和邮箱可以验证码登录, 账号需要设置密码才能登录
+	if phoneNumber == "" && email == "" {
+		user, err := o.Database.GetAccount(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if account == "" || user.Password == "" {
+			return nil, eerrs.ErrLastLoginIdentifier.Wrap()
+		}
+	}
+	if err := o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForVerifyOwner, req.Password, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode); err != nil {
+		return nil, err
+	}
+	update["change_time"] = time.Now()
+	if err := o.Database.UpdateLoginIdentifier(ctx, userID, update, "", accountType(register.AccountType, account, phoneNumber, email), nil); err != nil {
+		return nil, err
+	}
+	return &chat.UnbindIdentifierResp{}, nil
+}
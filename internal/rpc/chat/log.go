@@ -6,6 +6,7 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	table "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/mw/specialerror"
@@ -79,6 +80,9 @@ func (o *chatSvr) DeleteLogs(ctx context.Context, req *chat.DeleteLogsReq) (*cha
 		return nil, err
 	}
 	if userType == constant.AdminUser {
+		if err := o.checkAdminPermission(ctx, rbac.LogsWrite); err != nil {
+			return nil, err
+		}
 		userID = ""
 	}
 	logs, err := o.Database.GetLogs(ctx, req.LogIDs, userID)
@@ -104,6 +108,9 @@ func (o *chatSvr) SearchLogs(ctx context.Context, req *chat.SearchLogsReq) (*cha
 		resp    chat.SearchLogsResp
 		userIDs []string
 	)
+	if err := o.checkAdminPermission(ctx, rbac.LogsRead); err != nil {
+		return nil, err
+	}
 	if req.StartTime > req.EndTime {
 		return nil, errs.ErrArgs.Wrap("startTime>endTime")
 	}
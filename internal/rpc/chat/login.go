@@ -16,7 +16,6 @@ package chat
 
 import (
 	"context"
-	"github.com/OpenIMSDK/chat/pkg/common/mctx"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -33,41 +32,128 @@ import (
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/dbutil"
 	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/dbconn"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+	"github.com/OpenIMSDK/chat/pkg/sms"
 )
 
-func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber string) string {
+// verifyCodeJoin 验证码账号, 手机号为空时使用邮箱
+func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber, email string) string {
+	if phoneNumber == "" && email != "" {
+		return email
+	}
 	return areaCode + " " + phoneNumber
 }
 
+// verifyCodeUse 当前渠道使用的服务商, 短信和邮件都未配置时返回空, 使用超级验证码.
+// 只配置了其中一个渠道时, 另一个渠道直接拒绝, 不能退回超级验证码.
+// 短信按实际创建的服务判断, 配置了routes时use可以为空
+func (o *chatSvr) verifyCodeUse(phoneNumber, email string) (string, error) {
+	smsEnabled := sms.Enabled(o.SMS)
+	mailUse := config.Config.VerifyCode.Mail.Use
+	if !smsEnabled && mailUse == "" {
+		return "", nil
+	}
+	if phoneNumber == "" && email != "" {
+		if mailUse == "" {
+			return "", eerrs.ErrVerifyChannelDisabled.Wrap("mail is not configured")
+		}
+		return mailUse, nil
+	}
+	if !smsEnabled {
+		return "", eerrs.ErrVerifyChannelDisabled.Wrap("sms is not configured")
+	}
+	return o.SMS.Name(), nil
+}
+
+func (o *chatSvr) checkPhone(areaCode, phoneNumber string) error {
+	if areaCode == "" || phoneNumber == "" {
+		return errs.ErrArgs.Wrap("area code or phone number is empty")
+	}
+	if areaCode[0] != '+' {
+		return errs.ErrArgs.Wrap("area code must start with +")
+	}
+	if _, err := strconv.ParseUint(areaCode[1:], 10, 64); err != nil {
+		return errs.ErrArgs.Wrap("area code must be number")
+	}
+	if _, err := strconv.ParseUint(phoneNumber, 10, 64); err != nil {
+		return errs.ErrArgs.Wrap("phone number must be number")
+	}
+	return nil
+}
+
+// checkContactUnused 手机号或邮箱未被其他用户使用
+func (o *chatSvr) checkContactUnused(ctx context.Context, areaCode, phoneNumber, email string) error {
+	if phoneNumber == "" && email != "" {
+		_, err := o.Database.TakeAttributeByEmail(ctx, email)
+		if err == nil {
+			return eerrs.ErrEmailAlreadyRegister.Wrap("email already register")
+		} else if !o.Database.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	if err := o.checkPhone(areaCode, phoneNumber); err != nil {
+		return err
+	}
+	_, err := o.Database.TakeAttributeByPhone(ctx, areaCode, phoneNumber)
+	if err == nil {
+		return eerrs.ErrPhoneAlreadyRegister.Wrap("phone already register")
+	} else if !o.Database.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// contactConflict 检查通过后写入前手机号或邮箱可能已被其他用户占用, 由唯一索引兜底
+func contactConflict(err error, phoneNumber, email string) error {
+	if !dbconn.IsMysqlDuplicateKey(errs.Unwrap(err)) {
+		return err
+	}
+	if phoneNumber != "" {
+		return eerrs.ErrPhoneAlreadyRegister.Wrap("phone already register")
+	}
+	if email != "" {
+		return eerrs.ErrEmailAlreadyRegister.Wrap("email already register")
+	}
+	return err
+}
+
+func (o *chatSvr) takeAttributeByPhoneOrEmail(ctx context.Context, areaCode, phoneNumber, email string) (*chat2.Attribute, error) {
+	if phoneNumber == "" && email != "" {
+		return o.Database.TakeAttributeByEmail(ctx, email)
+	}
+	return o.Database.TakeAttributeByPhone(ctx, areaCode, phoneNumber)
+}
+
 func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeReq) (*chat.SendVerifyCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
+	conf, err := o.Admin.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	needCaptcha, err := o.captchaByIP(ctx, conf, constant.CaptchaIPSendCodeConfigKey, req.Ip)
+	if err != nil {
+		return nil, err
+	}
+	if needCaptcha {
+		if err := o.checkCaptcha(ctx, req.CaptchaToken); err != nil {
+			return nil, err
+		}
+	}
+	// 已注册用户优先使用其设置的语言
+	language := req.Language
 	switch req.UsedFor {
 	case constant.VerificationCodeForRegister:
 		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
 			return nil, err
 		}
-		if req.AreaCode == "" || req.PhoneNumber == "" {
-			return nil, errs.ErrArgs.Wrap("area code or phone number is empty")
-		}
-		if req.AreaCode[0] != '+' {
-			return nil, errs.ErrArgs.Wrap("area code must start with +")
-		}
-		if _, err := strconv.ParseUint(req.AreaCode[1:], 10, 64); err != nil {
-			return nil, errs.ErrArgs.Wrap("area code must be number")
-		}
-		if _, err := strconv.ParseUint(req.PhoneNumber, 10, 64); err != nil {
-			return nil, errs.ErrArgs.Wrap("phone number must be number")
-		}
-		_, err := o.Database.TakeAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
-		if err == nil {
-			return nil, eerrs.ErrPhoneAlreadyRegister.Wrap("phone already register")
-		} else if !o.Database.IsNotFound(err) {
-			return nil, err
-		}
-		conf, err := o.Admin.GetConfig(ctx)
-		if err != nil {
+		if err := o.checkContactUnused(ctx, req.AreaCode, req.PhoneNumber, req.Email); err != nil {
 			return nil, err
 		}
 		if val := conf[constant.NeedInvitationCodeRegisterConfigKey]; utils.Contain(strings.ToLower(val), "1", "true", "yes") {
@@ -78,13 +164,20 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 				return nil, err
 			}
 		}
-	case constant.VerificationCodeForLogin, constant.VerificationCodeForResetPassword:
-		_, err := o.Database.TakeAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
+	case constant.VerificationCodeForChangeContact:
+		if err := o.checkContactUnused(ctx, req.AreaCode, req.PhoneNumber, req.Email); err != nil {
+			return nil, err
+		}
+	case constant.VerificationCodeForLogin, constant.VerificationCodeForResetPassword, constant.VerificationCodeForDeleteAccount, constant.VerificationCodeForConfirmOld, constant.VerificationCodeForVerifyOwner:
+		attribute, err := o.takeAttributeByPhoneOrEmail(ctx, req.AreaCode, req.PhoneNumber, req.Email)
 		if o.Database.IsNotFound(err) {
-			return nil, errs.ErrArgs.Wrap("phone unregistered")
+			return nil, errs.ErrArgs.Wrap("phone or email unregistered")
 		} else if err != nil {
 			return nil, err
 		}
+		if attribute.Language != "" {
+			language = attribute.Language
+		}
 	default:
 		return nil, errs.ErrArgs.Wrap("used unknown")
 	}
@@ -92,28 +185,31 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 	if verifyCode.UintTime == 0 || verifyCode.MaxCount == 0 {
 		return nil, errs.ErrNoPermission.Wrap("verify code disabled")
 	}
-	if verifyCode.Use == "" {
+	use, err := o.verifyCodeUse(req.PhoneNumber, req.Email)
+	if err != nil {
+		return nil, err
+	}
+	if use == "" {
 		if verifyCode.SuperCode == "" {
 			return nil, errs.ErrInternalServer.Wrap("super code is empty")
 		}
 		return &chat.SendVerifyCodeResp{}, nil
 	}
-	now := time.Now()
-	count, err := o.Database.CountVerifyCodeRange(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), now.Add(-time.Duration(verifyCode.UintTime)*time.Second), now)
-	if err != nil {
+	if err := o.checkVerifyCodeLimit(ctx, req); err != nil {
 		return nil, err
 	}
-	if verifyCode.MaxCount < int(count) {
-		return nil, eerrs.ErrVerifyCodeSendFrequently.Wrap()
-	}
 	t := &chat2.VerifyCode{
-		Account:    o.verifyCodeJoin(req.AreaCode, req.PhoneNumber),
+		Account:    o.verifyCodeJoin(req.AreaCode, req.PhoneNumber, req.Email),
 		Code:       o.genVerifyCode(),
 		Duration:   uint(config.Config.VerifyCode.ValidTime),
 		CreateTime: time.Now(),
+		UsedFor:    req.UsedFor,
 	}
-	err = o.Database.AddVerifyCode(ctx, t, func() error {
-		return o.SMS.SendCode(ctx, req.AreaCode, req.PhoneNumber, t.Code)
+	err = o.Database.AddVerifyCode(ctx, t, func() (string, string, error) {
+		if req.PhoneNumber == "" && req.Email != "" {
+			return o.Mail.Name(), "", o.Mail.SendCode(ctx, req.Email, t.Code, req.UsedFor, language)
+		}
+		return sms.Deliver(ctx, o.SMS, req.AreaCode, req.PhoneNumber, t.Code, req.UsedFor, language)
 	})
 	if err != nil {
 		return nil, err
@@ -121,18 +217,27 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 	return &chat.SendVerifyCodeResp{}, nil
 }
 
-func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode string) (uint, error) {
+func (o *chatSvr) verifyCode(ctx context.Context, areaCode, phoneNumber, email string, verifyCode string) (uint, error) {
+	return o.verifyCodeFor(ctx, 0, areaCode, phoneNumber, email, verifyCode)
+}
+
+// verifyCodeFor 校验验证码, usedFor不为0时验证码必须是为该用途发送的
+func (o *chatSvr) verifyCodeFor(ctx context.Context, usedFor int32, areaCode, phoneNumber, email string, verifyCode string) (uint, error) {
 	defer log.ZDebug(ctx, "return")
 	if verifyCode == "" {
 		return 0, errs.ErrArgs.Wrap("verify code is empty")
 	}
-	if config.Config.VerifyCode.Use == "" {
+	use, err := o.verifyCodeUse(phoneNumber, email)
+	if err != nil {
+		return 0, err
+	}
+	if use == "" {
 		if verifyCode != config.Config.VerifyCode.SuperCode {
 			return 0, eerrs.ErrVerifyCodeNotMatch.Wrap()
 		}
 		return 0, nil
 	}
-	last, err := o.Database.TakeLastVerifyCode(ctx, account)
+	last, err := o.Database.TakeLastVerifyCode(ctx, o.verifyCodeJoin(areaCode, phoneNumber, email))
 	if err != nil {
 		if dbutil.IsGormNotFound(err) {
 			return 0, eerrs.ErrVerifyCodeExpired.Wrap()
@@ -145,6 +250,9 @@ func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode str
 	if last.Used {
 		return last.ID, eerrs.ErrVerifyCodeUsed.Wrap()
 	}
+	if usedFor != 0 && last.UsedFor != usedFor {
+		return last.ID, eerrs.ErrVerifyCodeNotMatch.Wrap("verify code not sent for this purpose")
+	}
 	if config.Config.VerifyCode.MaxCount > 0 {
 		if last.Count >= config.Config.VerifyCode.MaxCount {
 			return last.ID, eerrs.ErrVerifyCodeMaxCount.Wrap()
@@ -163,7 +271,7 @@ func (o *chatSvr) verifyCode(ctx context.Context, account string, verifyCode str
 
 func (o *chatSvr) VerifyCode(ctx context.Context, req *chat.VerifyCodeReq) (*chat.VerifyCodeResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode); err != nil {
+	if _, err := o.verifyCode(ctx, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode); err != nil {
 		return nil, err
 	}
 	return &chat.VerifyCodeResp{}, nil
@@ -213,18 +321,23 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 	resp := &chat.RegisterUserResp{}
 	defer log.ZDebug(ctx, "return")
 	isAdmin, err := o.Admin.CheckNilOrAdmin(ctx)
-	ctx = mctx.WithAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if isAdmin {
+		if err := o.checkAdminPermission(ctx, rbac.UserWrite); err != nil {
+			return nil, err
+		}
+	}
+	ctx = mctx.WithAdminUser(ctx)
 	if req.User == nil {
 		return nil, errs.ErrArgs.Wrap("user is nil")
 	}
 	if (req.User.AreaCode == "" && req.User.PhoneNumber != "") || (req.User.AreaCode != "" && req.User.PhoneNumber == "") {
 		return nil, errs.ErrArgs.Wrap("area code or phone number error")
 	}
-	if req.User.PhoneNumber == "" && req.User.Account == "" {
-		return nil, errs.ErrArgs.Wrap("phone number and account is empty")
+	if req.User.PhoneNumber == "" && req.User.Email == "" && req.User.Account == "" {
+		return nil, errs.ErrArgs.Wrap("phone number, email and account is empty")
 	}
 	var usedInvitationCode bool
 	if !isAdmin {
@@ -247,7 +360,7 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 				return nil, err
 			}
 		}
-		if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.User.AreaCode, req.User.PhoneNumber), req.VerifyCode); err != nil {
+		if _, err := o.verifyCode(ctx, req.User.AreaCode, req.User.PhoneNumber, req.User.Email, req.VerifyCode); err != nil {
 			return nil, err
 		}
 	}
@@ -283,14 +396,8 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		}
 	}
 	if req.User.PhoneNumber != "" {
-		if req.User.AreaCode[0] != '+' {
-			return nil, errs.ErrArgs.Wrap("area code must start with +")
-		}
-		if _, err := strconv.ParseUint(req.User.AreaCode[1:], 10, 64); err != nil {
-			return nil, errs.ErrArgs.Wrap("area code must be number")
-		}
-		if _, err := strconv.ParseUint(req.User.PhoneNumber, 10, 64); err != nil {
-			return nil, errs.ErrArgs.Wrap("phone number must be number")
+		if err := o.checkPhone(req.User.AreaCode, req.User.PhoneNumber); err != nil {
+			return nil, err
 		}
 		_, err := o.Database.TakeAttributeByPhone(ctx, req.User.AreaCode, req.User.PhoneNumber)
 		if err == nil {
@@ -299,6 +406,14 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 			return nil, err
 		}
 	}
+	if req.User.Email != "" {
+		_, err := o.Database.TakeAttributeByEmail(ctx, req.User.Email)
+		if err == nil {
+			return nil, eerrs.ErrEmailAlreadyRegister.Wrap()
+		} else if !o.Database.IsNotFound(err) {
+			return nil, err
+		}
+	}
 	if req.User.Account != "" {
 		_, err := o.Database.TakeAttributeByAccount(ctx, req.User.Account)
 		if err == nil {
@@ -312,13 +427,23 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		DeviceID:    req.DeviceID,
 		IP:          req.Ip,
 		Platform:    constant2.PlatformID2Name[int(req.Platform)],
-		AccountType: "",
+		AccountType: accountType("", req.User.Account, req.User.PhoneNumber, req.User.Email),
 		Mode:        constant.UserMode,
 		CreateTime:  time.Now(),
 	}
+	var password string
+	if req.User.Password != "" {
+		if err := passwd.CheckPolicy(req.User.Password); err != nil {
+			return nil, err
+		}
+		password, err = passwd.Hash(req.User.Password)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+	}
 	account := &chat2.Account{
 		UserID:         req.User.UserID,
-		Password:       req.User.Password,
+		Password:       password,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
 		ChangeTime:     register.CreateTime,
 		CreateTime:     register.CreateTime,
@@ -348,12 +473,13 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		}
 	}
 	if req.AutoLogin {
-		chatToken, adminErr := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		chatToken, adminErr := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser, &admin.TokenSession{Platform: req.Platform, DeviceID: req.DeviceID, Ip: req.Ip})
 		if err != nil {
 			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
 		}
 		if adminErr == nil {
 			resp.ChatToken = chatToken.Token
+			resp.RefreshToken = chatToken.RefreshToken
 		}
 	}
 	resp.UserID = req.User.UserID
@@ -363,47 +489,130 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginResp, error) {
 	defer log.ZDebug(ctx, "return")
 	resp := &chat.LoginResp{}
-	if req.Password == "" && req.VerifyCode == "" {
-		return nil, errs.ErrArgs.Wrap("password or code must be set")
+	if req.Password == "" && req.VerifyCode == "" && req.Passkey == nil {
+		return nil, errs.ErrArgs.Wrap("password, code or passkey must be set")
+	}
+	if req.Ip != "" {
+		if err := o.checkLoginLocked(ctx, ipLoginLockKey(req.Ip)); err != nil {
+			return nil, err
+		}
+	}
+	var (
+		conf          map[string]string
+		captchaPassed bool
+	)
+	if req.Passkey == nil {
+		var err error
+		conf, err = o.Admin.GetConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		needCaptcha, err := o.captchaByIP(ctx, conf, constant.CaptchaIPLoginConfigKey, req.Ip)
+		if err != nil {
+			return nil, err
+		}
+		if needCaptcha {
+			if err := o.checkCaptcha(ctx, req.CaptchaToken); err != nil {
+				return nil, err
+			}
+			captchaPassed = true
+		}
 	}
 	var err error
 	var attribute *chat2.Attribute
-	if req.Account != "" {
+	// 通行密钥已验证用户身份时视为多因素认证, 不再要求二次验证
+	var passkeyVerified bool
+	if req.Passkey != nil {
+		var userID string
+		userID, passkeyVerified, err = o.verifyPasskey(ctx, req.Passkey)
+		if err != nil {
+			if eerrs.ErrPasskeyVerifyFailed.Is(err) {
+				o.loginFailed(ctx, userID, req.Ip)
+			}
+			return nil, err
+		}
+		attribute, err = o.Database.GetAttribute(ctx, userID)
+	} else if req.Account != "" {
 		attribute, err = o.Database.GetAttributeByAccount(ctx, req.Account)
 	} else if req.PhoneNumber != "" {
 		if req.AreaCode == "" || req.AreaCode[0] != '+' {
 			return nil, errs.ErrArgs.Wrap("area code must start with +")
 		}
 		attribute, err = o.Database.GetAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
+	} else if req.Email != "" {
+		attribute, err = o.Database.GetAttributeByEmail(ctx, req.Email)
 	} else {
-		err = errs.ErrArgs.Wrap("account or phone number must be set")
+		err = errs.ErrArgs.Wrap("account, phone number or email must be set")
 	}
 	if err != nil {
 		if o.Database.IsNotFound(err) {
+			o.loginFailed(ctx, "", req.Ip)
 			return nil, eerrs.ErrAccountNotFound.Wrap("user unregistered")
 		}
 		return nil, err
 	}
+	if err := o.checkLoginLocked(ctx, userLoginLockKey(attribute.UserID)); err != nil {
+		return nil, err
+	}
+	if req.Passkey == nil && !captchaPassed {
+		needCaptcha, err := o.captchaByDevice(ctx, conf, attribute.UserID, req.DeviceID)
+		if err != nil {
+			return nil, err
+		}
+		if needCaptcha {
+			if err := o.checkCaptcha(ctx, req.CaptchaToken); err != nil {
+				return nil, err
+			}
+		}
+	}
 	if err := o.Admin.CheckLogin(ctx, attribute.UserID, req.Ip); err != nil {
 		return nil, err
 	}
 	var verifyCodeID *uint
-	if req.Password == "" {
-		id, err := o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode)
+	switch {
+	case req.Passkey != nil:
+		// 通行密钥已在查找用户时校验
+	case req.Password == "":
+		id, err := o.verifyCode(ctx, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
 		if err != nil {
+			if eerrs.ErrVerifyCodeNotMatch.Is(err) {
+				o.loginFailed(ctx, attribute.UserID, req.Ip)
+			}
 			return nil, err
 		}
 		verifyCodeID = &id
-	} else {
+	default:
 		account, err := o.Database.GetAccount(ctx, attribute.UserID)
 		if err != nil {
 			return nil, err
 		}
-		if account.Password != req.Password {
+		ok, rehash := passwd.Verify(account.Password, req.Password)
+		if !ok {
+			o.loginFailed(ctx, attribute.UserID, req.Ip)
 			return nil, eerrs.ErrPassword.Wrap()
 		}
+		if rehash {
+			o.rehashPassword(ctx, attribute.UserID, req.Password)
+		}
+	}
+	var challengeToken string
+	if !passkeyVerified {
+		challengeToken, err = o.loginChallenge(ctx, attribute.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if challengeToken != "" {
+		if verifyCodeID != nil {
+			if err := o.Database.DelVerifyCode(ctx, *verifyCodeID); err != nil {
+				return nil, err
+			}
+		}
+		resp.UserID = attribute.UserID
+		resp.ChallengeToken = challengeToken
+		return resp, nil
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, attribute.UserID, constant.NormalUser)
+	chatToken, err := o.Admin.CreateToken(ctx, attribute.UserID, constant.NormalUser, &admin.TokenSession{Platform: req.Platform, DeviceID: req.DeviceID, Ip: req.Ip})
 	if err != nil {
 		return nil, err
 	}
@@ -422,7 +631,9 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 			return nil, err
 		}
 	}
+	o.loginSucceeded(ctx, attribute.UserID)
 	resp.UserID = attribute.UserID
 	resp.ChatToken = chatToken.Token
+	resp.RefreshToken = chatToken.RefreshToken
 	return resp, nil
 }
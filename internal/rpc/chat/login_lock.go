@@ -0,0 +1,143 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	defaultUserMaxLoginFailed = 5
+	defaultIPMaxLoginFailed   = 50
+	defaultUserLoginLockTime  = 300
+	defaultUserMaxLockTime    = 86400
+)
+
+func userLoginLockKey(userID string) string {
+	return "user:" + userID
+}
+
+func ipLoginLockKey(ip string) string {
+	return "ip:" + ip
+}
+
+func loginLockConfig() (maxFailed int, ipMaxFailed int, lockTime time.Duration, maxLockTime time.Duration) {
+	conf := config.Config.UserLogin
+	maxFailed, ipMaxFailed = conf.MaxFailed, conf.IPMaxFailed
+	if maxFailed <= 0 {
+		maxFailed = defaultUserMaxLoginFailed
+	}
+	if ipMaxFailed <= 0 {
+		ipMaxFailed = defaultIPMaxLoginFailed
+	}
+	lock, maxLock := conf.LockTime, conf.MaxLockTime
+	if lock <= 0 {
+		lock = defaultUserLoginLockTime
+	}
+	if maxLock < lock {
+		maxLock = defaultUserMaxLockTime
+		if maxLock < lock {
+			maxLock = lock
+		}
+	}
+	return maxFailed, ipMaxFailed, time.Duration(lock) * time.Second, time.Duration(maxLock) * time.Second
+}
+
+// checkLoginLocked 账号或IP处于锁定期时返回剩余锁定时间
+func (o *chatSvr) checkLoginLocked(ctx context.Context, key string) error {
+	ttl, err := o.Database.LoginLockTTL(ctx, key)
+	if err != nil {
+		return err
+	}
+	if ttl > 0 {
+		return eerrs.ErrAccountLocked.Wrap(fmt.Sprintf("too many failed attempts, retry after %d seconds", mctx.SetRetryAfter(ctx, ttl)))
+	}
+	return nil
+}
+
+// loginFailed 记录一次失败, 达到上限后锁定, 每次再锁定时间翻倍
+func (o *chatSvr) loginFailed(ctx context.Context, userID string, ip string) {
+	maxFailed, ipMaxFailed, lockTime, maxLockTime := loginLockConfig()
+	if userID != "" {
+		o.incrLoginFailed(ctx, userLoginLockKey(userID), maxFailed, lockTime, maxLockTime)
+	}
+	if ip != "" {
+		o.incrLoginFailed(ctx, ipLoginLockKey(ip), ipMaxFailed, lockTime, maxLockTime)
+	}
+}
+
+// loginSucceeded 完整登录成功后清空失败次数.
+// 不能在第一步验证通过时清空, 也不清空锁定次数, 否则知道密码即可无限重试二次验证
+func (o *chatSvr) loginSucceeded(ctx context.Context, userID string) {
+	if err := o.Database.ClearLoginFailed(ctx, userLoginLockKey(userID)); err != nil {
+		log.ZError(ctx, "ClearLoginFailed", err, "userID", userID)
+	}
+}
+
+func (o *chatSvr) incrLoginFailed(ctx context.Context, key string, maxFailed int, lockTime time.Duration, maxLockTime time.Duration) {
+	count, err := o.Database.IncrLoginFailed(ctx, key, lockTime)
+	if err != nil {
+		log.ZError(ctx, "IncrLoginFailed", err, "key", key)
+		return
+	}
+	if count < int64(maxFailed) {
+		return
+	}
+	locks, err := o.Database.IncrLoginLockCount(ctx, key, maxLockTime)
+	if err != nil {
+		log.ZError(ctx, "IncrLoginLockCount", err, "key", key)
+		return
+	}
+	expire := lockTime
+	for i := int64(1); i < locks && expire < maxLockTime; i++ {
+		expire *= 2
+	}
+	if expire > maxLockTime {
+		expire = maxLockTime
+	}
+	if err := o.Database.LockLogin(ctx, key, expire); err != nil {
+		log.ZError(ctx, "LockLogin", err, "key", key)
+		return
+	}
+	log.ZWarn(ctx, "user login locked", nil, "key", key, "failed", count, "locks", locks, "expire", expire)
+}
+
+func (o *chatSvr) UnlockUserLogin(ctx context.Context, req *chat.UnlockUserLoginReq) (*chat.UnlockUserLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkAdminPermission(ctx, rbac.UserWrite); err != nil {
+		return nil, err
+	}
+	if req.UserID != "" {
+		if err := o.Database.UnlockLogin(ctx, userLoginLockKey(req.UserID)); err != nil {
+			return nil, err
+		}
+	}
+	if req.Ip != "" {
+		if err := o.Database.UnlockLogin(ctx, ipLoginLockKey(req.Ip)); err != nil {
+			return nil, err
+		}
+	}
+	return &chat.UnlockUserLoginResp{}, nil
+}
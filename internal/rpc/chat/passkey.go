@@ -0,0 +1,287 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/webauthn"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const (
+	// passkeyLoginChallenge 登录challenge不绑定用户, 由认证器选择通行密钥
+	passkeyLoginChallenge  = "*"
+	defaultPasskeyTimeout  = 300
+	maxPasskeyNameLength   = 64
+	maxPasskeyCountPerUser = 20
+)
+
+func (o *chatSvr) relyingParty() (*webauthn.RelyingParty, error) {
+	if config.Config.WebAuthn.RPID == "" {
+		return nil, errs.ErrArgs.Wrap("passkey login is not enabled")
+	}
+	return &webauthn.RelyingParty{ID: config.Config.WebAuthn.RPID, Origins: config.Config.WebAuthn.Origins}, nil
+}
+
+func (o *chatSvr) passkeyTimeout() time.Duration {
+	timeout := config.Config.WebAuthn.Timeout
+	if timeout <= 0 {
+		timeout = defaultPasskeyTimeout
+	}
+	return time.Duration(timeout) * time.Second
+}
+
+// BeginPasskeyRegistration 通行密钥登录可以跳过二次验证, 注册前需要重新验证本人, 仅凭token不能注册
+func (o *chatSvr) BeginPasskeyRegistration(ctx context.Context, req *chat.BeginPasskeyRegistrationReq) (*chat.BeginPasskeyRegistrationResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rp, err := o.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLocked(ctx, userLoginLockKey(userID)); err != nil {
+		return nil, err
+	}
+	if err := o.verifyAccountOwner(ctx, userID, constant.VerificationCodeForVerifyOwner, req.Password, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode); err != nil {
+		return nil, err
+	}
+	if err := o.verifyTOTPOwner(ctx, userID, req.TotpCode); err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.FindWebAuthnCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) >= maxPasskeyCountPerUser {
+		return nil, errs.ErrArgs.Wrap("too many passkeys")
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.SetWebAuthnChallenge(ctx, challenge, userID, o.passkeyTimeout()); err != nil {
+		return nil, err
+	}
+	exclude := make([]string, 0, len(credentials))
+	for _, credential := range credentials {
+		exclude = append(exclude, credential.CredentialID)
+	}
+	return &chat.BeginPasskeyRegistrationResp{
+		Challenge:            challenge,
+		RpID:                 rp.ID,
+		RpName:               config.Config.WebAuthn.RPName,
+		UserHandle:           webauthn.EncodeToString([]byte(userID)),
+		UserName:             o.totpLabel(attribute),
+		UserDisplayName:      attribute.Nickname,
+		Algorithms:           webauthn.Algorithms,
+		ExcludeCredentialIDs: exclude,
+		Timeout:              o.passkeyTimeout().Milliseconds(),
+	}, nil
+}
+
+func (o *chatSvr) FinishPasskeyRegistration(ctx context.Context, req *chat.FinishPasskeyRegistrationReq) (*chat.FinishPasskeyRegistrationResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len([]rune(req.Name)) > maxPasskeyNameLength {
+		return nil, errs.ErrArgs.Wrap("name too long")
+	}
+	rp, err := o.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	clientDataJSON, err := webauthn.DecodeString(req.ClientDataJSON)
+	if err != nil {
+		return nil, errs.ErrArgs.Wrap("clientDataJSON is invalid")
+	}
+	attestation, err := webauthn.DecodeString(req.AttestationObject)
+	if err != nil {
+		return nil, errs.ErrArgs.Wrap("attestationObject is invalid")
+	}
+	registration, err := rp.ParseRegistration(clientDataJSON, attestation)
+	if err != nil {
+		return nil, eerrs.ErrPasskeyVerifyFailed.Wrap(err.Error())
+	}
+	// challenge只在重新验证本人后签发, 且只能使用一次
+	challengeUserID, err := o.Database.TakeWebAuthnChallenge(ctx, registration.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	if challengeUserID != userID {
+		return nil, eerrs.ErrPasskeyVerifyFailed.Wrap("challenge expired")
+	}
+	credentialID := webauthn.EncodeToString(registration.CredentialID)
+	if credentialID != req.CredentialID {
+		return nil, eerrs.ErrPasskeyVerifyFailed.Wrap("credentialID mismatch")
+	}
+	if _, err := o.Database.TakeWebAuthnCredential(ctx, credentialID); err == nil {
+		return nil, errs.ErrArgs.Wrap("passkey already registered")
+	} else if !o.Database.IsNotFound(err) {
+		return nil, err
+	}
+	if req.Name == "" {
+		req.Name = "passkey"
+	}
+	if err := o.Database.CreateWebAuthnCredential(ctx, &chat2.WebAuthnCredential{
+		CredentialID: credentialID,
+		UserID:       userID,
+		Name:         req.Name,
+		PublicKey:    registration.PublicKey,
+		SignCount:    registration.SignCount,
+		CreateTime:   time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+	return &chat.FinishPasskeyRegistrationResp{}, nil
+}
+
+func (o *chatSvr) BeginPasskeyLogin(ctx context.Context, req *chat.BeginPasskeyLoginReq) (*chat.BeginPasskeyLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	rp, err := o.relyingParty()
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := webauthn.NewChallenge()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.SetWebAuthnChallenge(ctx, challenge, passkeyLoginChallenge, o.passkeyTimeout()); err != nil {
+		return nil, err
+	}
+	return &chat.BeginPasskeyLoginResp{
+		Challenge: challenge,
+		RpID:      rp.ID,
+		Timeout:   o.passkeyTimeout().Milliseconds(),
+	}, nil
+}
+
+func (o *chatSvr) FindPasskey(ctx context.Context, req *chat.FindPasskeyReq) (*chat.FindPasskeyResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := o.Database.FindWebAuthnCredential(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	passkeys := make([]*chat.PasskeyInfo, 0, len(credentials))
+	for _, credential := range credentials {
+		info := &chat.PasskeyInfo{
+			CredentialID: credential.CredentialID,
+			Name:         credential.Name,
+			CreateTime:   credential.CreateTime.UnixMilli(),
+		}
+		if !credential.LastUsedTime.IsZero() {
+			info.LastUsedTime = credential.LastUsedTime.UnixMilli()
+		}
+		passkeys = append(passkeys, info)
+	}
+	return &chat.FindPasskeyResp{Passkeys: passkeys}, nil
+}
+
+func (o *chatSvr) DelPasskey(ctx context.Context, req *chat.DelPasskeyReq) (*chat.DelPasskeyResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := o.Database.DelWebAuthnCredential(ctx, userID, req.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+	if !deleted {
+		return nil, errs.ErrRecordNotFound.Wrap("passkey not found")
+	}
+	return &chat.DelPasskeyResp{}, nil
+}
+
+// verifyPasskey 校验登录断言, 返回通行密钥所属用户及认证器是否验证了用户身份
+func (o *chatSvr) verifyPasskey(ctx context.Context, assertion *chat.WebAuthnAssertion) (string, bool, error) {
+	rp, err := o.relyingParty()
+	if err != nil {
+		return "", false, err
+	}
+	clientDataJSON, err := webauthn.DecodeString(assertion.ClientDataJSON)
+	if err != nil {
+		return "", false, errs.ErrArgs.Wrap("clientDataJSON is invalid")
+	}
+	authData, err := webauthn.DecodeString(assertion.AuthenticatorData)
+	if err != nil {
+		return "", false, errs.ErrArgs.Wrap("authenticatorData is invalid")
+	}
+	signature, err := webauthn.DecodeString(assertion.Signature)
+	if err != nil {
+		return "", false, errs.ErrArgs.Wrap("signature is invalid")
+	}
+	parsed, err := rp.ParseAssertion(clientDataJSON, authData)
+	if err != nil {
+		return "", false, eerrs.ErrPasskeyVerifyFailed.Wrap(err.Error())
+	}
+	challengeUserID, err := o.Database.TakeWebAuthnChallenge(ctx, parsed.Challenge)
+	if err != nil {
+		return "", false, err
+	}
+	if challengeUserID != passkeyLoginChallenge {
+		return "", false, eerrs.ErrPasskeyVerifyFailed.Wrap("challenge expired")
+	}
+	credential, err := o.Database.TakeWebAuthnCredential(ctx, assertion.CredentialID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return "", false, eerrs.ErrPasskeyVerifyFailed.Wrap("passkey not registered")
+		}
+		return "", false, err
+	}
+	if assertion.UserHandle != "" {
+		userHandle, err := webauthn.DecodeString(assertion.UserHandle)
+		if err != nil || string(userHandle) != credential.UserID {
+			return "", false, eerrs.ErrPasskeyVerifyFailed.Wrap("userHandle mismatch")
+		}
+	}
+	if err := webauthn.VerifySignature(credential.PublicKey, authData, clientDataJSON, signature); err != nil {
+		return credential.UserID, false, eerrs.ErrPasskeyVerifyFailed.Wrap(err.Error())
+	}
+	// 计数回退说明认证器可能被克隆
+	if err := webauthn.CheckSignCount(credential.SignCount, parsed.SignCount); err != nil {
+		log.ZWarn(ctx, "passkey sign count not increased", nil, "userID", credential.UserID, "credentialID", credential.CredentialID, "stored", credential.SignCount, "received", parsed.SignCount)
+		return credential.UserID, false, eerrs.ErrPasskeyVerifyFailed.Wrap(err.Error())
+	}
+	ok, err := o.Database.UseWebAuthnSignCount(ctx, credential.CredentialID, credential.SignCount, parsed.SignCount, time.Now())
+	if err != nil {
+		return "", false, err
+	}
+	if !ok {
+		return credential.UserID, false, eerrs.ErrPasskeyVerifyFailed.Wrap("passkey used concurrently")
+	}
+	return credential.UserID, parsed.UserVerified, nil
+}
@@ -21,8 +21,12 @@ import (
 
 	"github.com/OpenIMSDK/tools/errs"
 
+	"github.com/OpenIMSDK/chat/pkg/common/config"
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 )
 
@@ -31,15 +35,26 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	if req.Password == "" {
 		return nil, errs.ErrArgs.Wrap("password must be set")
 	}
-	verifyCodeID, err := o.verifyCode(ctx, o.verifyCodeJoin(req.AreaCode, req.PhoneNumber), req.VerifyCode)
+	verifyCodeID, err := o.verifyCode(ctx, req.AreaCode, req.PhoneNumber, req.Email, req.VerifyCode)
 	if err != nil {
 		return nil, err
 	}
-	attribute, err := o.Database.GetAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
+	attribute, err := o.takeAttributeByPhoneOrEmail(ctx, req.AreaCode, req.PhoneNumber, req.Email)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, req.Password, verifyCodeID)
+	account, err := o.Database.GetAccount(ctx, attribute.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkNewPassword(ctx, account, req.Password); err != nil {
+		return nil, err
+	}
+	password, err := passwd.Hash(req.Password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	err = o.Database.ChangePassword(ctx, attribute.UserID, account.Password, password, passwordHistoryKeep(), &verifyCodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +85,9 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		if req.UserID == "" {
 			return nil, errs.ErrArgs.Wrap("user id must be set")
 		}
+		if err := o.checkAdminPermission(ctx, rbac.UserWrite); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errs.ErrInternalServer.Wrap("invalid user type")
 	}
@@ -78,14 +96,60 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		return nil, err
 	}
 	if userType != constant.AdminUser {
-		if user.Password != req.CurrentPassword {
+		if ok, _ := passwd.Verify(user.Password, req.CurrentPassword); !ok {
 			return nil, errs.ErrNoPermission.Wrap("current password is wrong")
 		}
 	}
-	if user.Password != req.NewPassword {
-		if err := o.Database.UpdatePassword(ctx, req.UserID, req.NewPassword); err != nil {
-			return nil, err
-		}
+	if err := o.checkNewPassword(ctx, user, req.NewPassword); err != nil {
+		return nil, err
+	}
+	password, err := passwd.Hash(req.NewPassword)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.ChangePassword(ctx, req.UserID, user.Password, password, passwordHistoryKeep(), nil); err != nil {
+		return nil, err
 	}
 	return &chat.ChangePasswordResp{}, nil
 }
+
+// passwordHistoryKeep 除当前密码外需要保留的历史密码数量
+func passwordHistoryKeep() int {
+	if n := config.Config.PasswordPolicy.HistoryCount; n > 1 {
+		return n - 1
+	}
+	return 0
+}
+
+// checkNewPassword 校验密码规则, 且不能与当前密码及保留的历史密码相同
+func (o *chatSvr) checkNewPassword(ctx context.Context, account *chat2.Account, password string) error {
+	if err := passwd.CheckPolicy(password); err != nil {
+		return err
+	}
+	if config.Config.PasswordPolicy.HistoryCount <= 0 {
+		return nil
+	}
+	hashed := []string{account.Password}
+	if keep := passwordHistoryKeep(); keep > 0 {
+		histories, err := o.Database.FindPasswordHistory(ctx, account.UserID, keep)
+		if err != nil {
+			return err
+		}
+		for _, history := range histories {
+			hashed = append(hashed, history.Password)
+		}
+	}
+	return passwd.CheckHistory(password, hashed...)
+}
+
+// rehashPassword 登录成功后将旧格式的密码重新计算并保存, 失败不影响登录
+func (o *chatSvr) rehashPassword(ctx context.Context, userID string, password string) {
+	hashed, err := passwd.Hash(password)
+	if err != nil {
+		log.ZError(ctx, "rehash password failed", err, "userID", userID)
+		return
+	}
+	if err := o.Database.UpdatePassword(ctx, userID, hashed); err != nil {
+		log.ZError(ctx, "update rehashed password failed", err, "userID", userID)
+	}
+}
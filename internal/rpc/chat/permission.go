@@ -0,0 +1,30 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+)
+
+// checkAdminPermission 校验管理员角色权限, chat-api也接受管理员token, 不能只依赖admin-api路由校验
+func (o *chatSvr) checkAdminPermission(ctx context.Context, permission rbac.Permission) error {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return err
+	}
+	return o.Admin.CheckPermission(ctx, permission)
+}
@@ -0,0 +1,207 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+const defaultQRLoginExpire = 120
+
+func randHex(n int) (string, error) {
+	data := make([]byte, n)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return hex.EncodeToString(data), nil
+}
+
+func (o *chatSvr) CreateQRLogin(ctx context.Context, req *chat.CreateQRLoginReq) (*chat.CreateQRLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	// 未登录即可调用, 按IP限流防止刷凭证占满redis
+	if req.Ip != "" {
+		wait, rule, err := o.Database.TakeVerifyCodeLimit(ctx, rateLimitRules("qrlogin:ip:"+req.Ip, config.Config.QRLogin.RateLimit))
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil {
+			return nil, eerrs.ErrQRLoginRateLimited.Wrap(fmt.Sprintf("retry after %d seconds", mctx.SetRetryAfter(ctx, wait)))
+		}
+	}
+	ticket, err := randHex(16)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := randHex(32)
+	if err != nil {
+		return nil, err
+	}
+	expire := config.Config.QRLogin.Expire
+	if expire <= 0 {
+		expire = defaultQRLoginExpire
+	}
+	t := &cache.QRLoginTicket{
+		Secret:   secret,
+		Status:   constant.QRLoginPending,
+		Platform: req.Platform,
+		DeviceID: req.DeviceID,
+		IP:       req.Ip,
+	}
+	if err := o.Database.CreateQRLogin(ctx, ticket, t, time.Duration(expire)*time.Second); err != nil {
+		return nil, err
+	}
+	return &chat.CreateQRLoginResp{
+		Ticket:     ticket,
+		Secret:     secret,
+		ExpireTime: time.Now().Add(time.Duration(expire) * time.Second).UnixMilli(),
+	}, nil
+}
+
+func (o *chatSvr) ScanQRLogin(ctx context.Context, req *chat.ScanQRLoginReq) (*chat.ScanQRLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.GetQRLogin(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, eerrs.ErrQRLoginExpired.Wrap()
+	}
+	// 同一用户重复扫码时保持已扫码状态
+	if !(t.Status == constant.QRLoginScanned && t.UserID == userID) {
+		ok, err := o.Database.TransitQRLogin(ctx, req.Ticket, constant.QRLoginPending, constant.QRLoginScanned, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, eerrs.ErrQRLoginExpired.Wrap("qrcode already scanned")
+		}
+	}
+	return &chat.ScanQRLoginResp{
+		Platform: t.Platform,
+		DeviceID: t.DeviceID,
+		Ip:       t.IP,
+	}, nil
+}
+
+func (o *chatSvr) ConfirmQRLogin(ctx context.Context, req *chat.ConfirmQRLoginReq) (*chat.ConfirmQRLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := o.Database.TransitQRLogin(ctx, req.Ticket, constant.QRLoginScanned, constant.QRLoginConfirmed, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, eerrs.ErrQRLoginExpired.Wrap("qrcode not scanned or expired")
+	}
+	return &chat.ConfirmQRLoginResp{}, nil
+}
+
+// GetQRLoginStatus 发起端查询状态, 已确认时签发token.
+// token保存在凭证中, 凭证过期前重复查询返回同一token, 避免响应丢失后发起端无法登录
+func (o *chatSvr) GetQRLoginStatus(ctx context.Context, req *chat.GetQRLoginStatusReq) (*chat.GetQRLoginStatusResp, error) {
+	t, err := o.Database.GetQRLogin(ctx, req.Ticket)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return &chat.GetQRLoginStatusResp{Status: constant.QRLoginExpired}, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(t.Secret), []byte(req.Secret)) != 1 {
+		return nil, errs.ErrArgs.Wrap("secret mismatch")
+	}
+	switch t.Status {
+	case constant.QRLoginIssued:
+		return qrLoginIssuedResp(t, t.ChatToken, t.RefreshToken), nil
+	case constant.QRLoginIssuing:
+		// 其他请求正在签发, 发起端继续轮询即可拿到token
+		return &chat.GetQRLoginStatusResp{Status: constant.QRLoginScanned, Platform: t.Platform}, nil
+	case constant.QRLoginConfirmed:
+	default:
+		return &chat.GetQRLoginStatusResp{Status: t.Status, Platform: t.Platform}, nil
+	}
+	ok, err := o.Database.TransitQRLogin(ctx, req.Ticket, constant.QRLoginConfirmed, constant.QRLoginIssuing, t.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &chat.GetQRLoginStatusResp{Status: constant.QRLoginScanned, Platform: t.Platform}, nil
+	}
+	if err := o.Admin.CheckLogin(ctx, t.UserID, t.IP); err != nil {
+		if _, err := o.Database.ConsumeQRLogin(ctx, req.Ticket, constant.QRLoginIssuing); err != nil {
+			log.ZError(ctx, "consume qrcode login failed", err, "ticket", req.Ticket)
+		}
+		return nil, err
+	}
+	chatToken, err := o.Admin.CreateToken(ctx, t.UserID, constant.NormalUser, &admin.TokenSession{Platform: t.Platform, DeviceID: t.DeviceID, Ip: t.IP})
+	if err != nil {
+		// 签发失败时恢复为已确认, 下次查询重试
+		if _, err := o.Database.TransitQRLogin(ctx, req.Ticket, constant.QRLoginIssuing, constant.QRLoginConfirmed, t.UserID); err != nil {
+			log.ZError(ctx, "reset qrcode login failed", err, "ticket", req.Ticket)
+		}
+		return nil, err
+	}
+	// token已生成, 保存失败或签发期间凭证过期时仍然返回给本次请求
+	if ok, err := o.Database.IssueQRLogin(ctx, req.Ticket, constant.QRLoginIssuing, constant.QRLoginIssued, chatToken.Token, chatToken.RefreshToken); err != nil {
+		log.ZError(ctx, "save qrcode login token failed", err, "ticket", req.Ticket, "userID", t.UserID)
+	} else if !ok {
+		log.ZWarn(ctx, "qrcode login expired while issuing", nil, "ticket", req.Ticket, "userID", t.UserID)
+	}
+	record := &chat2.UserLoginRecord{
+		UserID:    t.UserID,
+		LoginTime: time.Now(),
+		IP:        t.IP,
+		DeviceID:  t.DeviceID,
+		Platform:  constant2.PlatformIDToName(int(t.Platform)),
+	}
+	if err := o.Database.LoginRecord(ctx, record, nil); err != nil {
+		log.ZError(ctx, "qrcode login record failed", err, "userID", t.UserID)
+	}
+	return qrLoginIssuedResp(t, chatToken.Token, chatToken.RefreshToken), nil
+}
+
+func qrLoginIssuedResp(t *cache.QRLoginTicket, chatToken string, refreshToken string) *chat.GetQRLoginStatusResp {
+	return &chat.GetQRLoginStatusResp{
+		Status:       constant.QRLoginConfirmed,
+		UserID:       t.UserID,
+		ChatToken:    chatToken,
+		RefreshToken: refreshToken,
+		Platform:     t.Platform,
+	}
+}
@@ -16,6 +16,7 @@ package chat
 
 import (
 	"context"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/tools/errs"
 	"time"
@@ -23,6 +24,9 @@ import (
 
 func (o *chatSvr) UserLoginCount(ctx context.Context, req *chat.UserLoginCountReq) (*chat.UserLoginCountResp, error) {
 	resp := &chat.UserLoginCountResp{}
+	if err := o.checkAdminPermission(ctx, rbac.StatisticRead); err != nil {
+		return nil, err
+	}
 	if req.Start > req.End {
 		return nil, errs.ErrArgs.Wrap("start > end")
 	}
@@ -0,0 +1,212 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	constant2 "github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/common/totp"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/admin"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+func (o *chatSvr) EnrollTOTP(ctx context.Context, req *chat.EnrollTOTPReq) (*chat.EnrollTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err == nil {
+		if t.Enabled {
+			return nil, errs.ErrArgs.Wrap("totp already enabled")
+		}
+	} else if !o.Database.IsNotFound(err) {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now()
+	if err := o.Database.SaveTOTP(ctx, &chat2.TOTP{
+		UserID:     userID,
+		Secret:     secret,
+		Enabled:    false,
+		CreateTime: now,
+		ChangeTime: now,
+	}); err != nil {
+		return nil, err
+	}
+	return &chat.EnrollTOTPResp{
+		Secret: secret,
+		Url:    totp.URL(config.Config.TOTP.Issuer, o.totpLabel(attribute), secret),
+	}, nil
+}
+
+func (o *chatSvr) ConfirmTOTP(ctx context.Context, req *chat.ConfirmTOTPReq) (*chat.ConfirmTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return nil, errs.ErrArgs.Wrap("totp not enrolled")
+		}
+		return nil, err
+	}
+	if t.Enabled {
+		return nil, errs.ErrArgs.Wrap("totp already enabled")
+	}
+	update, codes, err := totp.Confirm(t.Secret, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateTOTP(ctx, userID, update); err != nil {
+		return nil, err
+	}
+	return &chat.ConfirmTOTPResp{RecoveryCodes: codes}, nil
+}
+
+func (o *chatSvr) DisableTOTP(ctx context.Context, req *chat.DisableTOTPReq) (*chat.DisableTOTPResp, error) {
+	defer log.ZDebug(ctx, "return")
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch userType {
+	case constant.NormalUser:
+		if req.UserID == "" {
+			req.UserID = opUserID
+		}
+		if req.UserID != opUserID {
+			return nil, errs.ErrNoPermission.Wrap("no permission disable other user totp")
+		}
+	case constant.AdminUser:
+		if req.UserID == "" {
+			return nil, errs.ErrArgs.Wrap("user id must be set")
+		}
+		if err := o.checkAdminPermission(ctx, rbac.UserWrite); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errs.ErrInternalServer.Wrap("invalid user type")
+	}
+	t, err := o.Database.TakeTOTP(ctx, req.UserID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return &chat.DisableTOTPResp{}, nil
+		}
+		return nil, err
+	}
+	if userType != constant.AdminUser && t.Enabled {
+		if err := totp.CheckCode(ctx, o.Database, t.UserID, t.Secret, t.RecoveryCodes, req.Code); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.Database.DelTOTP(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	return &chat.DisableTOTPResp{}, nil
+}
+
+func (o *chatSvr) VerifyTOTPLogin(ctx context.Context, req *chat.VerifyTOTPLoginReq) (*chat.VerifyTOTPLoginResp, error) {
+	defer log.ZDebug(ctx, "return")
+	userID, err := totp.TakeChallenge(ctx, o.Database, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.checkLoginLocked(ctx, userLoginLockKey(userID)); err != nil {
+		return nil, err
+	}
+	if err := o.Admin.CheckLogin(ctx, userID, req.Ip); err != nil {
+		return nil, err
+	}
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := totp.VerifyChallenge(ctx, o.Database, req.ChallengeToken, userID, t.Secret, t.RecoveryCodes, req.Code); err != nil {
+		if eerrs.ErrTOTPNotMatch.Is(err) {
+			o.loginFailed(ctx, userID, req.Ip)
+		}
+		return nil, err
+	}
+	chatToken, err := o.Admin.CreateToken(ctx, userID, constant.NormalUser, &admin.TokenSession{Platform: req.Platform, DeviceID: req.DeviceID, Ip: req.Ip})
+	if err != nil {
+		return nil, err
+	}
+	record := &chat2.UserLoginRecord{
+		UserID:    userID,
+		LoginTime: time.Now(),
+		IP:        req.Ip,
+		DeviceID:  req.DeviceID,
+		Platform:  constant2.PlatformIDToName(int(req.Platform)),
+	}
+	if err := o.Database.LoginRecord(ctx, record, nil); err != nil {
+		return nil, err
+	}
+	o.loginSucceeded(ctx, userID)
+	return &chat.VerifyTOTPLoginResp{
+		ChatToken:    chatToken.Token,
+		UserID:       userID,
+		RefreshToken: chatToken.RefreshToken,
+	}, nil
+}
+
+// loginChallenge 用户开启二次验证时生成登录凭证, 未开启返回空
+func (o *chatSvr) loginChallenge(ctx context.Context, userID string) (string, error) {
+	t, err := o.Database.TakeTOTP(ctx, userID)
+	if err != nil {
+		if o.Database.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if !t.Enabled {
+		return "", nil
+	}
+	return totp.NewChallenge(ctx, o.Database, userID)
+}
+
+func (o *chatSvr) totpLabel(attribute *chat2.Attribute) string {
+	switch {
+	case attribute.Account != "":
+		return attribute.Account
+	case attribute.PhoneNumber != "":
+		return attribute.AreaCode + attribute.PhoneNumber
+	case attribute.Email != "":
+		return attribute.Email
+	default:
+		return attribute.UserID
+	}
+}
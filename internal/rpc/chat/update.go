@@ -63,6 +63,9 @@ func ToDBAttributeUpdate(req *chat.UpdateUserInfoReq) (map[string]any, error) {
 	if req.GlobalRecvMsgOpt != nil {
 		update["global_recv_msg_opt"] = req.GlobalRecvMsgOpt.Value
 	}
+	if req.Language != nil {
+		update["language"] = req.Language.Value
+	}
 	if len(update) == 0 {
 		return nil, errs.ErrArgs.Wrap("no update info")
 	}
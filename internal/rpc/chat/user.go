@@ -19,6 +19,7 @@ import (
 
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
 	"github.com/OpenIMSDK/chat/pkg/proto/chat"
 	"github.com/OpenIMSDK/tools/errs"
@@ -56,6 +57,9 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 			return nil, errs.ErrNoPermission.Wrap("level can not be updated")
 		}
 	case constant.AdminUser:
+		if err := o.checkAdminPermission(ctx, rbac.UserWrite); err != nil {
+			return nil, err
+		}
 	default:
 		return nil, errs.ErrNoPermission.Wrap("user type error")
 	}
@@ -75,6 +79,14 @@ func (o *chatSvr) UpdateUserInfo(ctx context.Context, req *chat.UpdateUserInfoRe
 			return nil, err
 		}
 	}
+	if req.Email != nil && req.Email.Value != "" && req.Email.Value != attribute.Email {
+		_, err := o.Database.TakeAttributeByEmail(ctx, req.Email.Value)
+		if err == nil {
+			return nil, eerrs.ErrEmailAlreadyRegister.Wrap()
+		} else if !o.Database.IsNotFound(err) {
+			return nil, err
+		}
+	}
 	if req.AreaCode != nil || req.PhoneNumber != nil {
 		areaCode := attribute.AreaCode
 		phoneNumber := attribute.PhoneNumber
@@ -130,7 +142,7 @@ func (o *chatSvr) SearchUserPublicInfo(ctx context.Context, req *chat.SearchUser
 
 func (o *chatSvr) FindUserFullInfo(ctx context.Context, req *chat.FindUserFullInfoReq) (*chat.FindUserFullInfoResp, error) {
 	defer log.ZDebug(ctx, "return")
-	if _, _, err := mctx.Check(ctx); err != nil {
+	if err := o.checkFullInfo(ctx); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -144,7 +156,7 @@ func (o *chatSvr) FindUserFullInfo(ctx context.Context, req *chat.FindUserFullIn
 }
 
 func (o *chatSvr) SearchUserFullInfo(ctx context.Context, req *chat.SearchUserFullInfoReq) (*chat.SearchUserFullInfoResp, error) {
-	if _, _, err := mctx.Check(ctx); err != nil {
+	if err := o.checkFullInfo(ctx); err != nil {
 		return nil, err
 	}
 	total, list, err := o.Database.Search(ctx, req.Normal, req.Keyword, req.Genders, req.Pagination.PageNumber, req.Pagination.ShowNumber)
@@ -157,6 +169,18 @@ func (o *chatSvr) SearchUserFullInfo(ctx context.Context, req *chat.SearchUserFu
 	}, nil
 }
 
+// checkFullInfo 管理员查询用户完整信息需要用户读权限
+func (o *chatSvr) checkFullInfo(ctx context.Context) error {
+	_, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if userType == constant.AdminUser {
+		return o.checkAdminPermission(ctx, rbac.UserRead)
+	}
+	return nil
+}
+
 func (o *chatSvr) FindUserAccount(ctx context.Context, req *chat.FindUserAccountReq) (*chat.FindUserAccountResp, error) {
 	defer log.ZDebug(ctx, "return")
 	if len(req.UserIDs) == 0 {
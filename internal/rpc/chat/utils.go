@@ -57,6 +57,7 @@ func DbToPbUserFullInfo(attribute *chat.Attribute) *common.UserFullInfo {
 		AllowBeep:        attribute.AllowBeep,
 		AllowVibration:   attribute.AllowVibration,
 		GlobalRecvMsgOpt: attribute.GlobalRecvMsgOpt,
+		Language:         attribute.Language,
 	}
 }
 
@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+// rateLimitRules 将配置转换为限流规则, 忽略未启用的配置
+func rateLimitRules(key string, limits []config.RateLimit) []cache.RateLimitRule {
+	var rules []cache.RateLimitRule
+	for _, l := range limits {
+		if l.Window <= 0 || l.Max <= 0 {
+			continue
+		}
+		rules = append(rules, cache.RateLimitRule{
+			Key:    key + ":" + strconv.Itoa(l.Window),
+			Window: time.Duration(l.Window) * time.Second,
+			Max:    l.Max,
+		})
+	}
+	return rules
+}
+
+// checkVerifyCodeLimit 按手机号或邮箱、IP、设备、区号及全局短信总量限流, 通过时计入本次发送
+func (o *chatSvr) checkVerifyCodeLimit(ctx context.Context, req *chat.SendVerifyCodeReq) error {
+	limit := config.Config.VerifyCode.RateLimit
+	account := limit.Account
+	if len(account) == 0 {
+		account = []config.RateLimit{{Window: config.Config.VerifyCode.UintTime, Max: config.Config.VerifyCode.MaxCount}}
+	}
+	var rules []cache.RateLimitRule
+	add := func(key string, limits []config.RateLimit) {
+		rules = append(rules, rateLimitRules(key, limits)...)
+	}
+	add("account:"+o.verifyCodeJoin(req.AreaCode, req.PhoneNumber, req.Email), account)
+	if req.Ip != "" {
+		add("ip:"+req.Ip, limit.IP)
+	}
+	if req.DeviceID != "" {
+		add("device:"+req.DeviceID, limit.Device)
+	}
+	if req.PhoneNumber != "" {
+		add("area:"+req.AreaCode, limit.AreaCode)
+		add("sms", []config.RateLimit{{Window: 3600, Max: limit.SMSPerHour}})
+	}
+	wait, rule, err := o.Database.TakeVerifyCodeLimit(ctx, rules)
+	if err != nil {
+		return err
+	}
+	if rule != nil {
+		kind := strings.SplitN(rule.Key, ":", 2)[0]
+		seconds := mctx.SetRetryAfter(ctx, wait)
+		return eerrs.ErrVerifyCodeRateLimited.Wrap(fmt.Sprintf("%s limit exceeded, retry after %d seconds", kind, seconds))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	chat2 "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
+	"github.com/OpenIMSDK/chat/pkg/proto/chat"
+)
+
+// UpdateVerifyCodeStatus 服务商推送的发送回执, 由chat-api校验回执token后调用
+func (o *chatSvr) UpdateVerifyCodeStatus(ctx context.Context, req *chat.UpdateVerifyCodeStatusReq) (*chat.UpdateVerifyCodeStatusResp, error) {
+	defer log.ZDebug(ctx, "return")
+	now := time.Now()
+	for _, receipt := range req.Receipts {
+		if err := o.Database.UpdateVerifyCodeStatus(ctx, receipt.Provider, receipt.MessageID, receipt.Status, receipt.Desc, now); err != nil {
+			return nil, err
+		}
+	}
+	return &chat.UpdateVerifyCodeStatusResp{}, nil
+}
+
+func (o *chatSvr) SearchVerifyCode(ctx context.Context, req *chat.SearchVerifyCodeReq) (*chat.SearchVerifyCodeResp, error) {
+	defer log.ZDebug(ctx, "return")
+	if err := o.checkAdminPermission(ctx, rbac.VerifyCodeRead); err != nil {
+		return nil, err
+	}
+	total, verifyCodes, err := o.Database.SearchVerifyCode(ctx, req.Keyword, req.Status, time.UnixMilli(req.StartTime), time.UnixMilli(req.EndTime), req.Pagination.PageNumber, req.Pagination.ShowNumber)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.SearchVerifyCodeResp{Total: total, VerifyCodes: make([]*chat.VerifyCodeInfo, 0, len(verifyCodes))}
+	for _, v := range verifyCodes {
+		resp.VerifyCodes = append(resp.VerifyCodes, DbToPbVerifyCodeInfo(v))
+	}
+	return resp, nil
+}
+
+// DbToPbVerifyCodeInfo 不返回验证码本身
+func DbToPbVerifyCodeInfo(v *chat2.VerifyCode) *chat.VerifyCodeInfo {
+	info := &chat.VerifyCodeInfo{
+		Id:         uint64(v.ID),
+		Account:    v.Account,
+		UsedFor:    v.UsedFor,
+		Provider:   v.Provider,
+		MessageID:  v.MessageID,
+		Status:     v.Status,
+		StatusDesc: v.StatusDesc,
+		Used:       v.Used,
+		Count:      int32(v.Count),
+		CreateTime: v.CreateTime.UnixMilli(),
+	}
+	if !v.StatusTime.IsZero() {
+		info.StatusTime = v.StatusTime.UnixMilli()
+	}
+	return info
+}
@@ -34,6 +34,7 @@ type CallerInterface interface {
 	InviteToGroup(ctx context.Context, userID string, groupIDs []string) error
 	UpdateUserInfo(ctx context.Context, userID string, nickName string, faceURL string) error
 	ForceOffLine(ctx context.Context, userID string) error
+	ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error
 	RegisterUser(ctx context.Context, users []*sdkws.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
@@ -113,6 +114,17 @@ func (c *Caller) ForceOffLine(ctx context.Context, userID string) error {
 	return nil
 }
 
+func (c *Caller) ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error {
+	if _, ok := constant.PlatformID2Name[int(platformID)]; !ok {
+		return nil
+	}
+	_, err := forceOffLine.Call(ctx, &auth.ForceLogoutReq{
+		PlatformID: platformID,
+		UserID:     userID,
+	})
+	return err
+}
+
 func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkws.GroupInfo, error) {
 	resp, err := getGroupsInfo.Call(ctx, &group.GetGroupsInfoReq{
 		GroupIDs: groupIDs,
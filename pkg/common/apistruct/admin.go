@@ -17,11 +17,21 @@ package apistruct
 import "github.com/OpenIMSDK/protocol/sdkws"
 
 type AdminLoginResp struct {
-	AdminAccount string `json:"adminAccount"`
+	AdminAccount   string `json:"adminAccount"`
+	AdminToken     string `json:"adminToken"`
+	Nickname       string `json:"nickname"`
+	FaceURL        string `json:"faceURL"`
+	Level          int32  `json:"level"`
+	AdminUserID    string `json:"adminUserID"`
+	ImUserID       string `json:"imUserID"`
+	ImToken        string `json:"imToken"`
+	ChallengeToken string `json:"challengeToken"`
+	RefreshToken   string `json:"refreshToken"`
+}
+
+type AdminRefreshTokenResp struct {
 	AdminToken   string `json:"adminToken"`
-	Nickname     string `json:"nickname"`
-	FaceURL      string `json:"faceURL"`
-	Level        int32  `json:"level"`
+	RefreshToken string `json:"refreshToken"`
 	AdminUserID  string `json:"adminUserID"`
 	ImUserID     string `json:"imUserID"`
 	ImToken      string `json:"imToken"`
@@ -15,15 +15,38 @@
 package apistruct
 
 type UserRegisterResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	UserID       string `json:"userID"`
+	RefreshToken string `json:"refreshToken"`
 }
 
 type LoginResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken        string `json:"imToken"`
+	ChatToken      string `json:"chatToken"`
+	UserID         string `json:"userID"`
+	ChallengeToken string `json:"challengeToken"`
+	RefreshToken   string `json:"refreshToken"`
+}
+
+type QRLoginStatusResp struct {
+	Status       int32  `json:"status"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	UserID       string `json:"userID"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RefreshTokenResp struct {
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	UserID       string `json:"userID"`
+	RefreshToken string `json:"refreshToken"`
 }
 
 type UpdateUserInfoResp struct{}
+
+// RetryAfterResp 被限流时随错误码返回.
+type RetryAfterResp struct {
+	RetryAfter int64 `json:"retryAfter"` // 需要等待的秒数
+}
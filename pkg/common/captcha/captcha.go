@@ -0,0 +1,157 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package captcha generates image captchas made of distorted digits.
+//
+// The images are drawn with a built-in 5x7 bitmap font, so no font files or
+// external services are needed.
+package captcha
+
+import (
+	"bytes"
+	"crypto/rand"
+	"image"
+	"image/color"
+	"image/png"
+	"math/big"
+)
+
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+	scale       = 4
+	cellWidth   = (glyphWidth + 2) * scale
+	height      = (glyphHeight + 4) * scale
+	padding     = 2 * scale
+)
+
+var glyphs = [10][glyphHeight]string{
+	{"01110", "10001", "10011", "10101", "11001", "10001", "01110"},
+	{"00100", "01100", "00100", "00100", "00100", "00100", "01110"},
+	{"01110", "10001", "00001", "00010", "00100", "01000", "11111"},
+	{"11111", "00010", "00100", "00010", "00001", "10001", "01110"},
+	{"00010", "00110", "01010", "10010", "11111", "00010", "00010"},
+	{"11111", "10000", "11110", "00001", "00001", "10001", "01110"},
+	{"00110", "01000", "10000", "11110", "10001", "10001", "01110"},
+	{"11111", "00001", "00010", "00100", "01000", "01000", "01000"},
+	{"01110", "10001", "10001", "01110", "10001", "10001", "01110"},
+	{"01110", "10001", "10001", "01111", "00001", "00010", "01100"},
+}
+
+func randInt(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(v.Int64())
+}
+
+func randColor(min, max int) color.RGBA {
+	c := func() uint8 { return uint8(min + randInt(max-min)) }
+	return color.RGBA{R: c(), G: c(), B: c(), A: 0xff}
+}
+
+// New returns a random digit answer of the given length and its PNG image.
+func New(length int) (string, []byte, error) {
+	answer := make([]byte, length)
+	for i := range answer {
+		answer[i] = byte('0' + randInt(10))
+	}
+	img, err := Draw(string(answer))
+	if err != nil {
+		return "", nil, err
+	}
+	return string(answer), img, nil
+}
+
+// Draw renders digits as a PNG image with per-digit offsets, skew and noise.
+func Draw(digits string) ([]byte, error) {
+	width := len(digits)*cellWidth + 2*padding
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	bg := randColor(220, 255)
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, bg)
+		}
+	}
+	for i := 0; i < width*height/12; i++ {
+		img.Set(randInt(width), randInt(height), randColor(100, 220))
+	}
+	for i, d := range digits {
+		if d < '0' || d > '9' {
+			continue
+		}
+		fg := randColor(0, 120)
+		x0 := padding + i*cellWidth + randInt(scale*2)
+		y0 := scale + randInt(scale*3)
+		skew := randInt(3) - 1
+		for row, line := range glyphs[d-'0'] {
+			shift := skew * (glyphHeight/2 - row)
+			for col, bit := range line {
+				if bit != '1' {
+					continue
+				}
+				for dx := 0; dx < scale; dx++ {
+					for dy := 0; dy < scale; dy++ {
+						img.Set(x0+col*scale+dx+shift, y0+row*scale+dy, fg)
+					}
+				}
+			}
+		}
+	}
+	for i := 0; i < 3; i++ {
+		line(img, randInt(width/3), randInt(height), width-1-randInt(width/3), randInt(height), randColor(0, 160))
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := sign(x1-x0), sign(y1-y0)
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		img.Set(x0, y0+1, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	if v < 0 {
+		return -1
+	}
+	return 1
+}
@@ -48,7 +48,8 @@ func Start(rpcPort int, rpcRegisterName string, prometheusPort int, rpcFn func(c
 	if err != nil {
 		return utils.Wrap1(err)
 	}
-	srv := grpc.NewServer(append(options, mw.GrpcServer())...)
+	// mw.GrpcServer需要最先执行, 后续拦截器才能从ctx中获取opUserID等信息
+	srv := grpc.NewServer(append([]grpc.ServerOption{mw.GrpcServer()}, options...)...)
 	defer srv.GracefulStop()
 	err = rpcFn(zkClient, srv)
 	if err != nil {
@@ -41,6 +41,8 @@ var Config struct {
 	AdminApi struct {
 		GinPort  []int  `yaml:"openImAdminApiPort"`
 		ListenIP string `yaml:"listenIP"`
+
+		IPAllowlistBypass bool `yaml:"ipAllowlistBypass"` // 跳过管理员ip白名单, 仅用于误配置后的紧急恢复
 	} `yaml:"adminApi"`
 	Rpc struct {
 		RegisterIP string `yaml:"registerIP"`
@@ -85,8 +87,73 @@ var Config struct {
 	OpenIMUrl   string  `yaml:"openIMUrl"`
 	OpenJavaUrl string  `yaml:"openJavaUrl"`
 	TokenPolicy struct {
-		Expire *int64 `yaml:"expire"`
+		Expire        *int64 `yaml:"expire"`
+		AccessExpire  int64  `yaml:"accessExpire"`
+		RefreshExpire int64  `yaml:"refreshExpire"`
 	} `yaml:"tokenPolicy"`
+	PasswordHash struct {
+		Algorithm  string `yaml:"algorithm"`
+		BcryptCost int    `yaml:"bcryptCost"`
+	} `yaml:"passwordHash"`
+	PasswordPolicy struct {
+		MinLength    int    `yaml:"minLength"`
+		MinClasses   int    `yaml:"minClasses"`
+		DenylistFile string `yaml:"denylistFile"`
+		HistoryCount int    `yaml:"historyCount"`
+	} `yaml:"passwordPolicy"`
+	TOTP struct {
+		Issuer          string `yaml:"issuer"`
+		ChallengeExpire int    `yaml:"challengeExpire"`
+	} `yaml:"totp"`
+	AdminLogin struct {
+		MaxFailed int `yaml:"maxFailed"`
+		LockTime  int `yaml:"lockTime"`
+
+		GeoIP struct {
+			Url   string `yaml:"url"`
+			Field string `yaml:"field"`
+		} `yaml:"geoIP"`
+		Alert struct {
+			Use     string `yaml:"use"`
+			Webhook struct {
+				Url     string            `yaml:"url"`
+				Headers map[string]string `yaml:"headers"`
+			} `yaml:"webhook"`
+		} `yaml:"alert"`
+	} `yaml:"adminLogin"`
+	WebAuthn struct {
+		RPID    string   `yaml:"rpID"`
+		RPName  string   `yaml:"rpName"`
+		Origins []string `yaml:"origins"`
+		Timeout int      `yaml:"timeout"`
+	} `yaml:"webAuthn"`
+	QRLogin struct {
+		Expire    int         `yaml:"expire"`
+		RateLimit []RateLimit `yaml:"rateLimit"`
+	} `yaml:"qrLogin"`
+	UserLogin struct {
+		MaxFailed   int `yaml:"maxFailed"`
+		IPMaxFailed int `yaml:"ipMaxFailed"`
+		LockTime    int `yaml:"lockTime"`
+		MaxLockTime int `yaml:"maxLockTime"`
+	} `yaml:"userLogin"`
+	AccountDeletion struct {
+		GracePeriod int    `yaml:"gracePeriod"`
+		Interval    int    `yaml:"interval"`
+		Nickname    string `yaml:"nickname"`
+	} `yaml:"accountDeletion"`
+	Block struct {
+		ExpireInterval int `yaml:"expireInterval"`
+	} `yaml:"block"`
+	Captcha struct {
+		Length     int `yaml:"length"`
+		Expire     int `yaml:"expire"`
+		PassExpire int `yaml:"passExpire"`
+		Window     int `yaml:"window"`
+	} `yaml:"captcha"`
+	ChangeContact struct {
+		VerifyOld bool `yaml:"verifyOld"`
+	} `yaml:"changeContact"`
 	VerifyCode struct {
 		ValidTime int    `yaml:"validTime"`
 		UintTime  int    `yaml:"uintTime"`
@@ -95,15 +162,62 @@ var Config struct {
 		Len       int    `yaml:"len"`
 		Use       string `yaml:"use"`
 		Ali       struct {
-			Endpoint                     string `yaml:"endpoint"`
-			AccessKeyId                  string `yaml:"accessKeyId"`
-			AccessKeySecret              string `yaml:"accessKeySecret"`
-			SignName                     string `yaml:"signName"`
-			VerificationCodeTemplateCode string `yaml:"verificationCodeTemplateCode"`
+			Endpoint                     string              `yaml:"endpoint"`
+			AccessKeyId                  string              `yaml:"accessKeyId"`
+			AccessKeySecret              string              `yaml:"accessKeySecret"`
+			SignName                     string              `yaml:"signName"`
+			VerificationCodeTemplateCode string              `yaml:"verificationCodeTemplateCode"`
+			Templates                    VerifyCodeTemplates `yaml:"templates"`
 		} `yaml:"ali"`
+		Twilio struct {
+			Endpoint            string              `yaml:"endpoint"`
+			AccountSid          string              `yaml:"accountSid"`
+			AuthToken           string              `yaml:"authToken"`
+			From                string              `yaml:"from"`
+			MessagingServiceSid string              `yaml:"messagingServiceSid"`
+			StatusCallback      string              `yaml:"statusCallback"`
+			Body                string              `yaml:"body"`
+			Templates           VerifyCodeTemplates `yaml:"templates"`
+		} `yaml:"twilio"`
+		Tencent struct {
+			Endpoint   string              `yaml:"endpoint"`
+			Region     string              `yaml:"region"`
+			SecretId   string              `yaml:"secretId"`
+			SecretKey  string              `yaml:"secretKey"`
+			SdkAppId   string              `yaml:"sdkAppId"`
+			SignName   string              `yaml:"signName"`
+			TemplateId string              `yaml:"templateId"`
+			Templates  VerifyCodeTemplates `yaml:"templates"`
+		} `yaml:"tencent"`
+		Webhook struct {
+			Url         string            `yaml:"url"`
+			Method      string            `yaml:"method"`
+			ContentType string            `yaml:"contentType"`
+			Headers     map[string]string `yaml:"headers"`
+			Body        string            `yaml:"body"`
+		} `yaml:"webhook"`
+		Mail struct {
+			Use                     string              `yaml:"use"`
+			Title                   string              `yaml:"title"`
+			SenderMail              string              `yaml:"senderMail"`
+			SenderAuthorizationCode string              `yaml:"senderAuthorizationCode"`
+			SmtpAddr                string              `yaml:"smtpAddr"`
+			SmtpPort                int                 `yaml:"smtpPort"`
+			Templates               VerifyCodeTemplates `yaml:"templates"`
+		} `yaml:"mail"`
+		RateLimit struct {
+			Account    []RateLimit `yaml:"account"`
+			IP         []RateLimit `yaml:"ip"`
+			Device     []RateLimit `yaml:"device"`
+			AreaCode   []RateLimit `yaml:"areaCode"`
+			SMSPerHour int         `yaml:"smsPerHour"`
+		} `yaml:"rateLimit"`
+		Routes       []SMSRoute `yaml:"routes"`
+		ReceiptToken string     `yaml:"receiptToken"`
 	} `yaml:"verifyCode"`
-	ProxyHeader string  `yaml:"proxyHeader"`
-	AdminList   []Admin `yaml:"adminList"`
+	ProxyHeader    string   `yaml:"proxyHeader"`
+	TrustedProxies []string `yaml:"trustedProxies"` // 只有来自这些ip或网段的请求才读取proxyHeader
+	AdminList      []Admin  `yaml:"adminList"`
 
 	Oauth struct {
 		AccessTokenExp    int    `yaml:"accessTokenExp"`
@@ -113,6 +227,18 @@ var Config struct {
 	} `yaml:"oauth"`
 }
 
+// RateLimit Window秒内最多发送Max次.
+type RateLimit struct {
+	Window int `yaml:"window"`
+	Max    int `yaml:"max"`
+}
+
+// SMSRoute 区号使用的短信服务, 按顺序尝试直到发送成功, AreaCode为空时为默认规则.
+type SMSRoute struct {
+	AreaCode []string `yaml:"areaCode"`
+	Use      []string `yaml:"use"`
+}
+
 type Admin struct {
 	AdminID   string `yaml:"adminID"`
 	NickName  string `yaml:"nickname"`
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -66,6 +67,9 @@ func InitConfig(configFile string) error {
 	if err := yaml.NewDecoder(bytes.NewReader(data)).Decode(&Config); err != nil {
 		return fmt.Errorf("parse loacl openIMConfig file error: %w", err)
 	}
+	if err := checkTrustedProxies(); err != nil {
+		return err
+	}
 	zk, err := openKeeper.NewClient(Config.Zookeeper.ZkAddr, Config.Zookeeper.Schema,
 		openKeeper.WithFreq(time.Hour), openKeeper.WithUserNameAndPassword(Config.Zookeeper.Username,
 			Config.Zookeeper.Password), openKeeper.WithRoundRobin(), openKeeper.WithTimeout(10), openKeeper.WithLogger(&zkLogger{}))
@@ -155,6 +159,35 @@ func GetIMAdmin(chatAdminID string) string {
 	return ""
 }
 
+// IsIMAdmin 判断是否为配置adminList中的openIM应用管理员
+func IsIMAdmin(imUserID string) bool {
+	for _, admin := range Config.AdminList {
+		if admin.ImAdminID == imUserID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTrustedProxies 配置了proxyHeader时必须配置trustedProxies, 否则不会读取proxyHeader, 所有请求的ip都变成代理的ip
+func checkTrustedProxies() error {
+	if Config.ProxyHeader == "" {
+		return nil
+	}
+	if len(Config.TrustedProxies) == 0 {
+		return fmt.Errorf("proxyHeader %q is set but trustedProxies is empty, add the reverse proxy ip or cidr to trustedProxies", Config.ProxyHeader)
+	}
+	for _, proxy := range Config.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			return fmt.Errorf("trustedProxies %q is not an ip or cidr", proxy)
+		}
+	}
+	return nil
+}
+
 type zkLogger struct{}
 
 func (l *zkLogger) Printf(format string, a ...interface{}) {
@@ -0,0 +1,77 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "strings"
+
+// VerifyCodeTemplate 按用途和语言选择的验证码模板, UsedFor为空匹配所有用途, Language为空匹配所有语言.
+type VerifyCodeTemplate struct {
+	UsedFor      []int32 `yaml:"usedFor"`
+	Language     string  `yaml:"language"`
+	SignName     string  `yaml:"signName"`     // ali, tencent
+	TemplateCode string  `yaml:"templateCode"` // ali, tencent 模板ID
+	Title        string  `yaml:"title"`        // mail 标题
+	Body         string  `yaml:"body"`         // twilio, mail 内容模板
+}
+
+type VerifyCodeTemplates []VerifyCodeTemplate
+
+// Match 返回匹配的模板下标, 优先匹配指定用途, 其次匹配语言, 语言zh可匹配zh-CN, 没有匹配时返回-1
+func (t VerifyCodeTemplates) Match(usedFor int32, language string) int {
+	var (
+		res   = -1
+		score = -1
+	)
+	for i, tpl := range t {
+		s := 0
+		if len(tpl.UsedFor) > 0 {
+			if !containsUsedFor(tpl.UsedFor, usedFor) {
+				continue
+			}
+			s += 4
+		}
+		if tpl.Language != "" {
+			switch {
+			case strings.EqualFold(tpl.Language, language):
+				s += 2
+			case strings.EqualFold(tpl.Language, baseLanguage(language)):
+				s++
+			default:
+				continue
+			}
+		}
+		if s > score {
+			res, score = i, s
+		}
+	}
+	return res
+}
+
+func containsUsedFor(usedFor []int32, v int32) bool {
+	for _, u := range usedFor {
+		if u == v {
+			return true
+		}
+	}
+	return false
+}
+
+// baseLanguage zh-CN -> zh
+func baseLanguage(language string) string {
+	if i := strings.IndexAny(language, "-_"); i > 0 {
+		return language[:i]
+	}
+	return language
+}
@@ -21,6 +21,10 @@ const (
 	VerificationCodeForRegister      = 1 // 注册
 	VerificationCodeForResetPassword = 2 // 重置密码
 	VerificationCodeForLogin         = 3 // 登录
+	VerificationCodeForDeleteAccount = 4 // 注销账号
+	VerificationCodeForChangeContact = 5 // 更换或绑定手机号、邮箱, 发送到新号码
+	VerificationCodeForConfirmOld    = 6 // 更换手机号或邮箱时验证原号码
+	VerificationCodeForVerifyOwner   = 7 // 绑定解绑登录方式、注册通行密钥等敏感操作前验证本人
 
 	VerificationCodeForRegisterSuffix = "_forRegister"
 	VerificationCodeForResetSuffix    = "_forReset"
@@ -29,12 +33,35 @@ const (
 
 const LogFileName = "chat.log"
 
+// 验证码发送状态, 由服务商回执更新.
+const (
+	VerifyCodeStatusUnknown   = 0 // 未知(超级验证码或服务商不支持回执)
+	VerifyCodeStatusSent      = 1 // 已提交服务商
+	VerifyCodeStatusDelivered = 2 // 已送达
+	VerifyCodeStatusFailed    = 3 // 发送失败
+)
+
 // block unblock.
 const (
 	BlockUser   = 1
 	UnblockUser = 2
 )
 
+// block category.
+const (
+	BlockCategorySpam    = "spam"
+	BlockCategoryFraud   = "fraud"
+	BlockCategoryAbuse   = "abuse"
+	BlockCategoryOther   = "other"
+	BlockCategoryDeleted = "deleted" // 账号已注销
+)
+
+// unblock type.
+const (
+	UnblockTypeManual  = 1 // 管理员解封
+	UnblockTypeExpired = 2 // 到期自动解封
+)
+
 // AccountType.
 const (
 	Email   = "email"
@@ -54,6 +81,20 @@ const (
 	AdvancedUserLevel = 100
 )
 
+// admin level, 即管理员角色.
+const (
+	AdminLevelAuditor  = 1   // 只读审计
+	AdminLevelSupport  = 20  // 客服
+	AdminLevelOperator = 50  // 运营
+	AdminLevelSuper    = 100 // 超级管理员
+)
+
+// admin status.
+const (
+	AdminStatusNormal   = 0
+	AdminStatusDisabled = 1 // 禁用, 不能登录
+)
+
 // AddFriendCtrl.
 const (
 	OrdinaryUserAddFriendEnable  = 1  // 允许普通用户添加好友
@@ -156,12 +197,20 @@ const (
 	RpcOperationID = constant.OperationID
 	RpcOpUserID    = constant.OpUserID
 	RpcOpUserType  = "opUserType"
+	RpcOpUserIP    = "opUserIP"
 )
 
 const RpcCustomHeader = constant.RpcCustomHeader
 
 const NeedInvitationCodeRegisterConfigKey = "needInvitationCodeRegister"
 
+// 需要人机验证的条件, 由管理员在客户端配置中设置.
+const (
+	CaptchaIPSendCodeConfigKey = "captchaIPSendCode" // 同一IP在统计周期内发送验证码次数超过该值后需要人机验证, 为空或0不限制
+	CaptchaIPLoginConfigKey    = "captchaIPLogin"    // 同一IP在统计周期内登录次数超过该值后需要人机验证, 为空或0不限制
+	CaptchaNewDeviceConfigKey  = "captchaNewDevice"  // 使用未登录过的设备登录时需要人机验证
+)
+
 const (
 	DefaultAllowVibration = 1
 	DefaultAllowBeep      = 1
@@ -175,4 +224,17 @@ const (
 
 const DefaultPlatform = 1
 
+// 扫码登录状态.
+const (
+	QRLoginPending   = 0 // 等待扫码
+	QRLoginScanned   = 1 // 已扫码, 等待确认
+	QRLoginConfirmed = 2 // 已确认
+	QRLoginExpired   = 3 // 已过期或已使用
+	QRLoginIssuing   = 4 // 正在签发token, 仅服务端内部使用
+	QRLoginIssued    = 5 // 已签发token, 仅服务端内部使用
+)
+
 const CtxApiToken = "api-token"
+
+// RpcRetryAfter 被限流时rpc通过trailer返回需要等待的秒数.
+const RpcRetryAfter = "retry-after"
@@ -0,0 +1,72 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const adminIPAllow = "CHAT_ADMIN_IP_ALLOW"
+
+// AdminIPAllowInterface 管理员ip白名单缓存, 每个管理后台请求都会校验, 避免每次查询数据库.
+type AdminIPAllowInterface interface {
+	// GetCIDRs 缓存不存在返回nil, 白名单为空返回空切片
+	GetCIDRs(ctx context.Context) ([]string, error)
+	SetCIDRs(ctx context.Context, cidrs []string, expire time.Duration) error
+	DelCIDRs(ctx context.Context) error
+}
+
+type AdminIPAllowRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewAdminIPAllowInterface(rdb redis.UniversalClient) *AdminIPAllowRedis {
+	return &AdminIPAllowRedis{rdb: rdb}
+}
+
+func (a *AdminIPAllowRedis) GetCIDRs(ctx context.Context) ([]string, error) {
+	data, err := a.rdb.Get(ctx, adminIPAllow).Bytes()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, errs.Wrap(err)
+	}
+	cidrs := make([]string, 0)
+	if err := json.Unmarshal(data, &cidrs); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return cidrs, nil
+}
+
+func (a *AdminIPAllowRedis) SetCIDRs(ctx context.Context, cidrs []string, expire time.Duration) error {
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+	data, err := json.Marshal(cidrs)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(a.rdb.Set(ctx, adminIPAllow, data, expire).Err())
+}
+
+func (a *AdminIPAllowRedis) DelCIDRs(ctx context.Context) error {
+	return errs.Wrap(a.rdb.Del(ctx, adminIPAllow).Err())
+}
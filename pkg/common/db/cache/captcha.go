@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	captcha     = "CHAT_CAPTCHA:"
+	captchaPass = "CHAT_CAPTCHA_PASS:"
+	captchaRisk = "CHAT_CAPTCHA_RISK:"
+)
+
+// CaptchaInterface 人机验证的题目、通过凭证与触发人机验证的计数.
+type CaptchaInterface interface {
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	// TakeCaptcha 取出并删除答案, 每个题目只能验证一次, 不存在或过期时返回空
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+	SetPass(ctx context.Context, token string, expire time.Duration) error
+	// TakePass 使用通过凭证, 每个凭证只能使用一次
+	TakePass(ctx context.Context, token string) (bool, error)
+	// IncrRisk 增加计数, 计数在window内有效
+	IncrRisk(ctx context.Context, key string, window time.Duration) (int64, error)
+}
+
+type CaptchaRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewCaptchaInterface(rdb redis.UniversalClient) *CaptchaRedis {
+	return &CaptchaRedis{rdb: rdb}
+}
+
+func (c *CaptchaRedis) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, captcha+id, answer, expire).Err())
+}
+
+func (c *CaptchaRedis) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	answer, err := c.rdb.Get(ctx, captcha+id).Result()
+	if err == redis.Nil {
+		return "", nil
+	} else if err != nil {
+		return "", errs.Wrap(err)
+	}
+	n, err := c.rdb.Del(ctx, captcha+id).Result()
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if n == 0 {
+		return "", nil
+	}
+	return answer, nil
+}
+
+func (c *CaptchaRedis) SetPass(ctx context.Context, token string, expire time.Duration) error {
+	return errs.Wrap(c.rdb.Set(ctx, captchaPass+token, time.Now().Unix(), expire).Err())
+}
+
+func (c *CaptchaRedis) TakePass(ctx context.Context, token string) (bool, error) {
+	n, err := c.rdb.Del(ctx, captchaPass+token).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
+
+func (c *CaptchaRedis) IncrRisk(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = captchaRisk + key
+	pipe := c.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
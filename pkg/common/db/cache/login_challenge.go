@@ -0,0 +1,90 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginChallenge       = "CHAT_LOGIN_CHALLENGE:"
+	adminLoginChallenge  = "CHAT_ADMIN_LOGIN_CHALLENGE:"
+	webAuthnChallenge    = "CHAT_WEBAUTHN_CHALLENGE:"
+	loginChallengeFailed = "FAILED:"
+)
+
+// LoginChallengeInterface 登录二次验证的临时凭证.
+type LoginChallengeInterface interface {
+	SetChallenge(ctx context.Context, token string, userID string, expire time.Duration) error
+	GetChallenge(ctx context.Context, token string) (string, error) // 不存在或过期时返回空
+	IncrChallengeFailed(ctx context.Context, token string) (int64, error)
+	DelChallenge(ctx context.Context, token string) (bool, error)
+}
+
+type LoginChallengeRedis struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+func NewLoginChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb, prefix: loginChallenge}
+}
+
+func NewAdminLoginChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb, prefix: adminLoginChallenge}
+}
+
+// NewWebAuthnChallengeInterface 通行密钥注册与登录的challenge.
+func NewWebAuthnChallengeInterface(rdb redis.UniversalClient) *LoginChallengeRedis {
+	return &LoginChallengeRedis{rdb: rdb, prefix: webAuthnChallenge}
+}
+
+func (l *LoginChallengeRedis) SetChallenge(ctx context.Context, token string, userID string, expire time.Duration) error {
+	return errs.Wrap(l.rdb.Set(ctx, l.prefix+token, userID, expire).Err())
+}
+
+func (l *LoginChallengeRedis) GetChallenge(ctx context.Context, token string) (string, error) {
+	userID, err := l.rdb.Get(ctx, l.prefix+token).Result()
+	if err == redis.Nil {
+		return "", nil
+	} else if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return userID, nil
+}
+
+func (l *LoginChallengeRedis) IncrChallengeFailed(ctx context.Context, token string) (int64, error) {
+	key := l.prefix + loginChallengeFailed + token
+	pipe := l.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
+
+// DelChallenge 删除凭证, 返回是否由本次调用删除, 用于保证凭证只能使用一次.
+func (l *LoginChallengeRedis) DelChallenge(ctx context.Context, token string) (bool, error) {
+	n, err := l.rdb.Del(ctx, l.prefix+token, l.prefix+loginChallengeFailed+token).Result()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return n > 0, nil
+}
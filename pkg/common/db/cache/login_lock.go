@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFailed    = "CHAT_LOGIN_FAILED:"
+	loginLock      = "CHAT_LOGIN_LOCK:"
+	loginLockCount = "CHAT_LOGIN_LOCK_COUNT:"
+)
+
+// LoginLockInterface 登录失败计数与锁定.
+type LoginLockInterface interface {
+	// IncrLoginFailed 增加失败次数, 计数在window内有效
+	IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Lock 锁定并清空失败次数
+	Lock(ctx context.Context, key string, expire time.Duration) error
+	// IncrLockCount 增加锁定次数, 用于逐次延长锁定时间, 计数在window内有效
+	IncrLockCount(ctx context.Context, key string, window time.Duration) (int64, error)
+	// LockTTL 剩余锁定时间, 未锁定返回0
+	LockTTL(ctx context.Context, key string) (time.Duration, error)
+	// Unlock 解除锁定并清空失败次数与锁定次数
+	Unlock(ctx context.Context, key string) error
+	// ClearFailed 只清空失败次数, 锁定次数按有效期自然过期
+	ClearFailed(ctx context.Context, key string) error
+}
+
+type LoginLockRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewLoginLockInterface(rdb redis.UniversalClient) *LoginLockRedis {
+	return &LoginLockRedis{rdb: rdb}
+}
+
+func (l *LoginLockRedis) IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = loginFailed + key
+	pipe := l.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
+
+func (l *LoginLockRedis) Lock(ctx context.Context, key string, expire time.Duration) error {
+	pipe := l.rdb.TxPipeline()
+	pipe.Set(ctx, loginLock+key, time.Now().Unix(), expire)
+	pipe.Del(ctx, loginFailed+key)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (l *LoginLockRedis) IncrLockCount(ctx context.Context, key string, window time.Duration) (int64, error) {
+	key = loginLockCount + key
+	pipe := l.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return incr.Val(), nil
+}
+
+func (l *LoginLockRedis) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := l.rdb.TTL(ctx, loginLock+key).Result()
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func (l *LoginLockRedis) Unlock(ctx context.Context, key string) error {
+	return errs.Wrap(l.rdb.Del(ctx, loginLock+key, loginFailed+key, loginLockCount+key).Err())
+}
+
+func (l *LoginLockRedis) ClearFailed(ctx context.Context, key string) error {
+	return errs.Wrap(l.rdb.Del(ctx, loginFailed+key).Err())
+}
@@ -0,0 +1,150 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const qrLogin = "CHAT_QR_LOGIN:"
+
+// transitQRLoginScript 状态为from且未被其他用户扫码时更新为to
+var transitQRLoginScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') ~= ARGV[1] then
+	return 0
+end
+local userID = redis.call('HGET', KEYS[1], 'userID')
+if userID and userID ~= '' and userID ~= ARGV[3] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[2], 'userID', ARGV[3])
+return 1
+`)
+
+// consumeQRLoginScript 状态为status时删除, 保证只能换取一次token
+var consumeQRLoginScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') ~= ARGV[1] then
+	return 0
+end
+redis.call('DEL', KEYS[1])
+return 1
+`)
+
+// issueQRLoginScript 状态为签发中时保存token, 凭证过期前可重复获取, 避免响应丢失后token无法找回
+var issueQRLoginScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'status') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'status', ARGV[2], 'chatToken', ARGV[3], 'refreshToken', ARGV[4])
+return 1
+`)
+
+// QRLoginTicket 扫码登录凭证, Platform/DeviceID/IP为待登录的桌面端信息.
+type QRLoginTicket struct {
+	Secret   string
+	Status   int32
+	UserID   string
+	Platform int32
+	DeviceID string
+	IP       string
+	// 签发后的token
+	ChatToken    string
+	RefreshToken string
+}
+
+type QRLoginInterface interface {
+	Create(ctx context.Context, ticket string, t *QRLoginTicket, expire time.Duration) error
+	// Get 不存在或过期时返回nil
+	Get(ctx context.Context, ticket string) (*QRLoginTicket, error)
+	Transit(ctx context.Context, ticket string, from int32, to int32, userID string) (bool, error)
+	Consume(ctx context.Context, ticket string, status int32) (bool, error)
+	// Issue 状态为from时更新为to并保存签发的token
+	Issue(ctx context.Context, ticket string, from int32, to int32, chatToken string, refreshToken string) (bool, error)
+}
+
+type QRLoginRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewQRLoginInterface(rdb redis.UniversalClient) *QRLoginRedis {
+	return &QRLoginRedis{rdb: rdb}
+}
+
+func (q *QRLoginRedis) Create(ctx context.Context, ticket string, t *QRLoginTicket, expire time.Duration) error {
+	pipe := q.rdb.TxPipeline()
+	pipe.HSet(ctx, qrLogin+ticket, map[string]any{
+		"secret":   t.Secret,
+		"status":   t.Status,
+		"userID":   t.UserID,
+		"platform": t.Platform,
+		"deviceID": t.DeviceID,
+		"ip":       t.IP,
+	})
+	pipe.Expire(ctx, qrLogin+ticket, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (q *QRLoginRedis) Get(ctx context.Context, ticket string) (*QRLoginTicket, error) {
+	m, err := q.rdb.HGetAll(ctx, qrLogin+ticket).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	status, _ := strconv.ParseInt(m["status"], 10, 32)
+	platform, _ := strconv.ParseInt(m["platform"], 10, 32)
+	return &QRLoginTicket{
+		Secret:   m["secret"],
+		Status:   int32(status),
+		UserID:   m["userID"],
+		Platform: int32(platform),
+		DeviceID: m["deviceID"],
+		IP:       m["ip"],
+
+		ChatToken:    m["chatToken"],
+		RefreshToken: m["refreshToken"],
+	}, nil
+}
+
+func (q *QRLoginRedis) Transit(ctx context.Context, ticket string, from int32, to int32, userID string) (bool, error) {
+	res, err := transitQRLoginScript.Run(ctx, q.rdb, []string{qrLogin + ticket}, from, to, userID).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
+
+func (q *QRLoginRedis) Consume(ctx context.Context, ticket string, status int32) (bool, error) {
+	res, err := consumeQRLoginScript.Run(ctx, q.rdb, []string{qrLogin + ticket}, status).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
+
+func (q *QRLoginRedis) Issue(ctx context.Context, ticket string, from int32, to int32, chatToken string, refreshToken string) (bool, error) {
+	res, err := issueQRLoginScript.Run(ctx, q.rdb, []string{qrLogin + ticket}, from, to, chatToken, refreshToken).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res == 1, nil
+}
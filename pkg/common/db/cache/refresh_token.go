@@ -0,0 +1,159 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	refreshFamily     = "CHAT_REFRESH_FAMILY:"
+	refreshUserFamily = "CHAT_REFRESH_USER:"
+)
+
+// rotateRefreshScript 仅当当前refresh token摘要匹配时才轮换, 否则视为重放
+var rotateRefreshScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'refresh') ~= ARGV[1] then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'refresh', ARGV[2], 'token', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return 1
+`)
+
+// RefreshFamily 同一次登录签发的refresh token链, 每次刷新只有最新的refresh token有效.
+type RefreshFamily struct {
+	UserID    string
+	UserType  int32
+	Refresh   string // 当前refresh token摘要
+	Token     string // 当前access token
+	Platform  int32
+	DeviceID  string
+	IP        string
+	LoginTime int64
+}
+
+type RefreshTokenInterface interface {
+	SetRefreshFamily(ctx context.Context, familyID string, family *RefreshFamily, expire time.Duration) error
+	// GetRefreshFamily 不存在返回nil
+	GetRefreshFamily(ctx context.Context, familyID string) (*RefreshFamily, error)
+	// RotateRefreshFamily oldRefresh不是当前refresh token时返回false
+	// 轮换成功后延长用户索引的过期时间, 否则长期会话会从索引中消失, 无法被退出所有设备等操作吊销
+	RotateRefreshFamily(ctx context.Context, userID string, familyID string, oldRefresh string, newRefresh string, token string, expire time.Duration) (bool, error)
+	GetRefreshFamilyIDs(ctx context.Context, userID string) ([]string, error)
+	DelRefreshFamilies(ctx context.Context, userID string, familyIDs []string) error
+}
+
+type RefreshTokenRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewRefreshTokenInterface(rdb redis.UniversalClient) *RefreshTokenRedis {
+	return &RefreshTokenRedis{rdb: rdb}
+}
+
+func (r *RefreshTokenRedis) SetRefreshFamily(ctx context.Context, familyID string, family *RefreshFamily, expire time.Duration) error {
+	key := refreshFamily + familyID
+	pipe := r.rdb.TxPipeline()
+	pipe.HSet(ctx, key, map[string]any{
+		"userID":    family.UserID,
+		"userType":  family.UserType,
+		"refresh":   family.Refresh,
+		"token":     family.Token,
+		"platform":  family.Platform,
+		"deviceID":  family.DeviceID,
+		"ip":        family.IP,
+		"loginTime": family.LoginTime,
+	})
+	pipe.Expire(ctx, key, expire)
+	pipe.SAdd(ctx, refreshUserFamily+family.UserID, familyID)
+	pipe.Expire(ctx, refreshUserFamily+family.UserID, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (r *RefreshTokenRedis) GetRefreshFamily(ctx context.Context, familyID string) (*RefreshFamily, error) {
+	m, err := r.rdb.HGetAll(ctx, refreshFamily+familyID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	userType, _ := strconv.ParseInt(m["userType"], 10, 32)
+	platform, _ := strconv.ParseInt(m["platform"], 10, 32)
+	loginTime, _ := strconv.ParseInt(m["loginTime"], 10, 64)
+	return &RefreshFamily{
+		UserID:    m["userID"],
+		UserType:  int32(userType),
+		Refresh:   m["refresh"],
+		Token:     m["token"],
+		Platform:  int32(platform),
+		DeviceID:  m["deviceID"],
+		IP:        m["ip"],
+		LoginTime: loginTime,
+	}, nil
+}
+
+func (r *RefreshTokenRedis) RotateRefreshFamily(ctx context.Context, userID string, familyID string, oldRefresh string, newRefresh string, token string, expire time.Duration) (bool, error) {
+	res, err := rotateRefreshScript.Run(ctx, r.rdb, []string{refreshFamily + familyID}, oldRefresh, newRefresh, token, expire.Milliseconds()).Int()
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	if res != 1 {
+		return false, nil
+	}
+	// 索引和链不在同一个slot, 集群模式下不能放在同一个脚本中.
+	// 所有链的有效期都是expire, 重置为expire不会早于其中任何一条链过期
+	pipe := r.rdb.TxPipeline()
+	pipe.SAdd(ctx, refreshUserFamily+userID, familyID)
+	pipe.Expire(ctx, refreshUserFamily+userID, expire)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return true, errs.Wrap(err)
+	}
+	return true, nil
+}
+
+func (r *RefreshTokenRedis) GetRefreshFamilyIDs(ctx context.Context, userID string) ([]string, error) {
+	familyIDs, err := r.rdb.SMembers(ctx, refreshUserFamily+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return familyIDs, nil
+}
+
+func (r *RefreshTokenRedis) DelRefreshFamilies(ctx context.Context, userID string, familyIDs []string) error {
+	if len(familyIDs) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(familyIDs))
+	members := make([]any, 0, len(familyIDs))
+	for _, familyID := range familyIDs {
+		keys = append(keys, refreshFamily+familyID)
+		members = append(members, familyID)
+	}
+	pipe := r.rdb.Pipeline()
+	for _, key := range keys {
+		pipe.Del(ctx, key)
+	}
+	pipe.SRem(ctx, refreshUserFamily+userID, members...)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
@@ -16,6 +16,8 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/utils"
@@ -23,12 +25,31 @@ import (
 )
 
 const (
-	chatToken = "CHAT_UID_TOKEN_STATUS:"
+	chatToken        = "CHAT_UID_TOKEN_STATUS:"
+	chatTokenSession = "CHAT_UID_TOKEN_SESSION:"
 )
 
+// TokenSession 签发token时的登录设备信息.
+type TokenSession struct {
+	Platform  int32  `json:"platform"`
+	DeviceID  string `json:"deviceID"`
+	IP        string `json:"ip"`
+	LoginTime int64  `json:"loginTime"`
+	LastSeen  int64  `json:"lastSeen"`
+	// RefreshFamily 对应的refresh token链, 未签发refresh token时为空
+	RefreshFamily string `json:"refreshFamily,omitempty"`
+}
+
 type TokenInterface interface {
 	AddTokenFlag(ctx context.Context, userID string, token string, flag int) error
+	SetTokensFlag(ctx context.Context, userID string, tokens []string, flag int) error
 	GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error)
+	DeleteTokens(ctx context.Context, userID string, tokens []string) error
+	ExpireTokens(ctx context.Context, userID string, expire time.Duration) error
+	SetTokenSession(ctx context.Context, userID string, token string, session *TokenSession) error
+	GetTokenSession(ctx context.Context, userID string, token string) (*TokenSession, error)
+	GetTokenSessions(ctx context.Context, userID string) (map[string]*TokenSession, error)
+	DeleteTokenSessions(ctx context.Context, userID string, tokens []string) error
 }
 
 type TokenCacheRedis struct {
@@ -56,3 +77,78 @@ func (t *TokenCacheRedis) GetTokensWithoutError(ctx context.Context, userID stri
 	}
 	return mm, nil
 }
+
+func (t *TokenCacheRedis) SetTokensFlag(ctx context.Context, userID string, tokens []string, flag int) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	values := make([]any, 0, len(tokens)*2)
+	for _, token := range tokens {
+		values = append(values, token, flag)
+	}
+	return errs.Wrap(t.rdb.HSet(ctx, chatToken+userID, values...).Err())
+}
+
+func (t *TokenCacheRedis) DeleteTokens(ctx context.Context, userID string, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.HDel(ctx, chatToken+userID, tokens...)
+	pipe.HDel(ctx, chatTokenSession+userID, tokens...)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) ExpireTokens(ctx context.Context, userID string, expire time.Duration) error {
+	pipe := t.rdb.TxPipeline()
+	pipe.Expire(ctx, chatToken+userID, expire)
+	pipe.Expire(ctx, chatTokenSession+userID, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) SetTokenSession(ctx context.Context, userID string, token string, session *TokenSession) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(t.rdb.HSet(ctx, chatTokenSession+userID, token, string(data)).Err())
+}
+
+func (t *TokenCacheRedis) GetTokenSession(ctx context.Context, userID string, token string) (*TokenSession, error) {
+	data, err := t.rdb.HGet(ctx, chatTokenSession+userID, token).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var session TokenSession
+	if err := json.Unmarshal([]byte(data), &session); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &session, nil
+}
+
+func (t *TokenCacheRedis) GetTokenSessions(ctx context.Context, userID string) (map[string]*TokenSession, error) {
+	m, err := t.rdb.HGetAll(ctx, chatTokenSession+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessions := make(map[string]*TokenSession, len(m))
+	for token, data := range m {
+		var session TokenSession
+		if err := json.Unmarshal([]byte(data), &session); err != nil {
+			continue
+		}
+		sessions[token] = &session
+	}
+	return sessions, nil
+}
+
+func (t *TokenCacheRedis) DeleteTokenSessions(ctx context.Context, userID string, tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	return errs.Wrap(t.rdb.HDel(ctx, chatTokenSession+userID, tokens...).Err())
+}
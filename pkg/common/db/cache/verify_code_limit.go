@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+// 使用hash tag保证同一次校验的所有key在集群模式下位于同一slot
+const verifyCodeLimit = "{CHAT_VERIFY_CODE_LIMIT}:"
+
+// takeVerifyCodeLimitScript 滑动窗口限流, 所有key均未超限时才同时计入, 否则返回最长需要等待的毫秒数及对应key的序号
+var takeVerifyCodeLimitScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local wait = 0
+local hit = 0
+for i, key in ipairs(KEYS) do
+	local window = tonumber(ARGV[2 * i + 1])
+	local max = tonumber(ARGV[2 * i + 2])
+	redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+	local count = redis.call('ZCARD', key)
+	if count >= max then
+		local oldest = redis.call('ZRANGE', key, count - max, count - max, 'WITHSCORES')
+		local w = tonumber(oldest[2]) + window - now
+		if w <= 0 then
+			w = 1
+		end
+		if w > wait then
+			wait = w
+			hit = i
+		end
+	end
+end
+if wait > 0 then
+	return {wait, hit}
+end
+for i, key in ipairs(KEYS) do
+	redis.call('ZADD', key, now, ARGV[2])
+	redis.call('PEXPIRE', key, ARGV[2 * i + 1])
+end
+return {0, 0}
+`)
+
+// RateLimitRule Key在Window内最多允许Max次.
+type RateLimitRule struct {
+	Key    string
+	Window time.Duration
+	Max    int
+}
+
+// VerifyCodeLimitInterface 发送验证码的滑动窗口限流.
+type VerifyCodeLimitInterface interface {
+	// Take 所有规则均未超限时各计入一次并返回0, 否则不计入并返回需要等待的时长及触发的规则
+	Take(ctx context.Context, rules []RateLimitRule) (time.Duration, *RateLimitRule, error)
+}
+
+type VerifyCodeLimitRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewVerifyCodeLimitInterface(rdb redis.UniversalClient) *VerifyCodeLimitRedis {
+	return &VerifyCodeLimitRedis{rdb: rdb}
+}
+
+func (v *VerifyCodeLimitRedis) Take(ctx context.Context, rules []RateLimitRule) (time.Duration, *RateLimitRule, error) {
+	if len(rules) == 0 {
+		return 0, nil, nil
+	}
+	data := make([]byte, 8)
+	if _, err := rand.Read(data); err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	now := time.Now().UnixMilli()
+	keys := make([]string, 0, len(rules))
+	args := make([]any, 0, 2+len(rules)*2)
+	args = append(args, now, hex.EncodeToString(data))
+	for _, rule := range rules {
+		keys = append(keys, verifyCodeLimit+rule.Key)
+		args = append(args, rule.Window.Milliseconds(), rule.Max)
+	}
+	res, err := takeVerifyCodeLimitScript.Run(ctx, v.rdb, keys, args...).Int64Slice()
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	if len(res) != 2 || res[0] == 0 {
+		return 0, nil, nil
+	}
+	if res[1] < 1 || int(res[1]) > len(rules) {
+		return 0, nil, errs.ErrInternalServer.Wrap("invalid verify code limit result")
+	}
+	return time.Duration(res[0]) * time.Millisecond, &rules[res[1]-1], nil
+}
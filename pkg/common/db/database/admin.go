@@ -16,6 +16,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	"github.com/OpenIMSDK/protocol/constant"
@@ -24,6 +25,7 @@ import (
 	"github.com/OpenIMSDK/tools/tx"
 	"gorm.io/gorm"
 
+	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/model/admin"
 	table "github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 )
@@ -33,6 +35,21 @@ type AdminDatabaseInterface interface {
 	GetAdmin(ctx context.Context, account string) (*table.Admin, error)
 	GetAdminUserID(ctx context.Context, userID string) (*table.Admin, error)
 	UpdateAdmin(ctx context.Context, userID string, update map[string]any) error
+	SearchAdmin(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.Admin, error)
+	CreateAdmin(ctx context.Context, admins ...*table.Admin) error
+	// DelAdmin 删除管理员及其二次验证
+	DelAdmin(ctx context.Context, userID string) error
+	AddAuditLog(ctx context.Context, logs ...*table.AuditLog) error
+	AddAdminIPAllow(ctx context.Context, ms []*table.AdminIPAllow) error
+	DelAdminIPAllow(ctx context.Context, cidrs []string) error
+	FindAdminIPAllow(ctx context.Context) ([]*table.AdminIPAllow, error)
+	// FindAdminIPAllowCIDR 优先从缓存获取白名单网段
+	FindAdminIPAllowCIDR(ctx context.Context) ([]string, error)
+	SearchAdminIPAllow(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.AdminIPAllow, error)
+	AddAdminLoginRecord(ctx context.Context, record *table.AdminLoginRecord) error
+	// CountAdminLogin 返回登录记录总数, 及其中相同ip和相同国家的记录数
+	CountAdminLogin(ctx context.Context, userID string, ip string, country string) (int64, int64, int64, error)
+	SearchAuditLog(ctx context.Context, opUserID string, action string, keyword string, start time.Time, end time.Time, beforeID uint64, page int32, size int32) (uint32, []*table.AuditLog, error)
 	CreateApplet(ctx context.Context, applets ...*table.Applet) error
 	DelApplet(ctx context.Context, appletIDs []string) error
 	GetApplet(ctx context.Context, appletID string) (*table.Applet, error)
@@ -63,7 +80,12 @@ type AdminDatabaseInterface interface {
 	FindBlockInfo(ctx context.Context, userIDs []string) ([]*table.ForbiddenAccount, error)
 	GetBlockInfo(ctx context.Context, userID string) (*table.ForbiddenAccount, error)
 	BlockUser(ctx context.Context, f []*table.ForbiddenAccount) error
-	DelBlockUser(ctx context.Context, userID []string) error
+	// DelBlockUser 解封并写入封号历史
+	DelBlockUser(ctx context.Context, userID []string, opUserID string) error
+	FindExpiredBlockUser(ctx context.Context, before time.Time, limit int) ([]*table.ForbiddenAccount, error)
+	// ExpireBlockUser 移除已到期的封禁并写入封号历史, 已被其他实例移除时返回false
+	ExpireBlockUser(ctx context.Context, f *table.ForbiddenAccount, now time.Time) (bool, error)
+	SearchBlockHistory(ctx context.Context, userID string, page int32, size int32) (uint32, []*table.ForbiddenAccountHistory, error)
 	SearchBlockUser(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.ForbiddenAccount, error)
 	FindBlockUser(ctx context.Context, userIDs []string) ([]*table.ForbiddenAccount, error)
 	SearchUserLimitLogin(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.LimitUserLoginIP, error)
@@ -73,7 +95,35 @@ type AdminDatabaseInterface interface {
 	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*table.LimitUserLoginIP, error)
 	CacheToken(ctx context.Context, userID string, token string) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
-}
+	KickTokens(ctx context.Context, userID string, tokens []string) error
+	DeleteTokens(ctx context.Context, userID string, tokens []string) error
+	ExpireTokens(ctx context.Context, userID string, expire time.Duration) error
+	SetTokenSession(ctx context.Context, userID string, token string, session *cache.TokenSession) error
+	GetTokenSession(ctx context.Context, userID string, token string) (*cache.TokenSession, error)
+	GetTokenSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error)
+	SetRefreshFamily(ctx context.Context, familyID string, family *cache.RefreshFamily, expire time.Duration) error
+	GetRefreshFamily(ctx context.Context, familyID string) (*cache.RefreshFamily, error)
+	RotateRefreshFamily(ctx context.Context, userID string, familyID string, oldRefresh string, newRefresh string, token string, expire time.Duration) (bool, error)
+	GetRefreshFamilyIDs(ctx context.Context, userID string) ([]string, error)
+	DelRefreshFamilies(ctx context.Context, userID string, familyIDs []string) error
+	TakeTOTP(ctx context.Context, userID string) (*table.AdminTOTP, error)
+	SaveTOTP(ctx context.Context, totp *table.AdminTOTP) error
+	UpdateTOTP(ctx context.Context, userID string, data map[string]any) error
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseTOTPRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error)
+	DelTOTP(ctx context.Context, userID string) error
+	SetLoginChallenge(ctx context.Context, token string, userID string, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, token string) (string, error)
+	IncrLoginChallengeFailed(ctx context.Context, token string) (int64, error)
+	DelLoginChallenge(ctx context.Context, token string) (bool, error)
+	IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error)
+	LockLogin(ctx context.Context, key string, expire time.Duration) error
+	LoginLockTTL(ctx context.Context, key string) (time.Duration, error)
+	UnlockLogin(ctx context.Context, key string) error
+}
+
+// adminIPAllowExpire 白名单缓存的兜底过期时间, 修改白名单时会直接删除缓存
+const adminIPAllowExpire = time.Hour
 
 func NewAdminDatabase(db *gorm.DB, rdb redis.UniversalClient) AdminDatabaseInterface {
 	return &AdminDatabase{
@@ -81,6 +131,7 @@ func NewAdminDatabase(db *gorm.DB, rdb redis.UniversalClient) AdminDatabaseInter
 		admin:              admin.NewAdmin(db),
 		ipForbidden:        admin.NewIPForbidden(db),
 		forbiddenAccount:   admin.NewForbiddenAccount(db),
+		forbiddenHistory:   admin.NewForbiddenAccountHistory(db),
 		limitUserLoginIP:   admin.NewLimitUserLoginIP(db),
 		invitationRegister: admin.NewInvitationRegister(db),
 		registerAddFriend:  admin.NewRegisterAddFriend(db),
@@ -88,6 +139,14 @@ func NewAdminDatabase(db *gorm.DB, rdb redis.UniversalClient) AdminDatabaseInter
 		applet:             admin.NewApplet(db),
 		clientConfig:       admin.NewClientConfig(db),
 		cache:              cache.NewTokenInterface(rdb),
+		totp:               admin.NewAdminTOTP(db),
+		auditLog:           admin.NewAuditLog(db),
+		ipAllow:            admin.NewAdminIPAllow(db),
+		ipAllowCache:       cache.NewAdminIPAllowInterface(rdb),
+		loginRecord:        admin.NewAdminLoginRecord(db),
+		challenge:          cache.NewAdminLoginChallengeInterface(rdb),
+		loginLock:          cache.NewLoginLockInterface(rdb),
+		refresh:            cache.NewRefreshTokenInterface(rdb),
 	}
 }
 
@@ -96,6 +155,7 @@ type AdminDatabase struct {
 	admin              table.AdminInterface
 	ipForbidden        table.IPForbiddenInterface
 	forbiddenAccount   table.ForbiddenAccountInterface
+	forbiddenHistory   table.ForbiddenAccountHistoryInterface
 	limitUserLoginIP   table.LimitUserLoginIPInterface
 	invitationRegister table.InvitationRegisterInterface
 	registerAddFriend  table.RegisterAddFriendInterface
@@ -103,6 +163,14 @@ type AdminDatabase struct {
 	applet             table.AppletInterface
 	clientConfig       table.ClientConfigInterface
 	cache              cache.TokenInterface
+	totp               table.AdminTOTPInterface
+	auditLog           table.AuditLogInterface
+	ipAllow            table.AdminIPAllowInterface
+	ipAllowCache       cache.AdminIPAllowInterface
+	loginRecord        table.AdminLoginRecordInterface
+	challenge          cache.LoginChallengeInterface
+	loginLock          cache.LoginLockInterface
+	refresh            cache.RefreshTokenInterface
 }
 
 func (o *AdminDatabase) InitAdmin(ctx context.Context) error {
@@ -121,6 +189,96 @@ func (o *AdminDatabase) UpdateAdmin(ctx context.Context, userID string, update m
 	return o.admin.Update(ctx, userID, update)
 }
 
+func (o *AdminDatabase) SearchAdmin(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.Admin, error) {
+	return o.admin.Search(ctx, keyword, page, size)
+}
+
+func (o *AdminDatabase) CreateAdmin(ctx context.Context, admins ...*table.Admin) error {
+	return o.admin.Create(ctx, admins...)
+}
+
+func (o *AdminDatabase) DelAdmin(ctx context.Context, userID string) error {
+	if err := o.admin.Delete(ctx, userID); err != nil {
+		return err
+	}
+	return o.totp.Delete(ctx, userID)
+}
+
+func (o *AdminDatabase) AddAuditLog(ctx context.Context, logs ...*table.AuditLog) error {
+	return o.auditLog.Create(ctx, logs...)
+}
+
+func (o *AdminDatabase) SearchAuditLog(ctx context.Context, opUserID string, action string, keyword string, start time.Time, end time.Time, beforeID uint64, page int32, size int32) (uint32, []*table.AuditLog, error) {
+	return o.auditLog.Search(ctx, opUserID, action, keyword, start, end, beforeID, page, size)
+}
+
+func (o *AdminDatabase) AddAdminIPAllow(ctx context.Context, ms []*table.AdminIPAllow) error {
+	if err := o.ipAllow.Create(ctx, ms); err != nil {
+		return err
+	}
+	return o.ipAllowCache.DelCIDRs(ctx)
+}
+
+func (o *AdminDatabase) DelAdminIPAllow(ctx context.Context, cidrs []string) error {
+	if err := o.ipAllow.Delete(ctx, cidrs); err != nil {
+		return err
+	}
+	return o.ipAllowCache.DelCIDRs(ctx)
+}
+
+func (o *AdminDatabase) FindAdminIPAllow(ctx context.Context) ([]*table.AdminIPAllow, error) {
+	return o.ipAllow.FindAll(ctx)
+}
+
+func (o *AdminDatabase) FindAdminIPAllowCIDR(ctx context.Context) ([]string, error) {
+	cidrs, err := o.ipAllowCache.GetCIDRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cidrs != nil {
+		return cidrs, nil
+	}
+	allows, err := o.ipAllow.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cidrs = make([]string, 0, len(allows))
+	for _, allow := range allows {
+		cidrs = append(cidrs, allow.CIDR)
+	}
+	if err := o.ipAllowCache.SetCIDRs(ctx, cidrs, adminIPAllowExpire); err != nil {
+		return nil, err
+	}
+	return cidrs, nil
+}
+
+func (o *AdminDatabase) SearchAdminIPAllow(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.AdminIPAllow, error) {
+	return o.ipAllow.Search(ctx, keyword, page, size)
+}
+
+func (o *AdminDatabase) AddAdminLoginRecord(ctx context.Context, record *table.AdminLoginRecord) error {
+	return o.loginRecord.Create(ctx, record)
+}
+
+func (o *AdminDatabase) CountAdminLogin(ctx context.Context, userID string, ip string, country string) (int64, int64, int64, error) {
+	total, err := o.loginRecord.Count(ctx, userID)
+	if err != nil || total == 0 {
+		return 0, 0, 0, err
+	}
+	ipCount, err := o.loginRecord.CountIP(ctx, userID, ip)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	var countryCount int64
+	if country != "" {
+		countryCount, err = o.loginRecord.CountCountry(ctx, userID, country)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+	}
+	return total, ipCount, countryCount, nil
+}
+
 func (o *AdminDatabase) CreateApplet(ctx context.Context, applets ...*table.Applet) error {
 	return o.applet.Create(ctx, applets...)
 }
@@ -241,8 +399,61 @@ func (o *AdminDatabase) BlockUser(ctx context.Context, f []*table.ForbiddenAccou
 	return o.forbiddenAccount.Create(ctx, f)
 }
 
-func (o *AdminDatabase) DelBlockUser(ctx context.Context, userID []string) error {
-	return o.forbiddenAccount.Delete(ctx, userID)
+func (o *AdminDatabase) DelBlockUser(ctx context.Context, userID []string, opUserID string) error {
+	return o.tx.Transaction(func(tx any) error {
+		fs, err := o.forbiddenAccount.NewTx(tx).Find(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := o.forbiddenAccount.NewTx(tx).Delete(ctx, userID); err != nil {
+			return err
+		}
+		if len(fs) == 0 {
+			return nil
+		}
+		now := time.Now()
+		histories := make([]*table.ForbiddenAccountHistory, 0, len(fs))
+		for _, f := range fs {
+			histories = append(histories, toForbiddenHistory(f, constant2.UnblockTypeManual, opUserID, now))
+		}
+		return o.forbiddenHistory.NewTx(tx).Create(ctx, histories)
+	})
+}
+
+func (o *AdminDatabase) FindExpiredBlockUser(ctx context.Context, before time.Time, limit int) ([]*table.ForbiddenAccount, error) {
+	return o.forbiddenAccount.FindExpired(ctx, before, limit)
+}
+
+func (o *AdminDatabase) ExpireBlockUser(ctx context.Context, f *table.ForbiddenAccount, now time.Time) (bool, error) {
+	var deleted bool
+	err := o.tx.Transaction(func(tx any) error {
+		var err error
+		deleted, err = o.forbiddenAccount.NewTx(tx).DeleteExpired(ctx, f.UserID, now)
+		if err != nil || !deleted {
+			return err
+		}
+		history := toForbiddenHistory(f, constant2.UnblockTypeExpired, "", now)
+		return o.forbiddenHistory.NewTx(tx).Create(ctx, []*table.ForbiddenAccountHistory{history})
+	})
+	return deleted, err
+}
+
+func (o *AdminDatabase) SearchBlockHistory(ctx context.Context, userID string, page int32, size int32) (uint32, []*table.ForbiddenAccountHistory, error) {
+	return o.forbiddenHistory.Search(ctx, userID, page, size)
+}
+
+func toForbiddenHistory(f *table.ForbiddenAccount, unblockType int32, opUserID string, now time.Time) *table.ForbiddenAccountHistory {
+	return &table.ForbiddenAccountHistory{
+		UserID:          f.UserID,
+		Reason:          f.Reason,
+		Category:        f.Category,
+		OperatorUserID:  f.OperatorUserID,
+		CreateTime:      f.CreateTime,
+		ExpireTime:      f.ExpireTime,
+		UnblockType:     unblockType,
+		UnblockOpUserID: opUserID,
+		UnblockTime:     now,
+	}
 }
 
 func (o *AdminDatabase) SearchBlockUser(ctx context.Context, keyword string, page int32, size int32) (uint32, []*table.ForbiddenAccount, error) {
@@ -280,3 +491,106 @@ func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token str
 func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[string]int32, error) {
 	return o.cache.GetTokensWithoutError(ctx, userID)
 }
+
+func (o *AdminDatabase) KickTokens(ctx context.Context, userID string, tokens []string) error {
+	if err := o.cache.SetTokensFlag(ctx, userID, tokens, constant.KickedToken); err != nil {
+		return err
+	}
+	return o.cache.DeleteTokenSessions(ctx, userID, tokens)
+}
+
+func (o *AdminDatabase) DeleteTokens(ctx context.Context, userID string, tokens []string) error {
+	return o.cache.DeleteTokens(ctx, userID, tokens)
+}
+
+func (o *AdminDatabase) ExpireTokens(ctx context.Context, userID string, expire time.Duration) error {
+	return o.cache.ExpireTokens(ctx, userID, expire)
+}
+
+func (o *AdminDatabase) SetTokenSession(ctx context.Context, userID string, token string, session *cache.TokenSession) error {
+	return o.cache.SetTokenSession(ctx, userID, token, session)
+}
+
+func (o *AdminDatabase) GetTokenSession(ctx context.Context, userID string, token string) (*cache.TokenSession, error) {
+	return o.cache.GetTokenSession(ctx, userID, token)
+}
+
+func (o *AdminDatabase) GetTokenSessions(ctx context.Context, userID string) (map[string]*cache.TokenSession, error) {
+	return o.cache.GetTokenSessions(ctx, userID)
+}
+
+func (o *AdminDatabase) TakeTOTP(ctx context.Context, userID string) (*table.AdminTOTP, error) {
+	return o.totp.Take(ctx, userID)
+}
+
+func (o *AdminDatabase) SaveTOTP(ctx context.Context, totp *table.AdminTOTP) error {
+	return o.totp.Save(ctx, totp)
+}
+
+func (o *AdminDatabase) UpdateTOTP(ctx context.Context, userID string, data map[string]any) error {
+	return o.totp.Update(ctx, userID, data)
+}
+
+func (o *AdminDatabase) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.totp.UseStep(ctx, userID, step)
+}
+
+func (o *AdminDatabase) UseTOTPRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error) {
+	return o.totp.UseRecoveryCodes(ctx, userID, old, new)
+}
+
+func (o *AdminDatabase) DelTOTP(ctx context.Context, userID string) error {
+	return o.totp.Delete(ctx, userID)
+}
+
+func (o *AdminDatabase) SetLoginChallenge(ctx context.Context, token string, userID string, expire time.Duration) error {
+	return o.challenge.SetChallenge(ctx, token, userID, expire)
+}
+
+func (o *AdminDatabase) GetLoginChallenge(ctx context.Context, token string) (string, error) {
+	return o.challenge.GetChallenge(ctx, token)
+}
+
+func (o *AdminDatabase) IncrLoginChallengeFailed(ctx context.Context, token string) (int64, error) {
+	return o.challenge.IncrChallengeFailed(ctx, token)
+}
+
+func (o *AdminDatabase) DelLoginChallenge(ctx context.Context, token string) (bool, error) {
+	return o.challenge.DelChallenge(ctx, token)
+}
+
+func (o *AdminDatabase) IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.loginLock.IncrLoginFailed(ctx, key, window)
+}
+
+func (o *AdminDatabase) LockLogin(ctx context.Context, key string, expire time.Duration) error {
+	return o.loginLock.Lock(ctx, key, expire)
+}
+
+func (o *AdminDatabase) LoginLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	return o.loginLock.LockTTL(ctx, key)
+}
+
+func (o *AdminDatabase) UnlockLogin(ctx context.Context, key string) error {
+	return o.loginLock.Unlock(ctx, key)
+}
+
+func (o *AdminDatabase) SetRefreshFamily(ctx context.Context, familyID string, family *cache.RefreshFamily, expire time.Duration) error {
+	return o.refresh.SetRefreshFamily(ctx, familyID, family, expire)
+}
+
+func (o *AdminDatabase) GetRefreshFamily(ctx context.Context, familyID string) (*cache.RefreshFamily, error) {
+	return o.refresh.GetRefreshFamily(ctx, familyID)
+}
+
+func (o *AdminDatabase) RotateRefreshFamily(ctx context.Context, userID string, familyID string, oldRefresh string, newRefresh string, token string, expire time.Duration) (bool, error) {
+	return o.refresh.RotateRefreshFamily(ctx, userID, familyID, oldRefresh, newRefresh, token, expire)
+}
+
+func (o *AdminDatabase) GetRefreshFamilyIDs(ctx context.Context, userID string) ([]string, error) {
+	return o.refresh.GetRefreshFamilyIDs(ctx, userID)
+}
+
+func (o *AdminDatabase) DelRefreshFamilies(ctx context.Context, userID string, familyIDs []string) error {
+	return o.refresh.DelRefreshFamilies(ctx, userID, familyIDs)
+}
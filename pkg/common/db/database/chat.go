@@ -19,12 +19,14 @@ import (
 	"time"
 
 	constant2 "github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/db/cache"
 	admin2 "github.com/OpenIMSDK/chat/pkg/common/db/model/admin"
 	"github.com/OpenIMSDK/chat/pkg/common/db/model/chat"
 	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
 	table "github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/tx"
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
@@ -36,22 +38,34 @@ type ChatDatabaseInterface interface {
 	FindAttributeByAccount(ctx context.Context, accounts []string) ([]*table.Attribute, error)
 	TakeAttributeByPhone(ctx context.Context, areaCode string, phoneNumber string) (*table.Attribute, error)
 	TakeAttributeByAccount(ctx context.Context, account string) (*table.Attribute, error)
+	TakeAttributeByEmail(ctx context.Context, email string) (*table.Attribute, error)
 	TakeAttributeByUserID(ctx context.Context, userID string) (*table.Attribute, error)
 	Search(ctx context.Context, normalUser int32, keyword string, gender int32, pageNumber int32, showNumber int32) (uint32, []*table.Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pageNumber int32, showNumber int32) (uint32, []*table.Attribute, error)
 	CountVerifyCodeRange(ctx context.Context, account string, start time.Time, end time.Time) (uint32, error)
-	AddVerifyCode(ctx context.Context, verifyCode *table.VerifyCode, fn func() error) error
+	// AddVerifyCode fn发送验证码并返回实际发送的服务及消息ID
+	AddVerifyCode(ctx context.Context, verifyCode *table.VerifyCode, fn func() (string, string, error)) error
+	UpdateVerifyCodeStatus(ctx context.Context, provider string, messageID string, status int32, desc string, statusTime time.Time) error
+	SearchVerifyCode(ctx context.Context, keyword string, status []int32, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*table.VerifyCode, error)
 	UpdateVerifyCodeIncrCount(ctx context.Context, id uint) error
 	TakeLastVerifyCode(ctx context.Context, account string) (*table.VerifyCode, error)
 	DelVerifyCode(ctx context.Context, id uint) error
 	RegisterUser(ctx context.Context, register *table.Register, account *table.Account, attribute *table.Attribute) error
 	GetAccount(ctx context.Context, userID string) (*table.Account, error)
+	TakeRegister(ctx context.Context, userID string) (*table.Register, error)
 	GetAttribute(ctx context.Context, userID string) (*table.Attribute, error)
 	GetAttributeByAccount(ctx context.Context, account string) (*table.Attribute, error)
 	GetAttributeByPhone(ctx context.Context, areaCode string, phoneNumber string) (*table.Attribute, error)
+	GetAttributeByEmail(ctx context.Context, email string) (*table.Attribute, error)
 	LoginRecord(ctx context.Context, record *table.UserLoginRecord, verifyCodeID *uint) error
 	UpdatePassword(ctx context.Context, userID string, password string) error
 	UpdatePasswordAndDeleteVerifyCode(ctx context.Context, userID string, password string, code uint) error
+	FindPasswordHistory(ctx context.Context, userID string, limit int) ([]*table.PasswordHistory, error)
+	// ChangePassword 修改密码, 保留被替换的密码及最近keep条历史密码, code不为空时删除已使用的验证码
+	ChangePassword(ctx context.Context, userID string, oldPassword string, password string, keep int, code *uint) error
+	UpdateContactAndDeleteVerifyCode(ctx context.Context, userID string, attribute map[string]any, codes []uint) error
+	// UpdateLoginIdentifier 绑定或解绑登录标识, password不为空时同时设置密码
+	UpdateLoginIdentifier(ctx context.Context, userID string, attribute map[string]any, password string, accountType string, codes []uint) error
 	NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountTotal(ctx context.Context, before *time.Time) (int64, error)
 	UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
@@ -59,9 +73,50 @@ type ChatDatabaseInterface interface {
 	DeleteLogs(ctx context.Context, logID []string, userID string) error
 	SearchLogs(ctx context.Context, keyword string, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*table.Log, error)
 	GetLogs(ctx context.Context, LogIDs []string, userID string) ([]*table.Log, error)
-}
-
-func NewChatDatabase(db *gorm.DB) ChatDatabaseInterface {
+	TakeTOTP(ctx context.Context, userID string) (*table.TOTP, error)
+	SaveTOTP(ctx context.Context, totp *table.TOTP) error
+	UpdateTOTP(ctx context.Context, userID string, data map[string]any) error
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseTOTPRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error)
+	DelTOTP(ctx context.Context, userID string) error
+	SetLoginChallenge(ctx context.Context, token string, userID string, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, token string) (string, error)
+	IncrLoginChallengeFailed(ctx context.Context, token string) (int64, error)
+	DelLoginChallenge(ctx context.Context, token string) (bool, error)
+	IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error)
+	IncrLoginLockCount(ctx context.Context, key string, window time.Duration) (int64, error)
+	LockLogin(ctx context.Context, key string, expire time.Duration) error
+	LoginLockTTL(ctx context.Context, key string) (time.Duration, error)
+	UnlockLogin(ctx context.Context, key string) error
+	ClearLoginFailed(ctx context.Context, key string) error
+	CreateWebAuthnCredential(ctx context.Context, credential *table.WebAuthnCredential) error
+	TakeWebAuthnCredential(ctx context.Context, credentialID string) (*table.WebAuthnCredential, error)
+	FindWebAuthnCredential(ctx context.Context, userID string) ([]*table.WebAuthnCredential, error)
+	UseWebAuthnSignCount(ctx context.Context, credentialID string, old uint32, new uint32, lastUsed time.Time) (bool, error)
+	DelWebAuthnCredential(ctx context.Context, userID string, credentialID string) (bool, error)
+	SetWebAuthnChallenge(ctx context.Context, challenge string, userID string, expire time.Duration) error
+	TakeWebAuthnChallenge(ctx context.Context, challenge string) (string, error)
+	CreateQRLogin(ctx context.Context, ticket string, t *cache.QRLoginTicket, expire time.Duration) error
+	GetQRLogin(ctx context.Context, ticket string) (*cache.QRLoginTicket, error)
+	TransitQRLogin(ctx context.Context, ticket string, from int32, to int32, userID string) (bool, error)
+	ConsumeQRLogin(ctx context.Context, ticket string, status int32) (bool, error)
+	IssueQRLogin(ctx context.Context, ticket string, from int32, to int32, chatToken string, refreshToken string) (bool, error)
+	SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error
+	TakeCaptcha(ctx context.Context, id string) (string, error)
+	SetCaptchaPass(ctx context.Context, token string, expire time.Duration) error
+	TakeCaptchaPass(ctx context.Context, token string) (bool, error)
+	IncrCaptchaRisk(ctx context.Context, key string, window time.Duration) (int64, error)
+	TakeVerifyCodeLimit(ctx context.Context, rules []cache.RateLimitRule) (time.Duration, *cache.RateLimitRule, error)
+	ExistLoginDevice(ctx context.Context, userID string, deviceID string) (bool, error)
+	CreateAccountDeletion(ctx context.Context, deletion *table.AccountDeletion) error
+	TakeAccountDeletion(ctx context.Context, userID string) (*table.AccountDeletion, error)
+	DelAccountDeletion(ctx context.Context, userID string) (bool, error)
+	FindDueAccountDeletion(ctx context.Context, before time.Time, limit int) ([]*table.AccountDeletion, error)
+	// DeleteAccount 清除用户账号及登录相关数据, 注销申请已被撤销时返回false
+	DeleteAccount(ctx context.Context, userID string, attribute map[string]any, register map[string]any) (bool, error)
+}
+
+func NewChatDatabase(db *gorm.DB, rdb redis.UniversalClient) ChatDatabaseInterface {
 	return &ChatDatabase{
 		tx:               tx.NewGorm(db),
 		register:         chat.NewRegister(db),
@@ -71,6 +126,16 @@ func NewChatDatabase(db *gorm.DB) ChatDatabaseInterface {
 		verifyCode:       chat.NewVerifyCode(db),
 		forbiddenAccount: admin2.NewForbiddenAccount(db),
 		log:              chat.NewLogs(db),
+		totp:             chat.NewTOTP(db),
+		challenge:        cache.NewLoginChallengeInterface(rdb),
+		loginLock:        cache.NewLoginLockInterface(rdb),
+		webAuthn:         chat.NewWebAuthnCredential(db),
+		webAuthnChal:     cache.NewWebAuthnChallengeInterface(rdb),
+		qrLogin:          cache.NewQRLoginInterface(rdb),
+		accountDeletion:  chat.NewAccountDeletion(db),
+		passwordHistory:  chat.NewPasswordHistory(db),
+		captcha:          cache.NewCaptchaInterface(rdb),
+		verifyCodeLimit:  cache.NewVerifyCodeLimitInterface(rdb),
 	}
 }
 
@@ -83,6 +148,16 @@ type ChatDatabase struct {
 	verifyCode       table.VerifyCodeInterface
 	forbiddenAccount admin.ForbiddenAccountInterface
 	log              table.LogInterface
+	totp             table.TOTPInterface
+	challenge        cache.LoginChallengeInterface
+	loginLock        cache.LoginLockInterface
+	webAuthn         table.WebAuthnCredentialInterface
+	webAuthnChal     cache.LoginChallengeInterface
+	qrLogin          cache.QRLoginInterface
+	accountDeletion  table.AccountDeletionInterface
+	passwordHistory  table.PasswordHistoryInterface
+	captcha          cache.CaptchaInterface
+	verifyCodeLimit  cache.VerifyCodeLimitInterface
 }
 
 func (o *ChatDatabase) GetLogs(ctx context.Context, LogIDs []string, userID string) ([]*table.Log, error) {
@@ -136,6 +211,10 @@ func (o *ChatDatabase) TakeAttributeByAccount(ctx context.Context, account strin
 	return o.attribute.TakeAccount(ctx, account)
 }
 
+func (o *ChatDatabase) TakeAttributeByEmail(ctx context.Context, email string) (*table.Attribute, error) {
+	return o.attribute.TakeEmail(ctx, email)
+}
+
 func (o *ChatDatabase) TakeAttributeByUserID(ctx context.Context, userID string) (*table.Attribute, error) {
 	return o.attribute.Take(ctx, userID)
 }
@@ -163,18 +242,39 @@ func (o *ChatDatabase) CountVerifyCodeRange(ctx context.Context, account string,
 	return o.verifyCode.RangeNum(ctx, account, start, end)
 }
 
-func (o *ChatDatabase) AddVerifyCode(ctx context.Context, verifyCode *table.VerifyCode, fn func() error) error {
+func (o *ChatDatabase) AddVerifyCode(ctx context.Context, verifyCode *table.VerifyCode, fn func() (string, string, error)) error {
 	return o.tx.Transaction(func(tx any) error {
 		if err := o.verifyCode.NewTx(tx).Add(ctx, []*table.VerifyCode{verifyCode}); err != nil {
 			return err
 		}
-		if fn != nil {
-			return fn()
+		if fn == nil {
+			return nil
 		}
-		return nil
+		provider, messageID, err := fn()
+		if err != nil {
+			return err
+		}
+		verifyCode.Provider = provider
+		verifyCode.MessageID = messageID
+		verifyCode.Status = constant2.VerifyCodeStatusSent
+		verifyCode.StatusTime = time.Now()
+		return o.verifyCode.NewTx(tx).Update(ctx, verifyCode.ID, map[string]any{
+			"provider":    verifyCode.Provider,
+			"message_id":  verifyCode.MessageID,
+			"status":      verifyCode.Status,
+			"status_time": verifyCode.StatusTime,
+		})
 	})
 }
 
+func (o *ChatDatabase) UpdateVerifyCodeStatus(ctx context.Context, provider string, messageID string, status int32, desc string, statusTime time.Time) error {
+	return o.verifyCode.UpdateStatus(ctx, provider, messageID, status, desc, statusTime)
+}
+
+func (o *ChatDatabase) SearchVerifyCode(ctx context.Context, keyword string, status []int32, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*table.VerifyCode, error) {
+	return o.verifyCode.Search(ctx, keyword, status, start, end, pageNumber, showNumber)
+}
+
 func (o *ChatDatabase) UpdateVerifyCodeIncrCount(ctx context.Context, id uint) error {
 	return o.verifyCode.Incr(ctx, id)
 }
@@ -206,6 +306,10 @@ func (o *ChatDatabase) GetAccount(ctx context.Context, userID string) (*table.Ac
 	return o.account.Take(ctx, userID)
 }
 
+func (o *ChatDatabase) TakeRegister(ctx context.Context, userID string) (*table.Register, error) {
+	return o.register.Take(ctx, userID)
+}
+
 func (o *ChatDatabase) GetAttribute(ctx context.Context, userID string) (*table.Attribute, error) {
 	return o.attribute.Take(ctx, userID)
 }
@@ -218,6 +322,10 @@ func (o *ChatDatabase) GetAttributeByPhone(ctx context.Context, areaCode string,
 	return o.attribute.TakePhone(ctx, areaCode, phoneNumber)
 }
 
+func (o *ChatDatabase) GetAttributeByEmail(ctx context.Context, email string) (*table.Attribute, error) {
+	return o.attribute.TakeEmail(ctx, email)
+}
+
 func (o *ChatDatabase) LoginRecord(ctx context.Context, record *table.UserLoginRecord, verifyCodeID *uint) error {
 	return o.tx.Transaction(func(tx any) error {
 		if err := o.userLoginRecord.NewTx(tx).Create(ctx, record); err != nil {
@@ -248,6 +356,75 @@ func (o *ChatDatabase) UpdatePasswordAndDeleteVerifyCode(ctx context.Context, us
 	})
 }
 
+func (o *ChatDatabase) FindPasswordHistory(ctx context.Context, userID string, limit int) ([]*table.PasswordHistory, error) {
+	return o.passwordHistory.FindLatest(ctx, userID, limit)
+}
+
+func (o *ChatDatabase) ChangePassword(ctx context.Context, userID string, oldPassword string, password string, keep int, code *uint) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.account.NewTx(tx).UpdatePassword(ctx, userID, password); err != nil {
+			return err
+		}
+		if keep > 0 && oldPassword != "" {
+			history := o.passwordHistory.NewTx(tx)
+			if err := history.Create(ctx, &table.PasswordHistory{UserID: userID, Password: oldPassword, CreateTime: time.Now()}); err != nil {
+				return err
+			}
+			latest, err := history.FindLatest(ctx, userID, keep)
+			if err != nil {
+				return err
+			}
+			if len(latest) == keep {
+				if err := history.DeleteBefore(ctx, userID, latest[keep-1].ID); err != nil {
+					return err
+				}
+			}
+		}
+		if code != nil {
+			if err := o.verifyCode.NewTx(tx).Delete(ctx, *code); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *ChatDatabase) UpdateContactAndDeleteVerifyCode(ctx context.Context, userID string, attribute map[string]any, codes []uint) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.attribute.NewTx(tx).Update(ctx, userID, attribute); err != nil {
+			return err
+		}
+		for _, code := range codes {
+			if err := o.verifyCode.NewTx(tx).Delete(ctx, code); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (o *ChatDatabase) UpdateLoginIdentifier(ctx context.Context, userID string, attribute map[string]any, password string, accountType string, codes []uint) error {
+	return o.tx.Transaction(func(tx any) error {
+		if err := o.attribute.NewTx(tx).Update(ctx, userID, attribute); err != nil {
+			return err
+		}
+		if password != "" {
+			if err := o.account.NewTx(tx).UpdatePassword(ctx, userID, password); err != nil {
+				return err
+			}
+		}
+		if err := o.register.NewTx(tx).Update(ctx, userID, map[string]any{"account_type": accountType}); err != nil {
+			return err
+		}
+		for _, code := range codes {
+			if err := o.verifyCode.NewTx(tx).Delete(ctx, code); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (o *ChatDatabase) NewUserCountTotal(ctx context.Context, before *time.Time) (int64, error) {
 	return o.register.CountTotal(ctx, before)
 }
@@ -259,3 +436,208 @@ func (o *ChatDatabase) UserLoginCountTotal(ctx context.Context, before *time.Tim
 func (o *ChatDatabase) UserLoginCountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error) {
 	return o.userLoginRecord.CountRangeEverydayTotal(ctx, start, end)
 }
+
+func (o *ChatDatabase) TakeTOTP(ctx context.Context, userID string) (*table.TOTP, error) {
+	return o.totp.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) SaveTOTP(ctx context.Context, totp *table.TOTP) error {
+	return o.totp.Save(ctx, totp)
+}
+
+func (o *ChatDatabase) UpdateTOTP(ctx context.Context, userID string, data map[string]any) error {
+	return o.totp.Update(ctx, userID, data)
+}
+
+func (o *ChatDatabase) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return o.totp.UseStep(ctx, userID, step)
+}
+
+func (o *ChatDatabase) UseTOTPRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error) {
+	return o.totp.UseRecoveryCodes(ctx, userID, old, new)
+}
+
+func (o *ChatDatabase) DelTOTP(ctx context.Context, userID string) error {
+	return o.totp.Delete(ctx, userID)
+}
+
+func (o *ChatDatabase) SetLoginChallenge(ctx context.Context, token string, userID string, expire time.Duration) error {
+	return o.challenge.SetChallenge(ctx, token, userID, expire)
+}
+
+func (o *ChatDatabase) GetLoginChallenge(ctx context.Context, token string) (string, error) {
+	return o.challenge.GetChallenge(ctx, token)
+}
+
+func (o *ChatDatabase) IncrLoginChallengeFailed(ctx context.Context, token string) (int64, error) {
+	return o.challenge.IncrChallengeFailed(ctx, token)
+}
+
+func (o *ChatDatabase) DelLoginChallenge(ctx context.Context, token string) (bool, error) {
+	return o.challenge.DelChallenge(ctx, token)
+}
+
+func (o *ChatDatabase) IncrLoginFailed(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.loginLock.IncrLoginFailed(ctx, key, window)
+}
+
+func (o *ChatDatabase) IncrLoginLockCount(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.loginLock.IncrLockCount(ctx, key, window)
+}
+
+func (o *ChatDatabase) LockLogin(ctx context.Context, key string, expire time.Duration) error {
+	return o.loginLock.Lock(ctx, key, expire)
+}
+
+func (o *ChatDatabase) LoginLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	return o.loginLock.LockTTL(ctx, key)
+}
+
+func (o *ChatDatabase) UnlockLogin(ctx context.Context, key string) error {
+	return o.loginLock.Unlock(ctx, key)
+}
+
+func (o *ChatDatabase) ClearLoginFailed(ctx context.Context, key string) error {
+	return o.loginLock.ClearFailed(ctx, key)
+}
+
+func (o *ChatDatabase) CreateWebAuthnCredential(ctx context.Context, credential *table.WebAuthnCredential) error {
+	return o.webAuthn.Create(ctx, credential)
+}
+
+func (o *ChatDatabase) TakeWebAuthnCredential(ctx context.Context, credentialID string) (*table.WebAuthnCredential, error) {
+	return o.webAuthn.Take(ctx, credentialID)
+}
+
+func (o *ChatDatabase) FindWebAuthnCredential(ctx context.Context, userID string) ([]*table.WebAuthnCredential, error) {
+	return o.webAuthn.Find(ctx, userID)
+}
+
+func (o *ChatDatabase) UseWebAuthnSignCount(ctx context.Context, credentialID string, old uint32, new uint32, lastUsed time.Time) (bool, error) {
+	return o.webAuthn.UseSignCount(ctx, credentialID, old, new, lastUsed)
+}
+
+func (o *ChatDatabase) DelWebAuthnCredential(ctx context.Context, userID string, credentialID string) (bool, error) {
+	return o.webAuthn.Delete(ctx, userID, credentialID)
+}
+
+func (o *ChatDatabase) SetWebAuthnChallenge(ctx context.Context, challenge string, userID string, expire time.Duration) error {
+	return o.webAuthnChal.SetChallenge(ctx, challenge, userID, expire)
+}
+
+// TakeWebAuthnChallenge challenge只能使用一次, 不存在或已使用返回空
+func (o *ChatDatabase) TakeWebAuthnChallenge(ctx context.Context, challenge string) (string, error) {
+	userID, err := o.webAuthnChal.GetChallenge(ctx, challenge)
+	if err != nil || userID == "" {
+		return "", err
+	}
+	deleted, err := o.webAuthnChal.DelChallenge(ctx, challenge)
+	if err != nil {
+		return "", err
+	}
+	if !deleted {
+		return "", nil
+	}
+	return userID, nil
+}
+
+func (o *ChatDatabase) CreateQRLogin(ctx context.Context, ticket string, t *cache.QRLoginTicket, expire time.Duration) error {
+	return o.qrLogin.Create(ctx, ticket, t, expire)
+}
+
+func (o *ChatDatabase) GetQRLogin(ctx context.Context, ticket string) (*cache.QRLoginTicket, error) {
+	return o.qrLogin.Get(ctx, ticket)
+}
+
+func (o *ChatDatabase) TransitQRLogin(ctx context.Context, ticket string, from int32, to int32, userID string) (bool, error) {
+	return o.qrLogin.Transit(ctx, ticket, from, to, userID)
+}
+
+func (o *ChatDatabase) ConsumeQRLogin(ctx context.Context, ticket string, status int32) (bool, error) {
+	return o.qrLogin.Consume(ctx, ticket, status)
+}
+
+func (o *ChatDatabase) IssueQRLogin(ctx context.Context, ticket string, from int32, to int32, chatToken string, refreshToken string) (bool, error) {
+	return o.qrLogin.Issue(ctx, ticket, from, to, chatToken, refreshToken)
+}
+
+func (o *ChatDatabase) SetCaptcha(ctx context.Context, id string, answer string, expire time.Duration) error {
+	return o.captcha.SetCaptcha(ctx, id, answer, expire)
+}
+
+func (o *ChatDatabase) TakeCaptcha(ctx context.Context, id string) (string, error) {
+	return o.captcha.TakeCaptcha(ctx, id)
+}
+
+func (o *ChatDatabase) SetCaptchaPass(ctx context.Context, token string, expire time.Duration) error {
+	return o.captcha.SetPass(ctx, token, expire)
+}
+
+func (o *ChatDatabase) TakeCaptchaPass(ctx context.Context, token string) (bool, error) {
+	return o.captcha.TakePass(ctx, token)
+}
+
+func (o *ChatDatabase) IncrCaptchaRisk(ctx context.Context, key string, window time.Duration) (int64, error) {
+	return o.captcha.IncrRisk(ctx, key, window)
+}
+
+func (o *ChatDatabase) ExistLoginDevice(ctx context.Context, userID string, deviceID string) (bool, error) {
+	return o.userLoginRecord.ExistDevice(ctx, userID, deviceID)
+}
+
+func (o *ChatDatabase) CreateAccountDeletion(ctx context.Context, deletion *table.AccountDeletion) error {
+	return o.accountDeletion.Create(ctx, deletion)
+}
+
+func (o *ChatDatabase) TakeAccountDeletion(ctx context.Context, userID string) (*table.AccountDeletion, error) {
+	return o.accountDeletion.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) DelAccountDeletion(ctx context.Context, userID string) (bool, error) {
+	return o.accountDeletion.Delete(ctx, userID)
+}
+
+func (o *ChatDatabase) FindDueAccountDeletion(ctx context.Context, before time.Time, limit int) ([]*table.AccountDeletion, error) {
+	return o.accountDeletion.FindDue(ctx, before, limit)
+}
+
+func (o *ChatDatabase) DeleteAccount(ctx context.Context, userID string, attribute map[string]any, register map[string]any) (bool, error) {
+	var deleted bool
+	err := o.tx.Transaction(func(tx any) error {
+		var err error
+		deleted, err = o.accountDeletion.NewTx(tx).Delete(ctx, userID)
+		if err != nil || !deleted {
+			return err
+		}
+		if err := o.attribute.NewTx(tx).Update(ctx, userID, attribute); err != nil {
+			return err
+		}
+		if err := o.register.NewTx(tx).Update(ctx, userID, register); err != nil {
+			return err
+		}
+		if err := o.account.NewTx(tx).Delete(ctx, userID); err != nil {
+			return err
+		}
+		if err := o.userLoginRecord.NewTx(tx).DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+		if err := o.log.NewTx(tx).DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+		if err := o.totp.NewTx(tx).Delete(ctx, userID); err != nil {
+			return err
+		}
+		if err := o.passwordHistory.NewTx(tx).DeleteUser(ctx, userID); err != nil {
+			return err
+		}
+		return o.webAuthn.NewTx(tx).DeleteUser(ctx, userID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return deleted, nil
+}
+
+func (o *ChatDatabase) TakeVerifyCodeLimit(ctx context.Context, rules []cache.RateLimitRule) (time.Duration, *cache.RateLimitRule, error) {
+	return o.verifyCodeLimit.Take(ctx, rules)
+}
@@ -22,8 +22,11 @@ import (
 	"time"
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+	"github.com/OpenIMSDK/chat/pkg/common/passwd"
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
 	"gorm.io/gorm"
 )
 
@@ -51,6 +54,14 @@ func (o *Admin) Update(ctx context.Context, account string, update map[string]an
 	return errs.Wrap(o.db.WithContext(ctx).Model(&admin.Admin{}).Where("user_id = ?", account).Updates(update).Error)
 }
 
+func (o *Admin) Create(ctx context.Context, admins ...*admin.Admin) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&admins).Error)
+}
+
+func (o *Admin) Delete(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&admin.Admin{}).Error)
+}
+
 func (o *Admin) InitAdmin(ctx context.Context) error {
 	var count int64
 	if err := o.db.WithContext(ctx).Model(&admin.Admin{}).Count(&count).Error; err != nil {
@@ -67,12 +78,16 @@ func (o *Admin) InitAdmin(ctx context.Context) error {
 	now := time.Now()
 	admins := make([]*admin.Admin, 0, len(config.Config.AdminList))
 	for _, adminChat := range config.Config.AdminList {
-		password := md5.Sum([]byte(adminChat.AdminID))
+		sum := md5.Sum([]byte(adminChat.AdminID))
+		password, err := passwd.Hash(hex.EncodeToString(sum[:]))
+		if err != nil {
+			return errs.Wrap(err)
+		}
 		table := admin.Admin{
 			Account:    adminChat.AdminID,
 			UserID:     adminChat.ImAdminID,
-			Password:   hex.EncodeToString(password[:]),
-			Level:      100,
+			Password:   password,
+			Level:      constant.AdminLevelSuper,
 			CreateTime: now,
 		}
 		if adminChat.NickName != "" {
@@ -87,3 +102,7 @@ func (o *Admin) InitAdmin(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (o *Admin) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.Admin, error) {
+	return ormutil.GormSearch[admin.Admin](o.db.WithContext(ctx).Order("create_time asc"), []string{"account", "nickname", "user_id"}, keyword, page, size)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewAdminIPAllow(db *gorm.DB) *AdminIPAllow {
+	return &AdminIPAllow{db: db}
+}
+
+type AdminIPAllow struct {
+	db *gorm.DB
+}
+
+func (o *AdminIPAllow) Create(ctx context.Context, ms []*admin.AdminIPAllow) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *AdminIPAllow) Delete(ctx context.Context, cidrs []string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("cidr in ?", cidrs).Delete(&admin.AdminIPAllow{}).Error)
+}
+
+func (o *AdminIPAllow) FindAll(ctx context.Context) ([]*admin.AdminIPAllow, error) {
+	var ms []*admin.AdminIPAllow
+	return ms, errs.Wrap(o.db.WithContext(ctx).Find(&ms).Error)
+}
+
+func (o *AdminIPAllow) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.AdminIPAllow, error) {
+	return ormutil.GormSearch[admin.AdminIPAllow](o.db.WithContext(ctx).Order("create_time desc"), []string{"cidr", "remark"}, keyword, page, size)
+}
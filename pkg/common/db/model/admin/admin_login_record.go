@@ -0,0 +1,51 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewAdminLoginRecord(db *gorm.DB) *AdminLoginRecord {
+	return &AdminLoginRecord{db: db}
+}
+
+type AdminLoginRecord struct {
+	db *gorm.DB
+}
+
+func (o *AdminLoginRecord) Create(ctx context.Context, records ...*admin.AdminLoginRecord) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&records).Error)
+}
+
+func (o *AdminLoginRecord) Count(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	return count, errs.Wrap(o.db.WithContext(ctx).Model(&admin.AdminLoginRecord{}).Where("user_id = ?", userID).Count(&count).Error)
+}
+
+func (o *AdminLoginRecord) CountIP(ctx context.Context, userID string, ip string) (int64, error) {
+	var count int64
+	return count, errs.Wrap(o.db.WithContext(ctx).Model(&admin.AdminLoginRecord{}).Where("user_id = ? and ip = ?", userID, ip).Count(&count).Error)
+}
+
+func (o *AdminLoginRecord) CountCountry(ctx context.Context, userID string, country string) (int64, error) {
+	var count int64
+	return count, errs.Wrap(o.db.WithContext(ctx).Model(&admin.AdminLoginRecord{}).Where("user_id = ? and country = ?", userID, country).Count(&count).Error)
+}
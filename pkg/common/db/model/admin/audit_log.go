@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewAuditLog(db *gorm.DB) *AuditLog {
+	return &AuditLog{db: db}
+}
+
+type AuditLog struct {
+	db *gorm.DB
+}
+
+func (o *AuditLog) Create(ctx context.Context, logs ...*admin.AuditLog) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&logs).Error)
+}
+
+func (o *AuditLog) Search(ctx context.Context, opUserID string, action string, keyword string, start time.Time, end time.Time, beforeID uint64, page int32, size int32) (uint32, []*admin.AuditLog, error) {
+	db := o.db.WithContext(ctx)
+	if opUserID != "" {
+		db = db.Where("op_user_id = ?", opUserID)
+	}
+	if action != "" {
+		db = db.Where("action = ?", action)
+	}
+	if start.UnixMilli() != 0 {
+		db = db.Where("create_time >= ?", start)
+	}
+	if end.UnixMilli() != 0 {
+		db = db.Where("create_time <= ?", end)
+	}
+	if beforeID > 0 {
+		db = db.Where("id < ?", beforeID)
+	}
+	return ormutil.GormSearch[admin.AuditLog](db.Order("id desc"), []string{"target", "request", "ip"}, keyword, page, size)
+}
@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/ormutil"
@@ -32,6 +33,15 @@ type ForbiddenAccount struct {
 	db *gorm.DB
 }
 
+func (o *ForbiddenAccount) NewTx(tx any) admin.ForbiddenAccountInterface {
+	return &ForbiddenAccount{db: tx.(*gorm.DB)}
+}
+
+// active 未到期的封禁
+func (o *ForbiddenAccount) active(ctx context.Context) *gorm.DB {
+	return o.db.WithContext(ctx).Where("(expire_time is null or expire_time > ?)", time.Now())
+}
+
 func (o *ForbiddenAccount) Create(ctx context.Context, ms []*admin.ForbiddenAccount) error {
 	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
 }
@@ -51,13 +61,26 @@ func (o *ForbiddenAccount) Find(ctx context.Context, userIDs []string) ([]*admin
 }
 
 func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*admin.ForbiddenAccount, error) {
-	return ormutil.GormSearch[admin.ForbiddenAccount](o.db.WithContext(ctx), []string{"user_id", "reason", "operator_user_id"}, keyword, page, size)
+	return ormutil.GormSearch[admin.ForbiddenAccount](o.active(ctx), []string{"user_id", "reason", "operator_user_id"}, keyword, page, size)
 }
 
 func (o *ForbiddenAccount) FindAllIDs(ctx context.Context) ([]string, error) {
 	var userIDs []string
-	if err := o.db.WithContext(ctx).Model(&admin.ForbiddenAccount{}).Pluck("user_id", &userIDs).Error; err != nil {
+	if err := o.active(ctx).Model(&admin.ForbiddenAccount{}).Pluck("user_id", &userIDs).Error; err != nil {
 		return nil, errs.Wrap(err)
 	}
 	return userIDs, nil
 }
+
+func (o *ForbiddenAccount) FindExpired(ctx context.Context, before time.Time, limit int) ([]*admin.ForbiddenAccount, error) {
+	var ms []*admin.ForbiddenAccount
+	return ms, errs.Wrap(o.db.WithContext(ctx).Where("expire_time <= ?", before).Order("expire_time").Limit(limit).Find(&ms).Error)
+}
+
+func (o *ForbiddenAccount) DeleteExpired(ctx context.Context, userID string, before time.Time) (bool, error) {
+	res := o.db.WithContext(ctx).Where("user_id = ? and expire_time <= ?", userID, before).Delete(&admin.ForbiddenAccount{})
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
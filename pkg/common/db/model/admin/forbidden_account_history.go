@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
+	"gorm.io/gorm"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/admin"
+)
+
+func NewForbiddenAccountHistory(db *gorm.DB) admin.ForbiddenAccountHistoryInterface {
+	return &ForbiddenAccountHistory{db: db}
+}
+
+type ForbiddenAccountHistory struct {
+	db *gorm.DB
+}
+
+func (o *ForbiddenAccountHistory) NewTx(tx any) admin.ForbiddenAccountHistoryInterface {
+	return &ForbiddenAccountHistory{db: tx.(*gorm.DB)}
+}
+
+func (o *ForbiddenAccountHistory) Create(ctx context.Context, ms []*admin.ForbiddenAccountHistory) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(&ms).Error)
+}
+
+func (o *ForbiddenAccountHistory) Search(ctx context.Context, userID string, page int32, size int32) (uint32, []*admin.ForbiddenAccountHistory, error) {
+	db := o.db.WithContext(ctx).Order("unblock_time desc")
+	if userID != "" {
+		db = db.Where("user_id = ?", userID)
+	}
+	return ormutil.GormPage[admin.ForbiddenAccountHistory](db, page, size)
+}
@@ -51,3 +51,7 @@ func (o *Account) Update(ctx context.Context, userID string, data map[string]any
 func (o *Account) UpdatePassword(ctx context.Context, userId string, password string) error {
 	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Account{}).Where("user_id = ?", userId).Updates(map[string]interface{}{"password": password, "change_time": time.Now()}).Error)
 }
+
+func (o *Account) Delete(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.Account{}).Error)
+}
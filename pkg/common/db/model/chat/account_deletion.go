@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewAccountDeletion(db *gorm.DB) chat.AccountDeletionInterface {
+	return &AccountDeletion{db: db}
+}
+
+type AccountDeletion struct {
+	db *gorm.DB
+}
+
+func (o *AccountDeletion) NewTx(tx any) chat.AccountDeletionInterface {
+	return &AccountDeletion{db: tx.(*gorm.DB)}
+}
+
+func (o *AccountDeletion) Create(ctx context.Context, deletion *chat.AccountDeletion) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(deletion).Error)
+}
+
+func (o *AccountDeletion) Take(ctx context.Context, userID string) (*chat.AccountDeletion, error) {
+	var d chat.AccountDeletion
+	return &d, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Take(&d).Error)
+}
+
+func (o *AccountDeletion) Delete(ctx context.Context, userID string) (bool, error) {
+	res := o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.AccountDeletion{})
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *AccountDeletion) FindDue(ctx context.Context, before time.Time, limit int) ([]*chat.AccountDeletion, error) {
+	var ds []*chat.AccountDeletion
+	return ds, errs.Wrap(o.db.WithContext(ctx).Where("execute_time <= ?", before).Order("execute_time").Limit(limit).Find(&ds).Error)
+}
@@ -72,6 +72,11 @@ func (o *Attribute) TakeAccount(ctx context.Context, account string) (*chat.Attr
 	return &a, errs.Wrap(o.db.WithContext(ctx).Where("account = ?", account).Take(&a).Error)
 }
 
+func (o *Attribute) TakeEmail(ctx context.Context, email string) (*chat.Attribute, error) {
+	var a chat.Attribute
+	return &a, errs.Wrap(o.db.WithContext(ctx).Where("email = ?", email).Take(&a).Error)
+}
+
 func (o *Attribute) Take(ctx context.Context, userID string) (*chat.Attribute, error) {
 	var a chat.Attribute
 	return &a, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Take(&a).Error)
@@ -13,6 +13,10 @@ type Logs struct {
 	db *gorm.DB
 }
 
+func (l *Logs) NewTx(tx any) chat.LogInterface {
+	return &Logs{db: tx.(*gorm.DB)}
+}
+
 func (l *Logs) Create(ctx context.Context, log []*chat.Log) error {
 	return errs.Wrap(l.db.WithContext(ctx).Create(log).Error)
 }
@@ -39,6 +43,11 @@ func (l *Logs) Get(ctx context.Context, logIDs []string, userID string) ([]*chat
 	}
 	return logs, errs.Wrap(l.db.WithContext(ctx).Where("log_id in ? and user_id=?", logIDs, userID).Find(&logs).Error)
 }
+
+func (l *Logs) DeleteUser(ctx context.Context, userID string) error {
+	return errs.Wrap(l.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.Log{}).Error)
+}
+
 func NewLogs(db *gorm.DB) chat.LogInterface {
 	return &Logs{db: db}
 }
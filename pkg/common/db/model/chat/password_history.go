@@ -0,0 +1,52 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewPasswordHistory(db *gorm.DB) chat.PasswordHistoryInterface {
+	return &PasswordHistory{db: db}
+}
+
+type PasswordHistory struct {
+	db *gorm.DB
+}
+
+func (o *PasswordHistory) NewTx(tx any) chat.PasswordHistoryInterface {
+	return &PasswordHistory{db: tx.(*gorm.DB)}
+}
+
+func (o *PasswordHistory) Create(ctx context.Context, history *chat.PasswordHistory) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(history).Error)
+}
+
+func (o *PasswordHistory) FindLatest(ctx context.Context, userID string, limit int) ([]*chat.PasswordHistory, error) {
+	var hs []*chat.PasswordHistory
+	return hs, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Order("id desc").Limit(limit).Find(&hs).Error)
+}
+
+func (o *PasswordHistory) DeleteBefore(ctx context.Context, userID string, id uint) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ? and id < ?", userID, id).Delete(&chat.PasswordHistory{}).Error)
+}
+
+func (o *PasswordHistory) DeleteUser(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.PasswordHistory{}).Error)
+}
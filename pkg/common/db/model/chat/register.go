@@ -40,6 +40,11 @@ func (o *Register) Create(ctx context.Context, registers ...*chat.Register) erro
 	return errs.Wrap(o.db.WithContext(ctx).Create(registers).Error)
 }
 
+func (o *Register) Take(ctx context.Context, userID string) (*chat.Register, error) {
+	var r chat.Register
+	return &r, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Take(&r).Error)
+}
+
 func (o *Register) CountTotal(ctx context.Context, before *time.Time) (count int64, err error) {
 	db := o.db.WithContext(ctx).Model(&chat.Register{})
 	if before != nil {
@@ -50,3 +55,7 @@ func (o *Register) CountTotal(ctx context.Context, before *time.Time) (count int
 	}
 	return count, nil
 }
+
+func (o *Register) Update(ctx context.Context, userID string, data map[string]any) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.Register{}).Where("user_id = ?", userID).Updates(data).Error)
+}
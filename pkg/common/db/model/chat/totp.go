@@ -0,0 +1,68 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewTOTP(db *gorm.DB) chat.TOTPInterface {
+	return &TOTP{db: db}
+}
+
+type TOTP struct {
+	db *gorm.DB
+}
+
+func (o *TOTP) NewTx(tx any) chat.TOTPInterface {
+	return &TOTP{db: tx.(*gorm.DB)}
+}
+
+func (o *TOTP) Save(ctx context.Context, totp *chat.TOTP) error {
+	return errs.Wrap(o.db.WithContext(ctx).Save(totp).Error)
+}
+
+func (o *TOTP) Take(ctx context.Context, userID string) (*chat.TOTP, error) {
+	var t chat.TOTP
+	return &t, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Take(&t).Error)
+}
+
+func (o *TOTP) Update(ctx context.Context, userID string, data map[string]any) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.TOTP{}).Where("user_id = ?", userID).Updates(data).Error)
+}
+
+func (o *TOTP) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&chat.TOTP{}).Where("user_id = ? and last_step < ?", userID, step).Update("last_step", step)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *TOTP) UseRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&chat.TOTP{}).Where("user_id = ? and recovery_codes = ?", userID, old).Update("recovery_codes", new)
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *TOTP) Delete(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.TOTP{}).Error)
+}
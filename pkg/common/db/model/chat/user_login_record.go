@@ -77,3 +77,15 @@ func (o *UserLoginRecord) CountRangeEverydayTotal(ctx context.Context, start *ti
 	}
 	return v, loginCount, nil
 }
+
+func (o *UserLoginRecord) DeleteUser(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.UserLoginRecord{}).Error)
+}
+
+func (o *UserLoginRecord) ExistDevice(ctx context.Context, userID string, deviceID string) (bool, error) {
+	var count int64
+	if err := o.db.WithContext(ctx).Model(&chat.UserLoginRecord{}).Where("user_id = ? and device_id = ?", userID, deviceID).Limit(1).Count(&count).Error; err != nil {
+		return false, errs.Wrap(err)
+	}
+	return count > 0, nil
+}
@@ -18,8 +18,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/ormutil"
 	"gorm.io/gorm"
 )
 
@@ -61,3 +63,29 @@ func (o *VerifyCode) Incr(ctx context.Context, id uint) error {
 func (o *VerifyCode) Delete(ctx context.Context, id uint) error {
 	return errs.Wrap(o.db.WithContext(ctx).Where("id = ?", id).Delete(&chat.VerifyCode{}).Error)
 }
+
+func (o *VerifyCode) Update(ctx context.Context, id uint, data map[string]any) error {
+	return errs.Wrap(o.db.WithContext(ctx).Model(&chat.VerifyCode{}).Where("id = ?", id).Updates(data).Error)
+}
+
+func (o *VerifyCode) UpdateStatus(ctx context.Context, provider string, messageID string, status int32, desc string, statusTime time.Time) error {
+	db := o.db.WithContext(ctx).Model(&chat.VerifyCode{}).Where("provider = ? and message_id = ?", provider, messageID)
+	if status == constant.VerifyCodeStatusSent {
+		db = db.Where("status in ?", []int32{constant.VerifyCodeStatusUnknown, constant.VerifyCodeStatusSent})
+	}
+	return errs.Wrap(db.Updates(map[string]any{"status": status, "status_desc": desc, "status_time": statusTime}).Error)
+}
+
+func (o *VerifyCode) Search(ctx context.Context, keyword string, status []int32, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*chat.VerifyCode, error) {
+	db := o.db.WithContext(ctx)
+	if start.UnixMilli() != 0 {
+		db = db.Where("create_time >= ?", start)
+	}
+	if end.UnixMilli() != 0 {
+		db = db.Where("create_time <= ?", end)
+	}
+	if len(status) > 0 {
+		db = db.Where("status in ?", status)
+	}
+	return ormutil.GormSearch[chat.VerifyCode](db.Order("id desc"), []string{"account"}, keyword, pageNumber, showNumber)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/db/table/chat"
+	"github.com/OpenIMSDK/tools/errs"
+	"gorm.io/gorm"
+)
+
+func NewWebAuthnCredential(db *gorm.DB) chat.WebAuthnCredentialInterface {
+	return &WebAuthnCredential{db: db}
+}
+
+type WebAuthnCredential struct {
+	db *gorm.DB
+}
+
+func (o *WebAuthnCredential) NewTx(tx any) chat.WebAuthnCredentialInterface {
+	return &WebAuthnCredential{db: tx.(*gorm.DB)}
+}
+
+func (o *WebAuthnCredential) Create(ctx context.Context, credential *chat.WebAuthnCredential) error {
+	return errs.Wrap(o.db.WithContext(ctx).Create(credential).Error)
+}
+
+func (o *WebAuthnCredential) Take(ctx context.Context, credentialID string) (*chat.WebAuthnCredential, error) {
+	var c chat.WebAuthnCredential
+	return &c, errs.Wrap(o.db.WithContext(ctx).Where("credential_id = ?", credentialID).Take(&c).Error)
+}
+
+func (o *WebAuthnCredential) Find(ctx context.Context, userID string) ([]*chat.WebAuthnCredential, error) {
+	var cs []*chat.WebAuthnCredential
+	return cs, errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Order("create_time").Find(&cs).Error)
+}
+
+func (o *WebAuthnCredential) UseSignCount(ctx context.Context, credentialID string, old uint32, new uint32, lastUsed time.Time) (bool, error) {
+	res := o.db.WithContext(ctx).Model(&chat.WebAuthnCredential{}).Where("credential_id = ? and sign_count = ?", credentialID, old).Updates(map[string]any{"sign_count": new, "last_used_time": lastUsed})
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *WebAuthnCredential) Delete(ctx context.Context, userID string, credentialID string) (bool, error) {
+	res := o.db.WithContext(ctx).Where("user_id = ? and credential_id = ?", userID, credentialID).Delete(&chat.WebAuthnCredential{})
+	if res.Error != nil {
+		return false, errs.Wrap(res.Error)
+	}
+	return res.RowsAffected > 0, nil
+}
+
+func (o *WebAuthnCredential) DeleteUser(ctx context.Context, userID string) error {
+	return errs.Wrap(o.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&chat.WebAuthnCredential{}).Error)
+}
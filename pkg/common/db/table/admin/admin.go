@@ -22,12 +22,14 @@ import (
 // Admin 后台管理员.
 type Admin struct {
 	Account    string    `gorm:"column:account;primary_key;type:varchar(64)"`
-	Password   string    `gorm:"column:password;type:varchar(64)"`
+	Password   string    `gorm:"column:password;type:varchar(255)"`
 	FaceURL    string    `gorm:"column:face_url;type:varchar(255)"`
 	Nickname   string    `gorm:"column:nickname;type:varchar(64)"`
-	UserID     string    `gorm:"column:user_id;type:varchar(64)"` // openIM userID
+	UserID     string    `gorm:"column:user_id;type:varchar(64)"` // 配置初始化的管理员与openIM userID相同
 	Level      int32     `gorm:"column:level;default:1"  `
 	CreateTime time.Time `gorm:"column:create_time"`
+	IMUserID   string    `gorm:"column:im_user_id;type:varchar(64)"` // 绑定的openIM管理员, 为空时使用配置adminList
+	Status     int32     `gorm:"column:status;default:0"`
 }
 
 func (Admin) TableName() string {
@@ -38,5 +40,8 @@ type AdminInterface interface {
 	Take(ctx context.Context, account string) (*Admin, error)
 	TakeUserID(ctx context.Context, userID string) (*Admin, error)
 	Update(ctx context.Context, account string, update map[string]any) error
+	Create(ctx context.Context, admins ...*Admin) error
+	Delete(ctx context.Context, userID string) error
 	InitAdmin(ctx context.Context) error
+	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*Admin, error)
 }
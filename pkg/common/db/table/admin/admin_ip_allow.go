@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AdminIPAllow 允许访问管理后台的ip段, 为空时不限制.
+type AdminIPAllow struct {
+	CIDR       string    `gorm:"column:cidr;primary_key;type:varchar(64)"`
+	Remark     string    `gorm:"column:remark;type:varchar(255)"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (AdminIPAllow) TableName() string {
+	return "admin_ip_allows"
+}
+
+type AdminIPAllowInterface interface {
+	Create(ctx context.Context, ms []*AdminIPAllow) error
+	Delete(ctx context.Context, cidrs []string) error
+	FindAll(ctx context.Context) ([]*AdminIPAllow, error)
+	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*AdminIPAllow, error)
+}
@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AdminLoginRecord 管理员登录记录, 用于发现新ip或国家的登录.
+type AdminLoginRecord struct {
+	ID        uint64    `gorm:"column:id;primary_key;autoIncrement"`
+	UserID    string    `gorm:"column:user_id;type:varchar(64);index"`
+	IP        string    `gorm:"column:ip;type:varchar(64)"`
+	Country   string    `gorm:"column:country;type:varchar(8)"`
+	LoginTime time.Time `gorm:"column:login_time"`
+}
+
+func (AdminLoginRecord) TableName() string {
+	return "admin_login_records"
+}
+
+type AdminLoginRecordInterface interface {
+	Create(ctx context.Context, records ...*AdminLoginRecord) error
+	Count(ctx context.Context, userID string) (int64, error)
+	CountIP(ctx context.Context, userID string, ip string) (int64, error)
+	CountCountry(ctx context.Context, userID string, country string) (int64, error)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AdminTOTP 管理员二次验证.
+type AdminTOTP struct {
+	UserID        string    `gorm:"column:user_id;primary_key;type:varchar(64)"`
+	Secret        string    `gorm:"column:secret;type:varchar(64)"`
+	Enabled       bool      `gorm:"column:enabled"`
+	RecoveryCodes string    `gorm:"column:recovery_codes;type:text"` // 恢复码sha256, 逗号分隔
+	LastStep      int64     `gorm:"column:last_step"`                // 最近一次使用的时间步, 防止重放
+	CreateTime    time.Time `gorm:"column:create_time"`
+	ChangeTime    time.Time `gorm:"column:change_time"`
+}
+
+func (AdminTOTP) TableName() string {
+	return "admin_totp"
+}
+
+type AdminTOTPInterface interface {
+	Save(ctx context.Context, totp *AdminTOTP) error
+	Take(ctx context.Context, userID string) (*AdminTOTP, error)
+	Update(ctx context.Context, userID string, data map[string]any) error
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error)
+	Delete(ctx context.Context, userID string) error
+}
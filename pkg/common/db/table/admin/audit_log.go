@@ -0,0 +1,43 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// AuditLog 管理员操作审计日志, 只能追加, 不提供修改和删除.
+type AuditLog struct {
+	ID         uint64    `gorm:"column:id;primary_key;autoIncrement"`
+	OpUserID   string    `gorm:"column:op_user_id;type:varchar(64);index"`
+	Action     string    `gorm:"column:action;type:varchar(64);index"`
+	Target     string    `gorm:"column:target;type:varchar(255)"`
+	Request    string    `gorm:"column:request;type:text"`  // 请求参数, 密码等敏感字段已脱敏
+	Previous   string    `gorm:"column:previous;type:text"` // 修改前的数据
+	IP         string    `gorm:"column:ip;type:varchar(64)"`
+	ErrCode    int32     `gorm:"column:err_code"`
+	ErrMsg     string    `gorm:"column:err_msg;type:varchar(255)"`
+	CreateTime time.Time `gorm:"column:create_time;index"`
+}
+
+func (AuditLog) TableName() string {
+	return "audit_logs"
+}
+
+type AuditLogInterface interface {
+	Create(ctx context.Context, logs ...*AuditLog) error
+	Search(ctx context.Context, opUserID string, action string, keyword string, start time.Time, end time.Time, beforeID uint64, page int32, size int32) (uint32, []*AuditLog, error)
+}
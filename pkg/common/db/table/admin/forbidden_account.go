@@ -25,6 +25,14 @@ type ForbiddenAccount struct {
 	Reason         string    `gorm:"column:reason;type:varchar(255)" `
 	OperatorUserID string    `gorm:"column:operator_user_id;type:varchar(255)"`
 	CreateTime     time.Time `gorm:"column:create_time" `
+
+	Category   string     `gorm:"column:category;type:varchar(32)"`
+	ExpireTime *time.Time `gorm:"column:expire_time;index"` // 为空时永久封禁
+}
+
+// Expired 临时封禁已到期.
+func (f *ForbiddenAccount) Expired(now time.Time) bool {
+	return f.ExpireTime != nil && !f.ExpireTime.After(now)
 }
 
 func (ForbiddenAccount) TableName() string {
@@ -32,10 +40,14 @@ func (ForbiddenAccount) TableName() string {
 }
 
 type ForbiddenAccountInterface interface {
+	NewTx(tx any) ForbiddenAccountInterface
 	Create(ctx context.Context, ms []*ForbiddenAccount) error
 	Take(ctx context.Context, userID string) (*ForbiddenAccount, error)
 	Delete(ctx context.Context, userIDs []string) error
 	Find(ctx context.Context, userIDs []string) ([]*ForbiddenAccount, error)
 	Search(ctx context.Context, keyword string, page int32, size int32) (uint32, []*ForbiddenAccount, error)
 	FindAllIDs(ctx context.Context) ([]string, error)
+	FindExpired(ctx context.Context, before time.Time, limit int) ([]*ForbiddenAccount, error)
+	// DeleteExpired 删除已到期的封禁, 返回是否删除, 多个实例同时清理时只有一个成功
+	DeleteExpired(ctx context.Context, userID string, before time.Time) (bool, error)
 }
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// ForbiddenAccountHistory 已解除的封号记录.
+type ForbiddenAccountHistory struct {
+	ID              uint64     `gorm:"column:id;primary_key;autoIncrement"`
+	UserID          string     `gorm:"column:user_id;type:char(64);index"`
+	Reason          string     `gorm:"column:reason;type:varchar(255)"`
+	Category        string     `gorm:"column:category;type:varchar(32)"`
+	OperatorUserID  string     `gorm:"column:operator_user_id;type:varchar(255)"`
+	CreateTime      time.Time  `gorm:"column:create_time"`
+	ExpireTime      *time.Time `gorm:"column:expire_time"`
+	UnblockType     int32      `gorm:"column:unblock_type"` // 1:管理员解封 2:到期自动解封
+	UnblockOpUserID string     `gorm:"column:unblock_op_user_id;type:varchar(255)"`
+	UnblockTime     time.Time  `gorm:"column:unblock_time"`
+}
+
+func (ForbiddenAccountHistory) TableName() string {
+	return "forbidden_account_histories"
+}
+
+type ForbiddenAccountHistoryInterface interface {
+	NewTx(tx any) ForbiddenAccountHistoryInterface
+	Create(ctx context.Context, ms []*ForbiddenAccountHistory) error
+	Search(ctx context.Context, userID string, page int32, size int32) (uint32, []*ForbiddenAccountHistory, error)
+}
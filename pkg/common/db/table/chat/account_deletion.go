@@ -0,0 +1,40 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// AccountDeletion 用户注销申请, 冷静期结束后执行注销.
+type AccountDeletion struct {
+	UserID      string    `gorm:"column:user_id;primary_key;type:char(64)"`
+	CreateTime  time.Time `gorm:"column:create_time"`
+	ExecuteTime time.Time `gorm:"column:execute_time;index"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletion"
+}
+
+type AccountDeletionInterface interface {
+	NewTx(tx any) AccountDeletionInterface
+	Create(ctx context.Context, deletion *AccountDeletion) error
+	Take(ctx context.Context, userID string) (*AccountDeletion, error)
+	Delete(ctx context.Context, userID string) (bool, error)
+	// FindDue 获取执行时间已到的注销申请
+	FindDue(ctx context.Context, before time.Time, limit int) ([]*AccountDeletion, error)
+}
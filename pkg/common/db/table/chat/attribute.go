@@ -37,6 +37,12 @@ type Attribute struct {
 	AllowBeep        int32     `gorm:"column:allow_beep;default:1"`
 	AllowAddFriend   int32     `gorm:"column:allow_add_friend;default:1"`
 	GlobalRecvMsgOpt int32     `gorm:"column:global_recv_msg_opt;default:0"`
+	Language         string    `gorm:"column:language;type:varchar(16)"`
+
+	// EmailKey 由email生成, 空邮箱为NULL, 用于邮箱唯一索引
+	EmailKey *string `gorm:"column:email_key;->;type:varchar(64) GENERATED ALWAYS AS (NULLIF(email, '')) STORED;uniqueIndex:uk_email"`
+	// PhoneKey 由区号和手机号生成, 空手机号为NULL, 用于手机号唯一索引
+	PhoneKey *string `gorm:"column:phone_key;->;type:varchar(41) GENERATED ALWAYS AS (IF(phone_number = '', NULL, CONCAT(area_code, ' ', phone_number))) STORED;uniqueIndex:uk_phone"`
 }
 
 func (Attribute) TableName() string {
@@ -52,6 +58,7 @@ type AttributeInterface interface {
 	Search(ctx context.Context, keyword string, genders []int32, page int32, size int32) (uint32, []*Attribute, error)
 	TakePhone(ctx context.Context, areaCode string, phoneNumber string) (*Attribute, error)
 	TakeAccount(ctx context.Context, account string) (*Attribute, error)
+	TakeEmail(ctx context.Context, email string) (*Attribute, error)
 	Take(ctx context.Context, userID string) (*Attribute, error)
 	SearchNormalUser(ctx context.Context, keyword string, forbiddenID []string, gender int32, page int32, size int32) (uint32, []*Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pageNumber int32, showNumber int32) (uint32, []*Attribute, error)
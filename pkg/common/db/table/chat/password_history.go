@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// PasswordHistory 用户使用过的密码, 修改密码时保存被替换的密码.
+type PasswordHistory struct {
+	ID         uint      `gorm:"column:id;primary_key;autoIncrement"`
+	UserID     string    `gorm:"column:user_id;type:char(64);index"`
+	Password   string    `gorm:"column:password;type:varchar(255)"`
+	CreateTime time.Time `gorm:"column:create_time"`
+}
+
+func (PasswordHistory) TableName() string {
+	return "password_history"
+}
+
+type PasswordHistoryInterface interface {
+	NewTx(tx any) PasswordHistoryInterface
+	Create(ctx context.Context, history *PasswordHistory) error
+	// FindLatest 按时间倒序获取最近的limit条记录
+	FindLatest(ctx context.Context, userID string, limit int) ([]*PasswordHistory, error)
+	// DeleteBefore 删除id小于指定值的记录
+	DeleteBefore(ctx context.Context, userID string, id uint) error
+	DeleteUser(ctx context.Context, userID string) error
+}
@@ -37,5 +37,7 @@ func (Register) TableName() string {
 type RegisterInterface interface {
 	NewTx(tx any) RegisterInterface
 	Create(ctx context.Context, registers ...*Register) error
+	Take(ctx context.Context, userID string) (*Register, error)
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
+	Update(ctx context.Context, userID string, data map[string]any) error
 }
@@ -37,4 +37,7 @@ type UserLoginRecordInterface interface {
 	Create(ctx context.Context, records ...*UserLoginRecord) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	CountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
+	DeleteUser(ctx context.Context, userID string) error
+	// ExistDevice 用户是否使用该设备登录过
+	ExistDevice(ctx context.Context, userID string, deviceID string) (bool, error)
 }
@@ -27,6 +27,12 @@ type VerifyCode struct {
 	Duration   uint      `gorm:"column:duration;type:int(11)"`
 	Count      int       `gorm:"column:count;type:int(11)"`
 	Used       bool      `gorm:"column:used"`
+	Provider   string    `gorm:"column:provider;type:varchar(32)"`
+	UsedFor    int32     `gorm:"column:used_for"`
+	MessageID  string    `gorm:"column:message_id;type:varchar(64);index"`
+	Status     int32     `gorm:"column:status"`
+	StatusDesc string    `gorm:"column:status_desc;type:varchar(255)"`
+	StatusTime time.Time `gorm:"column:status_time"`
 	CreateTime time.Time `gorm:"column:create_time;autoCreateTime"`
 }
 
@@ -41,4 +47,8 @@ type VerifyCodeInterface interface {
 	TakeLast(ctx context.Context, account string) (*VerifyCode, error)
 	Incr(ctx context.Context, id uint) error
 	Delete(ctx context.Context, id uint) error
+	Update(ctx context.Context, id uint, data map[string]any) error
+	// UpdateStatus 按服务商消息ID更新发送状态, 已送达或失败的记录不会被更新为已提交
+	UpdateStatus(ctx context.Context, provider string, messageID string, status int32, desc string, statusTime time.Time) error
+	Search(ctx context.Context, keyword string, status []int32, start time.Time, end time.Time, pageNumber int32, showNumber int32) (uint32, []*VerifyCode, error)
 }
@@ -0,0 +1,46 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// WebAuthnCredential 用户通行密钥(passkey).
+type WebAuthnCredential struct {
+	CredentialID string    `gorm:"column:credential_id;primary_key;type:varchar(255)"` // base64url
+	UserID       string    `gorm:"column:user_id;type:char(64);index"`
+	Name         string    `gorm:"column:name;type:varchar(64)"`
+	PublicKey    []byte    `gorm:"column:public_key;type:blob"` // COSE_Key
+	SignCount    uint32    `gorm:"column:sign_count"`
+	CreateTime   time.Time `gorm:"column:create_time"`
+	LastUsedTime time.Time `gorm:"column:last_used_time"`
+}
+
+func (WebAuthnCredential) TableName() string {
+	return "webauthn_credential"
+}
+
+type WebAuthnCredentialInterface interface {
+	NewTx(tx any) WebAuthnCredentialInterface
+	Create(ctx context.Context, credential *WebAuthnCredential) error
+	Take(ctx context.Context, credentialID string) (*WebAuthnCredential, error)
+	Find(ctx context.Context, userID string) ([]*WebAuthnCredential, error)
+	// UseSignCount 仅当签名计数未被并发更新时更新, 防止克隆的认证器重放
+	UseSignCount(ctx context.Context, credentialID string, old uint32, new uint32, lastUsed time.Time) (bool, error)
+	Delete(ctx context.Context, userID string, credentialID string) (bool, error)
+	DeleteUser(ctx context.Context, userID string) error
+}
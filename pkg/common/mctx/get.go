@@ -109,6 +109,15 @@ func GetOpUserID(ctx context.Context) string {
 	return userID
 }
 
+// GetOpUserIP api层设置的客户端ip, 服务内部调用时为空
+func GetOpUserIP(ctx context.Context) string {
+	ips, _ := ctx.Value(constant.RpcOpUserIP).([]string)
+	if len(ips) == 0 {
+		return ""
+	}
+	return ips[0]
+}
+
 func GetUserType(ctx context.Context) (int, error) {
 	userTypeArr, _ := ctx.Value(constant.RpcOpUserType).([]string)
 	userType, err := strconv.Atoi(userTypeArr[0])
@@ -0,0 +1,36 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mctx
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+)
+
+// SetRetryAfter 通过trailer返回需要等待的秒数, 错误经过rpc后只保留文本, 客户端无法可靠解析
+func SetRetryAfter(ctx context.Context, wait time.Duration) int64 {
+	seconds := int64((wait + time.Second - 1) / time.Second)
+	if err := grpc.SetTrailer(ctx, metadata.Pairs(constant.RpcRetryAfter, strconv.FormatInt(seconds, 10))); err != nil {
+		log.ZWarn(ctx, "set retry after trailer failed", err)
+	}
+	return seconds
+}
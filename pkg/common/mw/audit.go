@@ -0,0 +1,213 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mw/specialerror"
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+)
+
+const (
+	auditRedacted   = "******"
+	auditMaxRequest = 60000
+	auditMaxTarget  = 255
+)
+
+// auditSensitiveKeys 记录请求参数时需要脱敏的字段, 小写.
+var auditSensitiveKeys = map[string]struct{}{
+	"code":       {},
+	"verifycode": {},
+	"totpcode":   {},
+}
+
+// auditSensitiveParts 字段名包含这些内容时脱敏, 小写, 如currentPassword、refreshToken.
+var auditSensitiveParts = []string{"password", "secret", "token"}
+
+func auditSensitive(key string) bool {
+	key = strings.ToLower(key)
+	if _, ok := auditSensitiveKeys[key]; ok {
+		return true
+	}
+	for _, part := range auditSensitiveParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditRule 需要审计的rpc方法.
+type AuditRule struct {
+	Targets  []string                                        // 作为操作对象的请求字段
+	Previous func(ctx context.Context, req any) (any, error) // 获取修改前的数据, 可为空
+}
+
+// AuditRecord 一次管理员操作.
+type AuditRecord struct {
+	OpUserID string
+	Action   string
+	Target   string
+	Request  string
+	Previous string
+	IP       string
+	ErrCode  int32
+	ErrMsg   string
+	Time     time.Time
+}
+
+// Auditor 记录管理员调用的rpc, 服务启动时通过Init设置规则, 未设置时不记录.
+type Auditor struct {
+	rules  map[string]*AuditRule
+	record func(ctx context.Context, record *AuditRecord) error
+}
+
+// Init rules的key为rpc方法名, 如 AddApplet
+func (a *Auditor) Init(rules map[string]*AuditRule, record func(ctx context.Context, record *AuditRecord) error) {
+	a.rules = rules
+	a.record = record
+}
+
+// GrpcServer 需要在mw.GrpcServer之后执行
+func (a *Auditor) GrpcServer() grpc.ServerOption {
+	return grpc.ChainUnaryInterceptor(a.intercept)
+}
+
+func (a *Auditor) intercept(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if a.record == nil {
+		return handler(ctx, req)
+	}
+	action := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	rule, ok := a.rules[action]
+	if !ok {
+		return handler(ctx, req)
+	}
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil || userType != constant.AdminUser {
+		return handler(ctx, req)
+	}
+	record := &AuditRecord{
+		OpUserID: opUserID,
+		Action:   action,
+		IP:       mctx.GetOpUserIP(ctx),
+	}
+	fields := auditFields(req)
+	record.Target = auditTarget(fields, rule.Targets)
+	record.Request = auditString(fields, auditMaxRequest)
+	if rule.Previous != nil {
+		if previous, err := rule.Previous(ctx, req); err != nil {
+			log.ZWarn(ctx, "audit get previous data failed", err, "action", action)
+		} else if previous != nil {
+			record.Previous = auditString(auditFields(previous), auditMaxRequest)
+		}
+	}
+	resp, err := handler(ctx, req)
+	record.Time = time.Now()
+	if err != nil {
+		if codeErr := specialerror.ErrCode(errs.Unwrap(err)); codeErr != nil {
+			record.ErrCode = int32(codeErr.Code())
+			record.ErrMsg = codeErr.Msg()
+		} else {
+			record.ErrCode = int32(errs.ErrInternalServer.Code())
+			record.ErrMsg = err.Error()
+		}
+		record.ErrMsg = truncate(record.ErrMsg, auditMaxTarget)
+	}
+	if recordErr := a.record(ctx, record); recordErr != nil {
+		log.ZError(ctx, "audit record failed", recordErr, "opUserID", opUserID, "action", action, "target", record.Target)
+	}
+	return resp, err
+}
+
+// auditFields 按json字段名展开并脱敏
+func auditFields(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	var fields any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return string(data)
+	}
+	return redact(fields)
+}
+
+func redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if auditSensitive(k) {
+				if item != nil && item != "" {
+					val[k] = auditRedacted
+				}
+				continue
+			}
+			val[k] = redact(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = redact(item)
+		}
+	}
+	return v
+}
+
+func auditTarget(fields any, keys []string) string {
+	m, ok := fields.(map[string]any)
+	if !ok || len(keys) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if v, ok := m[key]; ok {
+			parts = append(parts, key+"="+auditValue(v))
+		}
+	}
+	return truncate(strings.Join(parts, " "), auditMaxTarget)
+}
+
+func auditValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func auditString(fields any, max int) string {
+	return truncate(auditValue(fields), max)
+}
+
+// truncate 按字节截断, 不截断多字节字符
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+	return s[:max]
+}
@@ -0,0 +1,47 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	fields := map[string]any{
+		"userID":          "u1",
+		"areaCode":        "+86",
+		"currentPassword": "old",
+		"newPassword":     "new",
+		"refreshToken":    "rt",
+		"verifyCode":      "123456",
+		"user": map[string]any{
+			"password": "p",
+			"nickname": "n",
+		},
+	}
+	redact(fields)
+	for _, k := range []string{"currentPassword", "newPassword", "refreshToken", "verifyCode"} {
+		if fields[k] != auditRedacted {
+			t.Errorf("%s not redacted: %v", k, fields[k])
+		}
+	}
+	for _, k := range []string{"userID", "areaCode"} {
+		if fields[k] == auditRedacted {
+			t.Errorf("%s redacted", k)
+		}
+	}
+	user := fields["user"].(map[string]any)
+	if user["password"] != auditRedacted || user["nickname"] != "n" {
+		t.Errorf("nested fields: %v", user)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package passwd hashes user and admin passwords before they are stored.
+//
+// Hashed values carry an algorithm prefix, e.g. "{bcrypt}$2a$10$..." or
+// "{argon2id}$v=19$m=65536,t=1,p=4$<salt>$<key>". Values without one of these prefixes are
+// legacy plaintext (as sent by the client) and are still accepted by Verify,
+// which then asks the caller to rehash them.
+package passwd
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const (
+	Bcrypt   = "bcrypt"
+	Argon2id = "argon2id"
+)
+
+const (
+	argon2idTime    = 1
+	argon2idMemory  = 64 * 1024
+	argon2idThreads = 4
+	argon2idKeyLen  = 32
+	argon2idSaltLen = 16
+)
+
+var errMalformed = errors.New("malformed password hash")
+
+func algorithm() string {
+	if alg := strings.ToLower(config.Config.PasswordHash.Algorithm); alg != "" {
+		return alg
+	}
+	return Bcrypt
+}
+
+func bcryptCost() int {
+	if cost := config.Config.PasswordHash.BcryptCost; cost >= bcrypt.MinCost && cost <= bcrypt.MaxCost {
+		return cost
+	}
+	return bcrypt.DefaultCost
+}
+
+// Hash hashes password with the configured algorithm.
+func Hash(password string) (string, error) {
+	switch alg := algorithm(); alg {
+	case Bcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost())
+		if err != nil {
+			return "", err
+		}
+		return "{" + Bcrypt + "}" + string(hash), nil
+	case Argon2id:
+		salt := make([]byte, argon2idSaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, argon2idTime, argon2idMemory, argon2idThreads, argon2idKeyLen)
+		return fmt.Sprintf("{%s}$v=%d$m=%d,t=%d,p=%d$%s$%s", Argon2id, argon2.Version, argon2idMemory, argon2idTime, argon2idThreads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	default:
+		return "", fmt.Errorf("not support password hash algorithm: `%s`", alg)
+	}
+}
+
+// Verify reports whether password matches hashed, and whether hashed should be
+// replaced by a fresh Hash because it is legacy plaintext or uses outdated parameters.
+func Verify(hashed string, password string) (ok bool, rehash bool) {
+	alg, value, found := split(hashed)
+	if !found {
+		return subtle.ConstantTimeCompare([]byte(hashed), []byte(password)) == 1, true
+	}
+	switch alg {
+	case Bcrypt:
+		if bcrypt.CompareHashAndPassword([]byte(value), []byte(password)) != nil {
+			return false, false
+		}
+		cost, err := bcrypt.Cost([]byte(value))
+		return true, alg != algorithm() || err != nil || cost != bcryptCost()
+	default:
+		match, err := verifyArgon2id(value, password)
+		if err != nil || !match {
+			return false, false
+		}
+		return true, alg != algorithm()
+	}
+}
+
+// split only recognizes the prefixes written by Hash, so legacy plaintext
+// passwords that happen to look like "{...}..." are still compared as plaintext.
+func split(hashed string) (alg string, value string, found bool) {
+	for _, alg := range []string{Bcrypt, Argon2id} {
+		if prefix := "{" + alg + "}"; strings.HasPrefix(hashed, prefix) {
+			return alg, hashed[len(prefix):], true
+		}
+	}
+	return "", "", false
+}
+
+func verifyArgon2id(value string, password string) (bool, error) {
+	parts := strings.Split(value, "$")
+	if len(parts) != 5 || parts[0] != "" {
+		return false, errMalformed
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[1], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, errMalformed
+	}
+	var (
+		memory  uint32
+		time    uint32
+		threads uint8
+	)
+	if _, err := fmt.Sscanf(parts[2], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return false, errMalformed
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return false, errMalformed
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, errMalformed
+	}
+	other := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
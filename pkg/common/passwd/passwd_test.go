@@ -0,0 +1,101 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func setHash(t *testing.T, alg string, cost int) {
+	t.Helper()
+	old := config.Config.PasswordHash
+	config.Config.PasswordHash.Algorithm = alg
+	config.Config.PasswordHash.BcryptCost = cost
+	t.Cleanup(func() { config.Config.PasswordHash = old })
+}
+
+func mustHash(t *testing.T, alg string, cost int, password string) string {
+	t.Helper()
+	setHash(t, alg, cost)
+	hashed, err := Hash(password)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hashed
+}
+
+func TestHash(t *testing.T) {
+	for _, alg := range []string{Bcrypt, Argon2id} {
+		hashed := mustHash(t, alg, bcrypt.MinCost, "secret")
+		if !strings.HasPrefix(hashed, "{"+alg+"}") {
+			t.Errorf("%s: unexpected prefix %q", alg, hashed)
+		}
+		if strings.Contains(hashed, "secret") {
+			t.Errorf("%s: hash contains password", alg)
+		}
+	}
+	setHash(t, "md5", 0)
+	if _, err := Hash("secret"); err == nil {
+		t.Error("unknown algorithm accepted")
+	}
+}
+
+func TestVerify(t *testing.T) {
+	bcryptHash := mustHash(t, Bcrypt, bcrypt.MinCost, "secret")
+	argon2idHash := mustHash(t, Argon2id, 0, "secret")
+	tests := []struct {
+		name     string
+		alg      string // configured algorithm when verifying
+		hashed   string
+		password string
+		ok       bool
+		rehash   bool
+	}{
+		{"bcrypt", Bcrypt, bcryptHash, "secret", true, false},
+		{"bcrypt wrong", Bcrypt, bcryptHash, "wrong", false, false},
+		{"bcrypt to argon2id", Argon2id, bcryptHash, "secret", true, true},
+		{"argon2id", Argon2id, argon2idHash, "secret", true, false},
+		{"argon2id wrong", Argon2id, argon2idHash, "wrong", false, false},
+		{"argon2id to bcrypt", Bcrypt, argon2idHash, "secret", true, true},
+		{"plaintext", Bcrypt, "secret", "secret", true, true},
+		{"plaintext wrong", Bcrypt, "secret", "wrong", false, true},
+		{"plaintext brace", Bcrypt, "{abc}def", "{abc}def", true, true},
+		{"plaintext brace wrong", Bcrypt, "{abc}def", "def", false, true},
+		{"plaintext bcrypt-like", Bcrypt, "{Bcrypt}x", "{Bcrypt}x", true, true},
+		{"argon2id malformed", Argon2id, "{argon2id}$v=19", "secret", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setHash(t, tt.alg, bcrypt.MinCost)
+			ok, rehash := Verify(tt.hashed, tt.password)
+			if ok != tt.ok || rehash != tt.rehash {
+				t.Errorf("Verify() = %v, %v, want %v, %v", ok, rehash, tt.ok, tt.rehash)
+			}
+		})
+	}
+}
+
+func TestVerifyBcryptCost(t *testing.T) {
+	hashed := mustHash(t, Bcrypt, bcrypt.MinCost, "secret")
+	setHash(t, Bcrypt, bcrypt.MinCost+1)
+	if ok, rehash := Verify(hashed, "secret"); !ok || !rehash {
+		t.Errorf("Verify() = %v, %v, want true, true", ok, rehash)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+var denylist struct {
+	once sync.Once
+	set  map[string]struct{}
+	err  error
+}
+
+func loadDenylist() (map[string]struct{}, error) {
+	denylist.once.Do(func() {
+		path := config.Config.PasswordPolicy.DenylistFile
+		if path == "" {
+			return
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			denylist.err = errs.Wrap(err, "open password denylist")
+			return
+		}
+		defer f.Close()
+		set := make(map[string]struct{})
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				set[strings.ToLower(line)] = struct{}{}
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			denylist.err = errs.Wrap(err, "read password denylist")
+			return
+		}
+		denylist.set = set
+	})
+	return denylist.set, denylist.err
+}
+
+// classes counts lower case letters, upper case letters, digits and symbols present in password.
+func classes(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
+
+// CheckPolicy checks password against the configured length, character class and denylist rules.
+func CheckPolicy(password string) error {
+	policy := config.Config.PasswordPolicy
+	if n := len([]rune(password)); n < policy.MinLength {
+		return eerrs.ErrPasswordTooShort.Wrap(fmt.Sprintf("password must be at least %d characters", policy.MinLength))
+	}
+	if policy.MinClasses > 0 && classes(password) < policy.MinClasses {
+		return eerrs.ErrPasswordTooSimple.Wrap(fmt.Sprintf("password must contain at least %d of lower case letters, upper case letters, digits and symbols", policy.MinClasses))
+	}
+	set, err := loadDenylist()
+	if err != nil {
+		return err
+	}
+	if _, ok := set[strings.ToLower(password)]; ok {
+		return eerrs.ErrPasswordTooCommon.Wrap("password is too common")
+	}
+	return nil
+}
+
+// CheckHistory reports eerrs.ErrPasswordReused when password matches any of the hashed passwords.
+func CheckHistory(password string, hashed ...string) error {
+	for _, h := range hashed {
+		if h == "" {
+			continue
+		}
+		if ok, _ := Verify(h, password); ok {
+			return eerrs.ErrPasswordReused.Wrap(fmt.Sprintf("password must differ from the last %d passwords", config.Config.PasswordPolicy.HistoryCount))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rbac 管理员角色(admin.Level)与权限.
+package rbac
+
+import (
+	"sort"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+)
+
+// Permission 资源:操作, 操作为read或write.
+type Permission string
+
+const (
+	DefaultRead         Permission = "default:read"
+	DefaultWrite        Permission = "default:write"
+	InvitationCodeRead  Permission = "invitation_code:read"
+	InvitationCodeWrite Permission = "invitation_code:write"
+	ForbiddenRead       Permission = "forbidden:read"
+	ForbiddenWrite      Permission = "forbidden:write"
+	AppletRead          Permission = "applet:read"
+	AppletWrite         Permission = "applet:write"
+	BlockRead           Permission = "block:read"
+	BlockWrite          Permission = "block:write"
+	UserRead            Permission = "user:read"
+	UserWrite           Permission = "user:write"
+	ClientConfigRead    Permission = "client_config:read"
+	ClientConfigWrite   Permission = "client_config:write"
+	StatisticRead       Permission = "statistic:read"
+	LogsRead            Permission = "logs:read"
+	LogsWrite           Permission = "logs:write"
+	VerifyCodeRead      Permission = "verify_code:read"
+	RoleRead            Permission = "role:read"
+	RoleWrite           Permission = "role:write"
+	AdminRead           Permission = "admin:read"
+	AdminWrite          Permission = "admin:write"
+	AuditRead           Permission = "audit:read"
+)
+
+var (
+	readPermissions = []Permission{
+		DefaultRead, InvitationCodeRead, ForbiddenRead, AppletRead, BlockRead, UserRead,
+		ClientConfigRead, StatisticRead, LogsRead, VerifyCodeRead, RoleRead, AdminRead, AuditRead,
+	}
+	writePermissions = []Permission{
+		DefaultWrite, InvitationCodeWrite, ForbiddenWrite, AppletWrite, BlockWrite, UserWrite,
+		ClientConfigWrite, LogsWrite,
+	}
+)
+
+// Role 角色名称及拥有的权限.
+type Role struct {
+	Level       int32
+	Name        string
+	Permissions map[Permission]struct{}
+}
+
+var roles = map[int32]*Role{
+	constant.AdminLevelAuditor:  newRole(constant.AdminLevelAuditor, "auditor", readPermissions),
+	constant.AdminLevelSupport:  newRole(constant.AdminLevelSupport, "support", without(readPermissions, RoleRead, AdminRead, AuditRead), BlockWrite, UserWrite),
+	constant.AdminLevelOperator: newRole(constant.AdminLevelOperator, "operator", readPermissions, writePermissions...),
+	constant.AdminLevelSuper:    newRole(constant.AdminLevelSuper, "super", readPermissions, append(writePermissions, RoleWrite, AdminWrite)...),
+}
+
+func newRole(level int32, name string, base []Permission, extra ...Permission) *Role {
+	r := &Role{Level: level, Name: name, Permissions: make(map[Permission]struct{})}
+	for _, p := range base {
+		r.Permissions[p] = struct{}{}
+	}
+	for _, p := range extra {
+		r.Permissions[p] = struct{}{}
+	}
+	return r
+}
+
+func without(ps []Permission, exclude ...Permission) []Permission {
+	res := make([]Permission, 0, len(ps))
+	for _, p := range ps {
+		if !contains(exclude, p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func contains(ps []Permission, p Permission) bool {
+	for _, v := range ps {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRole 未定义的level返回nil
+func GetRole(level int32) *Role {
+	return roles[level]
+}
+
+// Roles 按level升序
+func Roles() []*Role {
+	res := make([]*Role, 0, len(roles))
+	for _, r := range roles {
+		res = append(res, r)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Level < res[j].Level })
+	return res
+}
+
+// Allowed 未定义的level没有任何权限
+func Allowed(level int32, permission Permission) bool {
+	r := GetRole(level)
+	if r == nil {
+		return false
+	}
+	_, ok := r.Permissions[permission]
+	return ok
+}
+
+// PermissionList 排序后的权限列表
+func (r *Role) PermissionList() []string {
+	res := make([]string, 0, len(r.Permissions))
+	for p := range r.Permissions {
+		res = append(res, string(p))
+	}
+	sort.Strings(res)
+	return res
+}
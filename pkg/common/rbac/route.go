@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rbac
+
+// routePermissions admin-api路由需要的权限, 未登记的路由仅超级管理员可访问.
+var routePermissions = map[string]Permission{
+	"/default/user/add":     DefaultWrite,
+	"/default/user/del":     DefaultWrite,
+	"/default/user/find":    DefaultRead,
+	"/default/user/search":  DefaultRead,
+	"/default/group/add":    DefaultWrite,
+	"/default/group/del":    DefaultWrite,
+	"/default/group/find":   DefaultRead,
+	"/default/group/search": DefaultRead,
+
+	"/invitation_code/add":    InvitationCodeWrite,
+	"/invitation_code/gen":    InvitationCodeWrite,
+	"/invitation_code/del":    InvitationCodeWrite,
+	"/invitation_code/search": InvitationCodeRead,
+
+	"/forbidden/ip/add":      ForbiddenWrite,
+	"/forbidden/ip/del":      ForbiddenWrite,
+	"/forbidden/ip/search":   ForbiddenRead,
+	"/forbidden/user/add":    ForbiddenWrite,
+	"/forbidden/user/del":    ForbiddenWrite,
+	"/forbidden/user/search": ForbiddenRead,
+
+	"/applet/add":    AppletWrite,
+	"/applet/del":    AppletWrite,
+	"/applet/update": AppletWrite,
+	"/applet/search": AppletRead,
+
+	"/block/add":     BlockWrite,
+	"/block/del":     BlockWrite,
+	"/block/search":  BlockRead,
+	"/block/history": BlockRead,
+
+	"/user/password/reset": UserWrite,
+	"/user/totp/disable":   UserWrite,
+	"/user/login/unlock":   UserWrite,
+
+	"/client_config/get": ClientConfigRead,
+	"/client_config/set": ClientConfigWrite,
+	"/client_config/del": ClientConfigWrite,
+
+	"/statistic/new_user_count":   StatisticRead,
+	"/statistic/login_user_count": StatisticRead,
+
+	"/logs/search": LogsRead,
+	"/logs/delete": LogsWrite,
+
+	"/verify_code/search": VerifyCodeRead,
+
+	"/role/find":      RoleRead,
+	"/role/admin/set": RoleWrite,
+
+	"/admin/search":     AdminRead,
+	"/admin/add":        AdminWrite,
+	"/admin/del":        AdminWrite,
+	"/admin/status/set": AdminWrite,
+	"/admin/im/bind":    AdminWrite,
+
+	"/admin/ip/add":    AdminWrite,
+	"/admin/ip/del":    AdminWrite,
+	"/admin/ip/search": AdminRead,
+
+	"/audit/search": AuditRead,
+	"/audit/export": AuditRead,
+}
+
+// RoutePermission 返回路由需要的权限, 未登记时返回RoleWrite
+func RoutePermission(path string) Permission {
+	if p, ok := routePermissions[path]; ok {
+		return p
+	}
+	return RoleWrite
+}
@@ -36,21 +36,26 @@ type claims struct {
 	jwt.RegisteredClaims
 }
 
-func buildClaims(userID string, userType int32, ttl int64) claims {
+func buildClaims(userID string, userType int32, ttl time.Duration) claims {
 	now := time.Now()
 	before := now.Add(-time.Minute * 5)
 	return claims{
 		UserID:   userID,
 		UserType: userType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(ttl*24) * time.Hour)), // Expiration time
-			IssuedAt:  jwt.NewNumericDate(now),                                        // Issuing time
-			NotBefore: jwt.NewNumericDate(before),                                     // Begin Effective time
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)), // Expiration time
+			IssuedAt:  jwt.NewNumericDate(now),          // Issuing time
+			NotBefore: jwt.NewNumericDate(before),       // Begin Effective time
 		},
 	}
 }
 
+// CreateToken ttl单位为天
 func CreateToken(UserID string, userType int32, ttl int64) (string, error) {
+	return CreateTokenWithTTL(UserID, userType, time.Duration(ttl*24)*time.Hour)
+}
+
+func CreateTokenWithTTL(UserID string, userType int32, ttl time.Duration) (string, error) {
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", errs.ErrTokenUnknown.Wrap("token type unknown")
 	}
@@ -0,0 +1,146 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package totp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+	"github.com/OpenIMSDK/chat/pkg/eerrs"
+)
+
+const (
+	RecoveryCodeCount  = 10
+	MaxChallengeFailed = 5
+
+	defaultChallengeExpire = 300
+)
+
+// Store 二次验证的存储, 用户和管理员的数据库各自实现.
+type Store interface {
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	UseTOTPRecoveryCodes(ctx context.Context, userID string, old string, new string) (bool, error)
+	SetLoginChallenge(ctx context.Context, token string, userID string, expire time.Duration) error
+	GetLoginChallenge(ctx context.Context, token string) (string, error)
+	IncrLoginChallengeFailed(ctx context.Context, token string) (int64, error)
+	DelLoginChallenge(ctx context.Context, token string) (bool, error)
+}
+
+// Confirm 校验开启二次验证时的动态码, 返回需要更新的字段和新生成的恢复码.
+func Confirm(secret string, code string) (map[string]any, []string, error) {
+	step, ok := Validate(secret, code, time.Now())
+	if !ok {
+		return nil, nil, eerrs.ErrTOTPNotMatch.Wrap()
+	}
+	codes, err := GenerateRecoveryCodes(RecoveryCodeCount)
+	if err != nil {
+		return nil, nil, errs.Wrap(err)
+	}
+	update := map[string]any{
+		"enabled":        true,
+		"recovery_codes": JoinRecoveryCodes(codes),
+		"last_step":      step,
+		"change_time":    time.Now(),
+	}
+	return update, codes, nil
+}
+
+// CheckCode 校验动态码或恢复码, 两者都只能使用一次.
+func CheckCode(ctx context.Context, store Store, userID string, secret string, recoveryCodes string, code string) error {
+	if code == "" {
+		return errs.ErrArgs.Wrap("totp code is empty")
+	}
+	if step, ok := Validate(secret, code, time.Now()); ok {
+		used, err := store.UseTOTPStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return eerrs.ErrTOTPNotMatch.Wrap("code already used")
+		}
+		return nil
+	}
+	if remain, ok := UseRecoveryCode(recoveryCodes, code); ok {
+		used, err := store.UseTOTPRecoveryCodes(ctx, userID, recoveryCodes, remain)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return eerrs.ErrTOTPNotMatch.Wrap("recovery code already used")
+		}
+		return nil
+	}
+	return eerrs.ErrTOTPNotMatch.Wrap()
+}
+
+// NewChallenge 密码校验通过后生成二次验证的登录凭证.
+func NewChallenge(ctx context.Context, store Store, userID string) (string, error) {
+	data := make([]byte, 32)
+	if _, err := rand.Read(data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	token := hex.EncodeToString(data)
+	expire := config.Config.TOTP.ChallengeExpire
+	if expire <= 0 {
+		expire = defaultChallengeExpire
+	}
+	if err := store.SetLoginChallenge(ctx, token, userID, time.Duration(expire)*time.Second); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// TakeChallenge 返回登录凭证对应的userID, 凭证不存在或已过期时返回ErrLoginChallengeExpired.
+func TakeChallenge(ctx context.Context, store Store, token string) (string, error) {
+	userID, err := store.GetLoginChallenge(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	if userID == "" {
+		return "", eerrs.ErrLoginChallengeExpired.Wrap()
+	}
+	return userID, nil
+}
+
+// VerifyChallenge 校验登录凭证的二次验证码, 失败达到MaxChallengeFailed次后凭证作废, 成功后凭证只能使用一次.
+func VerifyChallenge(ctx context.Context, store Store, token string, userID string, secret string, recoveryCodes string, code string) error {
+	if err := CheckCode(ctx, store, userID, secret, recoveryCodes, code); err != nil {
+		if eerrs.ErrTOTPNotMatch.Is(err) {
+			count, incrErr := store.IncrLoginChallengeFailed(ctx, token)
+			if incrErr != nil {
+				log.ZError(ctx, "IncrLoginChallengeFailed", incrErr, "userID", userID)
+			} else if count >= MaxChallengeFailed {
+				if _, err := store.DelLoginChallenge(ctx, token); err != nil {
+					log.ZError(ctx, "DelLoginChallenge", err, "userID", userID)
+				}
+			}
+		}
+		return err
+	}
+	deleted, err := store.DelLoginChallenge(ctx, token)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return eerrs.ErrLoginChallengeExpired.Wrap()
+	}
+	return nil
+}
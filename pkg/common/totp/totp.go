@@ -0,0 +1,153 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package totp implements RFC 6238 time-based one-time passwords
+// (HMAC-SHA1, 6 digits, 30 second period) as used by common authenticator apps.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	Digits = 6
+	Period = 30
+
+	secretLen       = 20
+	skew            = 1
+	recoveryCodeLen = 10
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new base32 encoded secret.
+func GenerateSecret() (string, error) {
+	data := make([]byte, secretLen)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(data), nil
+}
+
+// URL returns the otpauth URL to be rendered as a QR code by the client.
+func URL(issuer string, account string, secret string) string {
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	v := url.Values{}
+	v.Set("secret", secret)
+	if issuer != "" {
+		v.Set("issuer", issuer)
+	}
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// Step returns the time step t falls into.
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// Validate checks code against secret at t, allowing one step of clock skew.
+// It returns the matched step so callers can reject a replayed code.
+func Validate(secret string, code string, t time.Time) (int64, bool) {
+	if len(code) != Digits {
+		return 0, false
+	}
+	key, err := encoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+	now := Step(t)
+	for i := -skew; i <= skew; i++ {
+		step := now + int64(i)
+		if subtle.ConstantTimeCompare([]byte(generate(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func generate(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+// GenerateRecoveryCodes returns n one-time recovery codes.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	const chars = "abcdefghjkmnpqrstuvwxyz23456789"
+	codes := make([]string, n)
+	for i := range codes {
+		data := make([]byte, recoveryCodeLen)
+		if _, err := rand.Read(data); err != nil {
+			return nil, err
+		}
+		for j := range data {
+			data[j] = chars[int(data[j])%len(chars)]
+		}
+		codes[i] = string(data[:recoveryCodeLen/2]) + "-" + string(data[recoveryCodeLen/2:])
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the stored form of a recovery code.
+func HashRecoveryCode(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
+
+// JoinRecoveryCodes returns the stored form of a set of recovery codes.
+func JoinRecoveryCodes(codes []string) string {
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return strings.Join(hashes, ",")
+}
+
+// UseRecoveryCode reports whether code is one of the stored recovery codes
+// and returns the stored form of the remaining codes.
+func UseRecoveryCode(stored string, code string) (string, bool) {
+	if stored == "" {
+		return "", false
+	}
+	hash := HashRecoveryCode(code)
+	hashes := strings.Split(stored, ",")
+	for i, h := range hashes {
+		if subtle.ConstantTimeCompare([]byte(h), []byte(hash)) == 1 {
+			return strings.Join(append(hashes[:i:i], hashes[i+1:]...), ","), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,323 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webauthn implements the relying party side of the WebAuthn
+// registration and assertion ceremonies needed for passkey login. Only
+// "none" style attestation is evaluated: the attestation statement is not
+// verified, the credential is trusted on first use like most consumer sites.
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ugorji/go/codec"
+)
+
+// COSE algorithm identifiers.
+const (
+	AlgES256 = -7
+	AlgEdDSA = -8
+	AlgRS256 = -257
+)
+
+const (
+	TypeCreate = "webauthn.create"
+	TypeGet    = "webauthn.get"
+
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+
+	challengeLen = 32
+)
+
+// Algorithms lists the supported algorithms in order of preference, for pubKeyCredParams.
+var Algorithms = []int64{AlgES256, AlgEdDSA, AlgRS256}
+
+var cborHandle codec.CborHandle
+
+// RelyingParty checks ceremony data against the configured rp id and allowed origins.
+type RelyingParty struct {
+	ID      string
+	Origins []string
+}
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Registration is the result of a verified registration ceremony.
+type Registration struct {
+	Challenge    string
+	CredentialID []byte
+	PublicKey    []byte // COSE_Key
+	SignCount    uint32
+	UserVerified bool
+}
+
+// Assertion is the parsed, not yet signature checked, result of an assertion ceremony.
+type Assertion struct {
+	Challenge    string
+	SignCount    uint32
+	UserVerified bool
+}
+
+type attestationObject struct {
+	Fmt      string                 `codec:"fmt"`
+	AttStmt  map[string]interface{} `codec:"attStmt"`
+	AuthData []byte                 `codec:"authData"`
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte
+}
+
+// NewChallenge returns a random base64url encoded challenge.
+func NewChallenge() (string, error) {
+	data := make([]byte, challengeLen)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return EncodeToString(data), nil
+}
+
+// EncodeToString encodes binary ceremony data the way browsers do (base64url without padding).
+func EncodeToString(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeString accepts base64url with or without padding.
+func DecodeString(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// ParseRegistration verifies the client data and authenticator data of a
+// navigator.credentials.create() response. The caller must still check that
+// the returned challenge was issued to the user.
+func (r *RelyingParty) ParseRegistration(clientDataJSON []byte, attestation []byte) (*Registration, error) {
+	cd, err := r.parseClientData(clientDataJSON, TypeCreate)
+	if err != nil {
+		return nil, err
+	}
+	var obj attestationObject
+	if err := codec.NewDecoderBytes(attestation, &cborHandle).Decode(&obj); err != nil {
+		return nil, fmt.Errorf("invalid attestation object: %w", err)
+	}
+	ad, err := r.parseAuthenticatorData(obj.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	if ad.flags&flagAttested == 0 || len(ad.credentialID) == 0 {
+		return nil, errors.New("attested credential data missing")
+	}
+	if _, _, err := parsePublicKey(ad.publicKey); err != nil {
+		return nil, err
+	}
+	return &Registration{
+		Challenge:    cd.Challenge,
+		CredentialID: ad.credentialID,
+		PublicKey:    ad.publicKey,
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// ParseAssertion verifies the client data and authenticator data of a
+// navigator.credentials.get() response. The signature is checked separately
+// with VerifySignature once the credential has been looked up.
+func (r *RelyingParty) ParseAssertion(clientDataJSON []byte, authData []byte) (*Assertion, error) {
+	cd, err := r.parseClientData(clientDataJSON, TypeGet)
+	if err != nil {
+		return nil, err
+	}
+	ad, err := r.parseAuthenticatorData(authData)
+	if err != nil {
+		return nil, err
+	}
+	return &Assertion{
+		Challenge:    cd.Challenge,
+		SignCount:    ad.signCount,
+		UserVerified: ad.flags&flagUserVerified != 0,
+	}, nil
+}
+
+// VerifySignature checks an assertion signature over authData || sha256(clientDataJSON).
+func VerifySignature(publicKey []byte, authData []byte, clientDataJSON []byte, signature []byte) error {
+	key, alg, err := parsePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := make([]byte, 0, len(authData)+len(clientDataHash))
+	signed = append(signed, authData...)
+	signed = append(signed, clientDataHash[:]...)
+	switch alg {
+	case AlgES256:
+		digest := sha256.Sum256(signed)
+		if !ecdsa.VerifyASN1(key.(*ecdsa.PublicKey), digest[:], signature) {
+			return errors.New("signature mismatch")
+		}
+	case AlgRS256:
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(key.(*rsa.PublicKey), crypto.SHA256, digest[:], signature); err != nil {
+			return errors.New("signature mismatch")
+		}
+	case AlgEdDSA:
+		if !ed25519.Verify(key.(ed25519.PublicKey), signed, signature) {
+			return errors.New("signature mismatch")
+		}
+	}
+	return nil
+}
+
+// CheckSignCount rejects an assertion whose signature counter did not advance
+// past the stored value. Authenticators without a counter always report 0.
+func CheckSignCount(stored uint32, received uint32) error {
+	if stored == 0 && received == 0 {
+		return nil
+	}
+	if received <= stored {
+		return errors.New("sign count not increased")
+	}
+	return nil
+}
+
+func (r *RelyingParty) parseClientData(data []byte, typ string) (*clientData, error) {
+	var cd clientData
+	if err := json.Unmarshal(data, &cd); err != nil {
+		return nil, fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != typ {
+		return nil, fmt.Errorf("client data type %q, expected %q", cd.Type, typ)
+	}
+	if cd.Challenge == "" {
+		return nil, errors.New("client data challenge is empty")
+	}
+	for _, origin := range r.Origins {
+		if cd.Origin == origin {
+			return &cd, nil
+		}
+	}
+	return nil, fmt.Errorf("origin %q not allowed", cd.Origin)
+}
+
+func (r *RelyingParty) parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("authenticator data too short")
+	}
+	ad := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	rpIDHash := sha256.Sum256([]byte(r.ID))
+	if subtle.ConstantTimeCompare(ad.rpIDHash, rpIDHash[:]) != 1 {
+		return nil, errors.New("rp id hash mismatch")
+	}
+	if ad.flags&flagUserPresent == 0 {
+		return nil, errors.New("user not present")
+	}
+	if ad.flags&flagAttested == 0 {
+		return ad, nil
+	}
+	// aaguid(16) + credentialIdLength(2) + credentialId + credentialPublicKey
+	rest := data[37:]
+	if len(rest) < 18 {
+		return nil, errors.New("attested credential data too short")
+	}
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < idLen {
+		return nil, errors.New("credential id too short")
+	}
+	ad.credentialID = rest[:idLen]
+	rest = rest[idLen:]
+	dec := codec.NewDecoderBytes(rest, &cborHandle)
+	var raw interface{}
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid credential public key: %w", err)
+	}
+	ad.publicKey = append([]byte(nil), rest[:dec.NumBytesRead()]...)
+	return ad, nil
+}
+
+// parsePublicKey decodes a COSE_Key into a crypto public key.
+func parsePublicKey(coseKey []byte) (crypto.PublicKey, int64, error) {
+	var m map[int64]interface{}
+	if err := codec.NewDecoderBytes(coseKey, &cborHandle).Decode(&m); err != nil {
+		return nil, 0, fmt.Errorf("invalid public key: %w", err)
+	}
+	alg, _ := coseInt(m[3])
+	switch alg {
+	case AlgES256:
+		crv, _ := coseInt(m[-1])
+		x, _ := m[-2].([]byte)
+		y, _ := m[-3].([]byte)
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, 0, errors.New("invalid ES256 public key")
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, 0, errors.New("ES256 public key not on curve")
+		}
+		return key, alg, nil
+	case AlgRS256:
+		n, _ := m[-1].([]byte)
+		e, _ := m[-2].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, 0, errors.New("invalid RS256 public key")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, alg, nil
+	case AlgEdDSA:
+		crv, _ := coseInt(m[-1])
+		x, _ := m[-2].([]byte)
+		if crv != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, 0, errors.New("invalid EdDSA public key")
+		}
+		return ed25519.PublicKey(x), alg, nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported public key algorithm %d", alg)
+	}
+}
+
+func coseInt(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case uint64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ugorji/go/codec"
+)
+
+const (
+	testRPID   = "example.com"
+	testOrigin = "https://example.com"
+)
+
+// authenticator 软件实现的ES256认证器, 用于模拟浏览器返回的数据.
+type authenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newAuthenticator(t *testing.T) *authenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		t.Fatal(err)
+	}
+	return &authenticator{key: key, credentialID: id}
+}
+
+func encodeCBOR(t *testing.T, v interface{}) []byte {
+	var out []byte
+	if err := codec.NewEncoderBytes(&out, &cborHandle).Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func (a *authenticator) coseKey(t *testing.T) []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	a.key.X.FillBytes(x)
+	a.key.Y.FillBytes(y)
+	return encodeCBOR(t, map[int64]interface{}{1: 2, 3: AlgES256, -1: 1, -2: x, -3: y})
+}
+
+func (a *authenticator) authData(rpID string, flags byte, attested []byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := append([]byte(nil), rpIDHash[:]...)
+	data = append(data, flags)
+	var count [4]byte
+	binary.BigEndian.PutUint32(count[:], a.signCount)
+	data = append(data, count[:]...)
+	return append(data, attested...)
+}
+
+func clientDataJSON(t *testing.T, typ string, challenge string, origin string) []byte {
+	data, err := json.Marshal(clientData{Type: typ, Challenge: challenge, Origin: origin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// create 模拟navigator.credentials.create()
+func (a *authenticator) create(t *testing.T, rpID string, flags byte, challenge string, origin string) ([]byte, []byte) {
+	attested := make([]byte, 18) // aaguid + credentialIdLength
+	binary.BigEndian.PutUint16(attested[16:], uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, a.coseKey(t)...)
+	obj := attestationObject{Fmt: "none", AttStmt: map[string]interface{}{}, AuthData: a.authData(rpID, flags|flagAttested, attested)}
+	return clientDataJSON(t, TypeCreate, challenge, origin), encodeCBOR(t, &obj)
+}
+
+// get 模拟navigator.credentials.get(), 每次调用计数加一
+func (a *authenticator) get(t *testing.T, rpID string, flags byte, challenge string, origin string) ([]byte, []byte, []byte) {
+	a.signCount++
+	cd := clientDataJSON(t, TypeGet, challenge, origin)
+	authData := a.authData(rpID, flags, nil)
+	clientDataHash := sha256.Sum256(cd)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cd, authData, signature
+}
+
+func newRelyingParty() *RelyingParty {
+	return &RelyingParty{ID: testRPID, Origins: []string{testOrigin}}
+}
+
+func newTestChallenge(t *testing.T) string {
+	challenge, err := NewChallenge()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return challenge
+}
+
+func TestRegisterAndLogin(t *testing.T) {
+	rp := newRelyingParty()
+	auth := newAuthenticator(t)
+
+	challenge := newTestChallenge(t)
+	cd, attestation := auth.create(t, testRPID, flagUserPresent|flagUserVerified, challenge, testOrigin)
+	reg, err := rp.ParseRegistration(cd, attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reg.Challenge != challenge {
+		t.Fatalf("challenge %q, expected %q", reg.Challenge, challenge)
+	}
+	if string(reg.CredentialID) != string(auth.credentialID) {
+		t.Fatal("credential id mismatch")
+	}
+	if !reg.UserVerified {
+		t.Fatal("user verified flag lost")
+	}
+
+	stored := reg.SignCount
+	for i := 0; i < 2; i++ {
+		challenge := newTestChallenge(t)
+		cd, authData, signature := auth.get(t, testRPID, flagUserPresent, challenge, testOrigin)
+		assertion, err := rp.ParseAssertion(cd, authData)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if assertion.Challenge != challenge {
+			t.Fatalf("challenge %q, expected %q", assertion.Challenge, challenge)
+		}
+		if assertion.UserVerified {
+			t.Fatal("user verified without flag")
+		}
+		if err := VerifySignature(reg.PublicKey, authData, cd, signature); err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckSignCount(stored, assertion.SignCount); err != nil {
+			t.Fatal(err)
+		}
+		stored = assertion.SignCount
+	}
+
+	// 签名必须覆盖clientDataJSON, 替换挑战后签名失效
+	cd, authData, signature := auth.get(t, testRPID, flagUserPresent, newTestChallenge(t), testOrigin)
+	forged := clientDataJSON(t, TypeGet, newTestChallenge(t), testOrigin)
+	if err := VerifySignature(reg.PublicKey, authData, forged, signature); err == nil {
+		t.Fatal("signature over other client data accepted")
+	}
+	if err := VerifySignature(reg.PublicKey, authData, cd, signature); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRejectAssertion(t *testing.T) {
+	rp := newRelyingParty()
+	auth := newAuthenticator(t)
+	cases := []struct {
+		name   string
+		rpID   string
+		flags  byte
+		origin string
+		want   string
+	}{
+		{name: "rpIdHash", rpID: "evil.com", flags: flagUserPresent, origin: testOrigin, want: "rp id hash mismatch"},
+		{name: "origin", rpID: testRPID, flags: flagUserPresent, origin: "https://evil.com", want: "not allowed"},
+		{name: "userPresent", rpID: testRPID, flags: flagUserVerified, origin: testOrigin, want: "user not present"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cd, attestation := auth.create(t, c.rpID, c.flags, newTestChallenge(t), c.origin)
+			if _, err := rp.ParseRegistration(cd, attestation); err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("registration error %v, expected %q", err, c.want)
+			}
+			cd, authData, _ := auth.get(t, c.rpID, c.flags, newTestChallenge(t), c.origin)
+			if _, err := rp.ParseAssertion(cd, authData); err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("assertion error %v, expected %q", err, c.want)
+			}
+		})
+	}
+
+	// 注册数据不能用于登录, 反之亦然
+	cd, attestation := auth.create(t, testRPID, flagUserPresent, newTestChallenge(t), testOrigin)
+	if _, err := rp.ParseAssertion(cd, attestation); err == nil {
+		t.Fatal("create client data accepted as assertion")
+	}
+}
+
+func TestSignCountRegression(t *testing.T) {
+	rp := newRelyingParty()
+	auth := newAuthenticator(t)
+	auth.signCount = 9
+	cd, authData, _ := auth.get(t, testRPID, flagUserPresent, newTestChallenge(t), testOrigin)
+	assertion, err := rp.ParseAssertion(cd, authData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if assertion.SignCount != 10 {
+		t.Fatalf("sign count %d, expected 10", assertion.SignCount)
+	}
+	cases := []struct {
+		stored   uint32
+		received uint32
+		ok       bool
+	}{
+		{stored: 0, received: 0, ok: true},
+		{stored: 9, received: assertion.SignCount, ok: true},
+		{stored: 10, received: assertion.SignCount, ok: false},
+		{stored: 11, received: assertion.SignCount, ok: false},
+		{stored: 5, received: 0, ok: false},
+	}
+	for _, c := range cases {
+		if err := CheckSignCount(c.stored, c.received); (err == nil) != c.ok {
+			t.Errorf("CheckSignCount(%d, %d) = %v, expected ok %v", c.stored, c.received, err, c.ok)
+		}
+	}
+}
@@ -30,4 +30,22 @@ var (
 	ErrInvitationNotFound       = errs.NewCodeError(20011, "InvitationNotFound")       // 邀请码不存在
 	ErrForbidden                = errs.NewCodeError(20012, "Forbidden")                // 限制登录注册
 	ErrRefuseFriend             = errs.NewCodeError(20013, "RefuseFriend")             // 拒绝添加好友
+	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")     // 邮箱已经注册
+	ErrTOTPNotMatch             = errs.NewCodeError(20015, "TOTPNotMatch")             // 二次验证码错误
+	ErrLoginChallengeExpired    = errs.NewCodeError(20016, "LoginChallengeExpired")    // 二次验证凭证过期
+	ErrAccountLocked            = errs.NewCodeError(20017, "AccountLocked")            // 登录失败次数过多, 账号已锁定
+	ErrPasskeyVerifyFailed      = errs.NewCodeError(20018, "PasskeyVerifyFailed")      // 通行密钥验证失败
+	ErrQRLoginExpired           = errs.NewCodeError(20019, "QRLoginExpired")           // 登录二维码已过期
+	ErrLastLoginIdentifier      = errs.NewCodeError(20020, "LastLoginIdentifier")      // 不能解绑最后一个登录方式
+	ErrPasswordTooShort         = errs.NewCodeError(20021, "PasswordTooShort")         // 密码长度不足
+	ErrPasswordTooSimple        = errs.NewCodeError(20022, "PasswordTooSimple")        // 密码字符种类不足
+	ErrPasswordTooCommon        = errs.NewCodeError(20023, "PasswordTooCommon")        // 密码过于常见或已泄露
+	ErrPasswordReused           = errs.NewCodeError(20024, "PasswordReused")           // 与最近使用过的密码相同
+	ErrCaptchaRequired          = errs.NewCodeError(20025, "CaptchaRequired")          // 需要完成人机验证
+	ErrCaptchaNotMatch          = errs.NewCodeError(20026, "CaptchaNotMatch")          // 人机验证答案错误或已过期
+	ErrVerifyCodeRateLimited    = errs.NewCodeError(20027, "VerifyCodeRateLimited")    // 发送验证码超出限流, 需等待后重试
+	ErrAdminDisabled            = errs.NewCodeError(20028, "AdminDisabled")            // 管理员账号已禁用
+	ErrAdminIPNotAllowed        = errs.NewCodeError(20029, "AdminIPNotAllowed")        // ip不在管理后台白名单中
+	ErrQRLoginRateLimited       = errs.NewCodeError(20030, "QRLoginRateLimited")       // 生成登录二维码过于频繁
+	ErrVerifyChannelDisabled    = errs.NewCodeError(20031, "VerifyChannelDisabled")    // 该渠道未配置验证码服务
 )
@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func New() (Mail, error) {
+	switch strings.ToLower(config.Config.VerifyCode.Mail.Use) {
+	case "":
+		return empty{}, nil
+	case "smtp":
+		return newSMTP()
+	default:
+		return nil, fmt.Errorf("not support mail: `%s`", config.Config.VerifyCode.Mail.Use)
+	}
+}
+
+type Mail interface {
+	Name() string
+	SendCode(ctx context.Context, mail string, verifyCode string, usedFor int32, language string) error
+}
+
+type empty struct{}
+
+func (e empty) Name() string {
+	return "empty-mail"
+}
+
+func (e empty) SendCode(ctx context.Context, mail string, verifyCode string, usedFor int32, language string) error {
+	return nil
+}
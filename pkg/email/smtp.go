@@ -0,0 +1,192 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+// smtpsPort is the port on which the server expects an implicit TLS connection.
+const smtpsPort = 465
+
+func newSMTP() (Mail, error) {
+	conf := config.Config.VerifyCode.Mail
+	if conf.SmtpAddr == "" || conf.SmtpPort == 0 {
+		return nil, errors.New("smtp address or port is empty")
+	}
+	if conf.SenderMail == "" {
+		return nil, errors.New("smtp sender mail is empty")
+	}
+	bodies := make([]*template.Template, len(conf.Templates))
+	for i, tpl := range conf.Templates {
+		if tpl.Body == "" {
+			continue
+		}
+		t, err := template.New("mail body").Parse(tpl.Body)
+		if err != nil {
+			return nil, errs.Wrap(err, "mail body template is invalid")
+		}
+		bodies[i] = t
+	}
+	return &smtpMail{
+		host:      conf.SmtpAddr,
+		addr:      net.JoinHostPort(conf.SmtpAddr, strconv.Itoa(conf.SmtpPort)),
+		implicit:  conf.SmtpPort == smtpsPort,
+		sender:    conf.SenderMail,
+		password:  conf.SenderAuthorizationCode,
+		title:     conf.Title,
+		templates: conf.Templates,
+		bodies:    bodies,
+	}, nil
+}
+
+type smtpMail struct {
+	host     string
+	addr     string
+	implicit bool
+	sender   string
+	password string
+	title    string
+	// templates 按用途和语言选择标题和内容, bodies与其一一对应, 未配置body时为nil
+	templates config.VerifyCodeTemplates
+	bodies    []*template.Template
+}
+
+func (s *smtpMail) Name() string {
+	return "smtp-mail"
+}
+
+func (s *smtpMail) SendCode(ctx context.Context, mail string, verifyCode string, usedFor int32, language string) error {
+	msg, err := s.message(mail, verifyCode, usedFor, language)
+	if err != nil {
+		return err
+	}
+	return errs.Wrap(s.send(ctx, mail, msg))
+}
+
+func (s *smtpMail) message(mail string, verifyCode string, usedFor int32, language string) ([]byte, error) {
+	title := s.title
+	content := fmt.Sprintf("Your verification code is: %s\r\n", verifyCode)
+	if i := s.templates.Match(usedFor, language); i >= 0 {
+		if s.templates[i].Title != "" {
+			title = s.templates[i].Title
+		}
+		if s.bodies[i] != nil {
+			var sb strings.Builder
+			data := &struct {
+				Email    string
+				Code     string
+				UsedFor  int32
+				Language string
+			}{Email: mail, Code: verifyCode, UsedFor: usedFor, Language: language}
+			if err := s.bodies[i].Execute(&sb, data); err != nil {
+				return nil, errs.Wrap(err)
+			}
+			content = sb.String()
+		}
+	}
+	if title == "" {
+		title = "Verification Code"
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", s.sender)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", title))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(content)
+	return buf.Bytes(), nil
+}
+
+func (s *smtpMail) dial(ctx context.Context) (*smtp.Client, error) {
+	var dialer net.Dialer
+	var (
+		conn net.Conn
+		err  error
+	)
+	if s.implicit {
+		conn, err = (&tls.Dialer{NetDialer: &dialer, Config: &tls.Config{ServerName: s.host}}).DialContext(ctx, "tcp", s.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", s.addr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+func (s *smtpMail) send(ctx context.Context, mail string, msg []byte) error {
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if !s.implicit {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+				return err
+			}
+		}
+	}
+	if s.password != "" {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err := client.Auth(smtp.PlainAuth("", s.sender, s.password, s.host)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := client.Mail(s.sender); err != nil {
+		return err
+	}
+	if err := client.Rcpt(mail); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
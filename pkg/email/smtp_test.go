@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+// smtpSink 只实现发送验证码需要的命令, 不支持STARTTLS和AUTH.
+type smtpSink struct {
+	ln   net.Listener
+	from string
+	rcpt []string
+	data string
+	done chan struct{}
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpSink{ln: ln, done: make(chan struct{})}
+	t.Cleanup(func() { ln.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *smtpSink) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpSink) serve() {
+	defer close(s.done)
+	conn, err := s.ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 sink ESMTP")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			_ = tp.PrintfLine("250 sink")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+			_ = tp.PrintfLine("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			s.rcpt = append(s.rcpt, strings.Trim(line[len("RCPT TO:"):], "<> "))
+			_ = tp.PrintfLine("250 OK")
+		case cmd == "DATA":
+			_ = tp.PrintfLine("354 end with .")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			s.data = string(data)
+			_ = tp.PrintfLine("250 OK")
+		case cmd == "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPSendCode(t *testing.T) {
+	sink := newSMTPSink(t)
+	conf := &config.Config.VerifyCode.Mail
+	conf.Use = "smtp"
+	conf.SmtpAddr = "127.0.0.1"
+	conf.SmtpPort = sink.port()
+	conf.SenderMail = "noreply@example.com"
+	conf.Title = "默认标题"
+	conf.Templates = config.VerifyCodeTemplates{{UsedFor: []int32{1}, Title: "Register", Body: "code {{.Code}} for {{.Email}}"}}
+
+	mail, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := mail.SendCode(ctx, "user@example.com", "123456", 1, ""); err != nil {
+		t.Fatal(err)
+	}
+	<-sink.done
+
+	if sink.from != conf.SenderMail {
+		t.Errorf("from = %q", sink.from)
+	}
+	if len(sink.rcpt) != 1 || sink.rcpt[0] != "user@example.com" {
+		t.Errorf("rcpt = %v", sink.rcpt)
+	}
+	msg, err := textproto.NewReader(bufio.NewReader(strings.NewReader(sink.data))).ReadMIMEHeader()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Get("Subject") != "Register" {
+		t.Errorf("subject = %q", msg.Get("Subject"))
+	}
+	if !strings.Contains(sink.data, "code 123456 for user@example.com") {
+		t.Errorf("body = %q", sink.data)
+	}
+}
+
+func TestSMTPRejected(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tp := textproto.NewConn(conn)
+		_ = tp.PrintfLine("220 sink ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			if strings.HasPrefix(strings.ToUpper(line), "RCPT") {
+				_ = tp.PrintfLine("550 no such user")
+				continue
+			}
+			_ = tp.PrintfLine("250 OK")
+		}
+	}()
+	s := &smtpMail{
+		host:   "127.0.0.1",
+		addr:   net.JoinHostPort("127.0.0.1", strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)),
+		sender: "noreply@example.com",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.SendCode(ctx, "nobody@example.com", "123456", 1, ""); err == nil {
+		t.Fatal("expected error for rejected recipient")
+	}
+}
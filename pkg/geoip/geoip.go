@@ -0,0 +1,92 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geoip 查询ip所在国家.
+package geoip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const (
+	defaultField    = "countryCode"
+	maxResponseBody = 64 * 1024
+)
+
+var httpClient = &http.Client{Timeout: 5 * time.Second}
+
+func New() Locator {
+	conf := config.Config.AdminLogin.GeoIP
+	if conf.Url == "" {
+		return empty{}
+	}
+	field := conf.Field
+	if field == "" {
+		field = defaultField
+	}
+	return &httpLocator{url: conf.Url, field: field}
+}
+
+type Locator interface {
+	// Country 返回国家代码, 无法判断时返回空
+	Country(ctx context.Context, ip string) (string, error)
+}
+
+type empty struct{}
+
+func (empty) Country(ctx context.Context, ip string) (string, error) {
+	return "", nil
+}
+
+// httpLocator 请求url(其中{ip}替换为查询的ip), 从返回的JSON中取field字段.
+type httpLocator struct {
+	url   string
+	field string
+}
+
+func (h *httpLocator) Country(ctx context.Context, ip string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.ReplaceAll(h.url, "{ip}", url.PathEscape(ip)), nil)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if resp.StatusCode/100 != 2 {
+		return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("geoip http status %d: %s", resp.StatusCode, string(body)))
+	}
+	var res map[string]any
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errs.Wrap(err, "geoip response is not json")
+	}
+	country, _ := res[h.field].(string)
+	return strings.ToUpper(country), nil
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+// 事件类型.
+const (
+	EventAdminLoginAnomaly = "admin_login_anomaly"
+)
+
+func New() (Notifier, error) {
+	switch strings.ToLower(config.Config.AdminLogin.Alert.Use) {
+	case "":
+		return logger{}, nil
+	case "webhook":
+		return newWebhook()
+	default:
+		return nil, fmt.Errorf("not support notifier: `%s`", config.Config.AdminLogin.Alert.Use)
+	}
+}
+
+// Event 提醒内容, Data为事件相关的字段.
+type Event struct {
+	Type    string         `json:"type"`
+	Title   string         `json:"title"`
+	Content string         `json:"content"`
+	Data    map[string]any `json:"data"`
+	Time    int64          `json:"time"`
+}
+
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, event *Event) error
+}
+
+type logger struct{}
+
+func (logger) Name() string {
+	return "log"
+}
+
+func (logger) Notify(ctx context.Context, event *Event) error {
+	log.ZWarn(ctx, "notify "+event.Type, nil, "title", event.Title, "content", event.Content, "data", event.Data)
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const maxResponseBody = 64 * 1024
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+func newWebhook() (Notifier, error) {
+	conf := config.Config.AdminLogin.Alert.Webhook
+	if conf.Url == "" {
+		return nil, errs.ErrArgs.Wrap("alert webhook url is empty")
+	}
+	return &webhook{url: conf.Url, headers: conf.Headers}, nil
+}
+
+// webhook 以JSON格式POST事件.
+type webhook struct {
+	url     string
+	headers map[string]string
+}
+
+func (w *webhook) Name() string {
+	return "webhook"
+}
+
+func (w *webhook) Notify(ctx context.Context, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if resp.StatusCode/100 != 2 {
+		return errs.ErrInternalServer.Wrap(fmt.Sprintf("notify webhook http status %d: %s", resp.StatusCode, string(body)))
+	}
+	return nil
+}
@@ -30,10 +30,30 @@ func (x *LoginReq) Check() error {
 	return nil
 }
 
+func (x *ConfirmTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *VerifyTOTPLoginReq) Check() error {
+	if x.ChallengeToken == "" {
+		return errs.ErrArgs.Wrap("challengeToken is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
 func (x *ChangePasswordReq) Check() error {
 	if x.Password == "" {
 		return errs.ErrArgs.Wrap("password is empty")
 	}
+	if x.CurrentPassword == "" {
+		return errs.ErrArgs.Wrap("currentPassword is empty")
+	}
 	return nil
 }
 
@@ -236,6 +256,9 @@ func (x *BlockUserReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.Wrap("userID is empty")
 	}
+	if x.Days < 0 || x.Days > 3650 {
+		return errs.ErrArgs.Wrap("days is invalid")
+	}
 	return nil
 }
 
@@ -266,6 +289,19 @@ func (x *FindUserBlockInfoReq) Check() error {
 	return nil
 }
 
+func (x *SearchBlockHistoryReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
 func (x *CreateTokenReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.Wrap("userID is empty")
@@ -344,3 +380,152 @@ func (x *SetClientConfigReq) Check() error {
 	}
 	return nil
 }
+
+func (x *GetUserSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeUserSessionReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	if x.SessionID == "" {
+		return errs.ErrArgs.Wrap("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errs.ErrArgs.Wrap("refreshToken is empty")
+	}
+	return nil
+}
+
+func (x *CheckPermissionReq) Check() error {
+	if x.Permission == "" {
+		return errs.ErrArgs.Wrap("permission is empty")
+	}
+	return nil
+}
+
+func (x *SearchAdminReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *SetAdminLevelReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.Wrap("account is empty")
+	}
+	return nil
+}
+
+func (x *AddAdminAccountReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.Wrap("account is empty")
+	}
+	if x.Password == "" {
+		return errs.ErrArgs.Wrap("password is empty")
+	}
+	if x.ImUserID == "" {
+		return errs.ErrArgs.Wrap("imUserID is empty")
+	}
+	return nil
+}
+
+func (x *DelAdminAccountReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.Wrap("account is empty")
+	}
+	return nil
+}
+
+func (x *SetAdminStatusReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.Wrap("account is empty")
+	}
+	return nil
+}
+
+func (x *BindIMAdminReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.Wrap("account is empty")
+	}
+	if x.ImUserID == "" {
+		return errs.ErrArgs.Wrap("imUserID is empty")
+	}
+	return nil
+}
+
+func (x *GetIMAdminReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *AddAuditLogReq) Check() error {
+	if x.Action == "" {
+		return errs.ErrArgs.Wrap("action is empty")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *AddAdminIPAllowReq) Check() error {
+	if x.Cidr == "" {
+		return errs.ErrArgs.Wrap("cidr is empty")
+	}
+	return nil
+}
+
+func (x *DelAdminIPAllowReq) Check() error {
+	if len(x.Cidrs) == 0 {
+		return errs.ErrArgs.Wrap("cidrs is empty")
+	}
+	return nil
+}
+
+func (x *SearchAdminIPAllowReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *CheckAdminIPReq) Check() error {
+	if x.Ip == "" {
+		return errs.ErrArgs.Wrap("ip is empty")
+	}
+	return nil
+}
@@ -49,6 +49,7 @@ type LoginReq struct {
 
 	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
 	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	Ip       string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
 func (x *LoginReq) Reset() {
@@ -97,17 +98,26 @@ func (x *LoginReq) GetPassword() string {
 	return ""
 }
 
+func (x *LoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
 type LoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AdminAccount string `protobuf:"bytes,1,opt,name=adminAccount,proto3" json:"adminAccount"`
-	AdminToken   string `protobuf:"bytes,2,opt,name=adminToken,proto3" json:"adminToken"`
-	Nickname     string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
-	FaceURL      string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
-	Level        int32  `protobuf:"varint,5,opt,name=level,proto3" json:"level"`
-	AdminUserID  string `protobuf:"bytes,6,opt,name=adminUserID,proto3" json:"adminUserID"`
+	AdminAccount   string `protobuf:"bytes,1,opt,name=adminAccount,proto3" json:"adminAccount"`
+	AdminToken     string `protobuf:"bytes,2,opt,name=adminToken,proto3" json:"adminToken"`
+	Nickname       string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL        string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
+	Level          int32  `protobuf:"varint,5,opt,name=level,proto3" json:"level"`
+	AdminUserID    string `protobuf:"bytes,6,opt,name=adminUserID,proto3" json:"adminUserID"`
+	ChallengeToken string `protobuf:"bytes,7,opt,name=challengeToken,proto3" json:"challengeToken"` // 开启二次验证时返回, 需调用VerifyTOTPLogin换取adminToken
+	RefreshToken   string `protobuf:"bytes,8,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
 func (x *LoginResp) Reset() {
@@ -184,20 +194,28 @@ func (x *LoginResp) GetAdminUserID() string {
 	return ""
 }
 
-type AdminUpdateInfoReq struct {
+func (x *LoginResp) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type EnrollTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Account  *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
-	Password *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
-	FaceURL  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
-	Nickname *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
-	Level    *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=level,proto3" json:"level"`
 }
 
-func (x *AdminUpdateInfoReq) Reset() {
-	*x = AdminUpdateInfoReq{}
+func (x *EnrollTOTPReq) Reset() {
+	*x = EnrollTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -205,13 +223,13 @@ func (x *AdminUpdateInfoReq) Reset() {
 	}
 }
 
-func (x *AdminUpdateInfoReq) String() string {
+func (x *EnrollTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateInfoReq) ProtoMessage() {}
+func (*EnrollTOTPReq) ProtoMessage() {}
 
-func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
+func (x *EnrollTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,58 +241,22 @@ func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateInfoReq.ProtoReflect.Descriptor instead.
-func (*AdminUpdateInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollTOTPReq.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AdminUpdateInfoReq) GetAccount() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetPassword() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Password
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetFaceURL() *wrapperspb.StringValue {
-	if x != nil {
-		return x.FaceURL
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetNickname() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Nickname
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetLevel() *wrapperspb.Int32Value {
-	if x != nil {
-		return x.Level
-	}
-	return nil
-}
-
-type AdminUpdateInfoResp struct {
+type EnrollTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
-	FaceURL  string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret"`
+	Url    string `protobuf:"bytes,2,opt,name=url,proto3" json:"url"`
 }
 
-func (x *AdminUpdateInfoResp) Reset() {
-	*x = AdminUpdateInfoResp{}
+func (x *EnrollTOTPResp) Reset() {
+	*x = EnrollTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -282,13 +264,13 @@ func (x *AdminUpdateInfoResp) Reset() {
 	}
 }
 
-func (x *AdminUpdateInfoResp) String() string {
+func (x *EnrollTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateInfoResp) ProtoMessage() {}
+func (*EnrollTOTPResp) ProtoMessage() {}
 
-func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
+func (x *EnrollTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -300,42 +282,35 @@ func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateInfoResp.ProtoReflect.Descriptor instead.
-func (*AdminUpdateInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollTOTPResp.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AdminUpdateInfoResp) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *AdminUpdateInfoResp) GetNickname() string {
+func (x *EnrollTOTPResp) GetSecret() string {
 	if x != nil {
-		return x.Nickname
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *AdminUpdateInfoResp) GetFaceURL() string {
+func (x *EnrollTOTPResp) GetUrl() string {
 	if x != nil {
-		return x.FaceURL
+		return x.Url
 	}
 	return ""
 }
 
-type ChangePasswordReq struct {
+type ConfirmTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
 }
 
-func (x *ChangePasswordReq) Reset() {
-	*x = ChangePasswordReq{}
+func (x *ConfirmTOTPReq) Reset() {
+	*x = ConfirmTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +318,13 @@ func (x *ChangePasswordReq) Reset() {
 	}
 }
 
-func (x *ChangePasswordReq) String() string {
+func (x *ConfirmTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordReq) ProtoMessage() {}
+func (*ConfirmTOTPReq) ProtoMessage() {}
 
-func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,26 +336,28 @@ func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTOTPReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChangePasswordReq) GetPassword() string {
+func (x *ConfirmTOTPReq) GetCode() string {
 	if x != nil {
-		return x.Password
+		return x.Code
 	}
 	return ""
 }
 
-type ChangePasswordResp struct {
+type ConfirmTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes"`
 }
 
-func (x *ChangePasswordResp) Reset() {
-	*x = ChangePasswordResp{}
+func (x *ConfirmTOTPResp) Reset() {
+	*x = ConfirmTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -388,13 +365,13 @@ func (x *ChangePasswordResp) Reset() {
 	}
 }
 
-func (x *ChangePasswordResp) String() string {
+func (x *ConfirmTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResp) ProtoMessage() {}
+func (*ConfirmTOTPResp) ProtoMessage() {}
 
-func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -406,19 +383,28 @@ func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTOTPResp.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{5}
 }
 
-type GetAdminInfoReq struct {
+func (x *ConfirmTOTPResp) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
 }
 
-func (x *GetAdminInfoReq) Reset() {
-	*x = GetAdminInfoReq{}
+func (x *DisableTOTPReq) Reset() {
+	*x = DisableTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,13 +412,13 @@ func (x *GetAdminInfoReq) Reset() {
 	}
 }
 
-func (x *GetAdminInfoReq) String() string {
+func (x *DisableTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminInfoReq) ProtoMessage() {}
+func (*DisableTOTPReq) ProtoMessage() {}
 
-func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
+func (x *DisableTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -444,27 +430,26 @@ func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminInfoReq.ProtoReflect.Descriptor instead.
-func (*GetAdminInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTOTPReq.ProtoReflect.Descriptor instead.
+func (*DisableTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{6}
 }
 
-type GetAdminInfoResp struct {
+func (x *DisableTOTPReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Account    string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
-	Password   string `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
-	FaceURL    string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
-	Nickname   string `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname"`
-	UserID     string `protobuf:"bytes,6,opt,name=userID,proto3" json:"userID"`
-	Level      int32  `protobuf:"varint,7,opt,name=level,proto3" json:"level"`
-	CreateTime int64  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *GetAdminInfoResp) Reset() {
-	*x = GetAdminInfoResp{}
+func (x *DisableTOTPResp) Reset() {
+	*x = DisableTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,13 +457,13 @@ func (x *GetAdminInfoResp) Reset() {
 	}
 }
 
-func (x *GetAdminInfoResp) String() string {
+func (x *DisableTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminInfoResp) ProtoMessage() {}
+func (*DisableTOTPResp) ProtoMessage() {}
 
-func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
+func (x *DisableTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -490,70 +475,23 @@ func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminInfoResp.ProtoReflect.Descriptor instead.
-func (*GetAdminInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTOTPResp.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetAdminInfoResp) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetFaceURL() string {
-	if x != nil {
-		return x.FaceURL
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetLevel() int32 {
-	if x != nil {
-		return x.Level
-	}
-	return 0
-}
-
-func (x *GetAdminInfoResp) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-type AddDefaultFriendReq struct {
+type VerifyTOTPLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Ip             string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *AddDefaultFriendReq) Reset() {
-	*x = AddDefaultFriendReq{}
+func (x *VerifyTOTPLoginReq) Reset() {
+	*x = VerifyTOTPLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,13 +499,13 @@ func (x *AddDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *AddDefaultFriendReq) String() string {
+func (x *VerifyTOTPLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultFriendReq) ProtoMessage() {}
+func (*VerifyTOTPLoginReq) ProtoMessage() {}
 
-func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *VerifyTOTPLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -579,26 +517,46 @@ func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*AddDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyTOTPLoginReq.ProtoReflect.Descriptor instead.
+func (*VerifyTOTPLoginReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AddDefaultFriendReq) GetUserIDs() []string {
+func (x *VerifyTOTPLoginReq) GetChallengeToken() string {
 	if x != nil {
-		return x.UserIDs
+		return x.ChallengeToken
 	}
-	return nil
+	return ""
 }
 
-type AddDefaultFriendResp struct {
+func (x *VerifyTOTPLoginReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyTOTPLoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type AdminUpdateInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account  *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Password *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	FaceURL  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
+	Level    *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=level,proto3" json:"level"`
 }
 
-func (x *AddDefaultFriendResp) Reset() {
-	*x = AddDefaultFriendResp{}
+func (x *AdminUpdateInfoReq) Reset() {
+	*x = AdminUpdateInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,13 +564,13 @@ func (x *AddDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *AddDefaultFriendResp) String() string {
+func (x *AdminUpdateInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultFriendResp) ProtoMessage() {}
+func (*AdminUpdateInfoReq) ProtoMessage() {}
 
-func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -624,35 +582,72 @@ func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*AddDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUpdateInfoReq.ProtoReflect.Descriptor instead.
+func (*AdminUpdateInfoReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{9}
 }
 
-type DelDefaultFriendReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+func (x *AdminUpdateInfoReq) GetAccount() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Account
+	}
+	return nil
 }
 
-func (x *DelDefaultFriendReq) Reset() {
-	*x = DelDefaultFriendReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AdminUpdateInfoReq) GetPassword() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Password
 	}
+	return nil
 }
 
-func (x *DelDefaultFriendReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AdminUpdateInfoReq) GetFaceURL() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FaceURL
+	}
+	return nil
 }
 
-func (*DelDefaultFriendReq) ProtoMessage() {}
+func (x *AdminUpdateInfoReq) GetNickname() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
 
-func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *AdminUpdateInfoReq) GetLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+type AdminUpdateInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL  string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+}
+
+func (x *AdminUpdateInfoResp) Reset() {
+	*x = AdminUpdateInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminUpdateInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateInfoResp) ProtoMessage() {}
+
+func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,26 +659,45 @@ func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*DelDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUpdateInfoResp.ProtoReflect.Descriptor instead.
+func (*AdminUpdateInfoResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DelDefaultFriendReq) GetUserIDs() []string {
+func (x *AdminUpdateInfoResp) GetUserID() string {
 	if x != nil {
-		return x.UserIDs
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type DelDefaultFriendResp struct {
+func (x *AdminUpdateInfoResp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminUpdateInfoResp) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+type ChangePasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Password        string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"`
+	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
+	TotpCode        string `protobuf:"bytes,3,opt,name=totpCode,proto3" json:"totpCode"` // 开启二次验证时必填, 动态码或恢复码
+	Token           string `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`       // 当前请求的token, 修改后保留, 其他token失效
 }
 
-func (x *DelDefaultFriendResp) Reset() {
-	*x = DelDefaultFriendResp{}
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,13 +705,13 @@ func (x *DelDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *DelDefaultFriendResp) String() string {
+func (x *ChangePasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultFriendResp) ProtoMessage() {}
+func (*ChangePasswordReq) ProtoMessage() {}
 
-func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,19 +723,47 @@ func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*DelDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{11}
 }
 
-type FindDefaultFriendReq struct {
+func (x *ChangePasswordReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *FindDefaultFriendReq) Reset() {
-	*x = FindDefaultFriendReq{}
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -729,13 +771,13 @@ func (x *FindDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *FindDefaultFriendReq) String() string {
+func (x *ChangePasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultFriendReq) ProtoMessage() {}
+func (*ChangePasswordResp) ProtoMessage() {}
 
-func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -747,21 +789,19 @@ func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*FindDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{12}
 }
 
-type FindDefaultFriendResp struct {
+type GetAdminInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *FindDefaultFriendResp) Reset() {
-	*x = FindDefaultFriendResp{}
+func (x *GetAdminInfoReq) Reset() {
+	*x = GetAdminInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -769,13 +809,13 @@ func (x *FindDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *FindDefaultFriendResp) String() string {
+func (x *GetAdminInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultFriendResp) ProtoMessage() {}
+func (*GetAdminInfoReq) ProtoMessage() {}
 
-func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,29 +827,28 @@ func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*FindDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdminInfoReq.ProtoReflect.Descriptor instead.
+func (*GetAdminInfoReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *FindDefaultFriendResp) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type SearchDefaultFriendReq struct {
+type GetAdminInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Account     string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	Password    string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	FaceURL     string   `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname    string   `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname"`
+	UserID      string   `protobuf:"bytes,6,opt,name=userID,proto3" json:"userID"`
+	Level       int32    `protobuf:"varint,7,opt,name=level,proto3" json:"level"`
+	CreateTime  int64    `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	Permissions []string `protobuf:"bytes,9,rep,name=permissions,proto3" json:"permissions"`
 }
 
-func (x *SearchDefaultFriendReq) Reset() {
-	*x = SearchDefaultFriendReq{}
+func (x *GetAdminInfoResp) Reset() {
+	*x = GetAdminInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -817,13 +856,13 @@ func (x *SearchDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *SearchDefaultFriendReq) String() string {
+func (x *GetAdminInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultFriendReq) ProtoMessage() {}
+func (*GetAdminInfoResp) ProtoMessage() {}
 
-func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -835,37 +874,78 @@ func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*SearchDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdminInfoResp.ProtoReflect.Descriptor instead.
+func (*GetAdminInfoResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SearchDefaultFriendReq) GetKeyword() string {
+func (x *GetAdminInfoResp) GetAccount() string {
 	if x != nil {
-		return x.Keyword
+		return x.Account
 	}
 	return ""
 }
 
-func (x *SearchDefaultFriendReq) GetPagination() *sdkws.RequestPagination {
+func (x *GetAdminInfoResp) GetPassword() string {
 	if x != nil {
-		return x.Pagination
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetAdminInfoResp) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GetAdminInfoResp) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type DefaultFriendAttribute struct {
+// ################### 管理员角色 ###################
+type CheckPermissionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	CreateTime int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	User       *common.UserPublicInfo `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	Permission string `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
 }
 
-func (x *DefaultFriendAttribute) Reset() {
-	*x = DefaultFriendAttribute{}
+func (x *CheckPermissionReq) Reset() {
+	*x = CheckPermissionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -873,13 +953,13 @@ func (x *DefaultFriendAttribute) Reset() {
 	}
 }
 
-func (x *DefaultFriendAttribute) String() string {
+func (x *CheckPermissionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultFriendAttribute) ProtoMessage() {}
+func (*CheckPermissionReq) ProtoMessage() {}
 
-func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
+func (x *CheckPermissionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -891,43 +971,26 @@ func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultFriendAttribute.ProtoReflect.Descriptor instead.
-func (*DefaultFriendAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckPermissionReq.ProtoReflect.Descriptor instead.
+func (*CheckPermissionReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *DefaultFriendAttribute) GetUserID() string {
+func (x *CheckPermissionReq) GetPermission() string {
 	if x != nil {
-		return x.UserID
+		return x.Permission
 	}
 	return ""
 }
 
-func (x *DefaultFriendAttribute) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-func (x *DefaultFriendAttribute) GetUser() *common.UserPublicInfo {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-type SearchDefaultFriendResp struct {
+type CheckPermissionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Total uint32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*DefaultFriendAttribute `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *SearchDefaultFriendResp) Reset() {
-	*x = SearchDefaultFriendResp{}
+func (x *CheckPermissionResp) Reset() {
+	*x = CheckPermissionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -935,13 +998,13 @@ func (x *SearchDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *SearchDefaultFriendResp) String() string {
+func (x *CheckPermissionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultFriendResp) ProtoMessage() {}
+func (*CheckPermissionResp) ProtoMessage() {}
 
-func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *CheckPermissionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -953,35 +1016,23 @@ func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*SearchDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckPermissionResp.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SearchDefaultFriendResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchDefaultFriendResp) GetUsers() []*DefaultFriendAttribute {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-type AddDefaultGroupReq struct {
+type RoleInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Level       int32    `protobuf:"varint,1,opt,name=level,proto3" json:"level"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions"`
 }
 
-func (x *AddDefaultGroupReq) Reset() {
-	*x = AddDefaultGroupReq{}
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -989,13 +1040,13 @@ func (x *AddDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *AddDefaultGroupReq) String() string {
+func (x *RoleInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultGroupReq) ProtoMessage() {}
+func (*RoleInfo) ProtoMessage() {}
 
-func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,26 +1058,40 @@ func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*AddDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AddDefaultGroupReq) GetGroupIDs() []string {
+func (x *RoleInfo) GetLevel() int32 {
 	if x != nil {
-		return x.GroupIDs
+		return x.Level
+	}
+	return 0
+}
+
+func (x *RoleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type AddDefaultGroupResp struct {
+type FindRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddDefaultGroupResp) Reset() {
-	*x = AddDefaultGroupResp{}
+func (x *FindRoleReq) Reset() {
+	*x = FindRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1034,13 +1099,13 @@ func (x *AddDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *AddDefaultGroupResp) String() string {
+func (x *FindRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultGroupResp) ProtoMessage() {}
+func (*FindRoleReq) ProtoMessage() {}
 
-func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *FindRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,21 +1117,21 @@ func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*AddDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindRoleReq.ProtoReflect.Descriptor instead.
+func (*FindRoleReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{18}
 }
 
-type DelDefaultGroupReq struct {
+type FindRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Roles []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles"`
 }
 
-func (x *DelDefaultGroupReq) Reset() {
-	*x = DelDefaultGroupReq{}
+func (x *FindRoleResp) Reset() {
+	*x = FindRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1074,13 +1139,13 @@ func (x *DelDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *DelDefaultGroupReq) String() string {
+func (x *FindRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultGroupReq) ProtoMessage() {}
+func (*FindRoleResp) ProtoMessage() {}
 
-func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *FindRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,26 +1157,35 @@ func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*DelDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindRoleResp.ProtoReflect.Descriptor instead.
+func (*FindRoleResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *DelDefaultGroupReq) GetGroupIDs() []string {
+func (x *FindRoleResp) GetRoles() []*RoleInfo {
 	if x != nil {
-		return x.GroupIDs
+		return x.Roles
 	}
 	return nil
 }
 
-type DelDefaultGroupResp struct {
+type AdminInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account    string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	FaceURL    string `protobuf:"bytes,2,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname   string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	UserID     string `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID"`
+	Level      int32  `protobuf:"varint,5,opt,name=level,proto3" json:"level"`
+	CreateTime int64  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	ImUserID   string `protobuf:"bytes,7,opt,name=imUserID,proto3" json:"imUserID"`
+	Status     int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status"`
 }
 
-func (x *DelDefaultGroupResp) Reset() {
-	*x = DelDefaultGroupResp{}
+func (x *AdminInfo) Reset() {
+	*x = AdminInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1119,13 +1193,13 @@ func (x *DelDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *DelDefaultGroupResp) String() string {
+func (x *AdminInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultGroupResp) ProtoMessage() {}
+func (*AdminInfo) ProtoMessage() {}
 
-func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *AdminInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1137,19 +1211,78 @@ func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*DelDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminInfo.ProtoReflect.Descriptor instead.
+func (*AdminInfo) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{20}
 }
 
-type FindDefaultGroupReq struct {
+func (x *AdminInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *AdminInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *AdminInfo) GetImUserID() string {
+	if x != nil {
+		return x.ImUserID
+	}
+	return ""
+}
+
+func (x *AdminInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type SearchAdminReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *FindDefaultGroupReq) Reset() {
-	*x = FindDefaultGroupReq{}
+func (x *SearchAdminReq) Reset() {
+	*x = SearchAdminReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1157,13 +1290,13 @@ func (x *FindDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *FindDefaultGroupReq) String() string {
+func (x *SearchAdminReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultGroupReq) ProtoMessage() {}
+func (*SearchAdminReq) ProtoMessage() {}
 
-func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1175,21 +1308,36 @@ func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*FindDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminReq.ProtoReflect.Descriptor instead.
+func (*SearchAdminReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{21}
 }
 
-type FindDefaultGroupResp struct {
+func (x *SearchAdminReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAdminReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchAdminResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Total  uint32       `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Admins []*AdminInfo `protobuf:"bytes,2,rep,name=admins,proto3" json:"admins"`
 }
 
-func (x *FindDefaultGroupResp) Reset() {
-	*x = FindDefaultGroupResp{}
+func (x *SearchAdminResp) Reset() {
+	*x = SearchAdminResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1197,13 +1345,13 @@ func (x *FindDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *FindDefaultGroupResp) String() string {
+func (x *SearchAdminResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultGroupResp) ProtoMessage() {}
+func (*SearchAdminResp) ProtoMessage() {}
 
-func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1215,29 +1363,36 @@ func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*FindDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminResp.ProtoReflect.Descriptor instead.
+func (*SearchAdminResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *FindDefaultGroupResp) GetGroupIDs() []string {
+func (x *SearchAdminResp) GetTotal() uint32 {
 	if x != nil {
-		return x.GroupIDs
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAdminResp) GetAdmins() []*AdminInfo {
+	if x != nil {
+		return x.Admins
 	}
 	return nil
 }
 
-type SearchDefaultGroupReq struct {
+type SetAdminLevelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Level   int32  `protobuf:"varint,2,opt,name=level,proto3" json:"level"`
 }
 
-func (x *SearchDefaultGroupReq) Reset() {
-	*x = SearchDefaultGroupReq{}
+func (x *SetAdminLevelReq) Reset() {
+	*x = SetAdminLevelReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,13 +1400,13 @@ func (x *SearchDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *SearchDefaultGroupReq) String() string {
+func (x *SetAdminLevelReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultGroupReq) ProtoMessage() {}
+func (*SetAdminLevelReq) ProtoMessage() {}
 
-func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *SetAdminLevelReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1263,37 +1418,33 @@ func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*SearchDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminLevelReq.ProtoReflect.Descriptor instead.
+func (*SetAdminLevelReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *SearchDefaultGroupReq) GetKeyword() string {
+func (x *SetAdminLevelReq) GetAccount() string {
 	if x != nil {
-		return x.Keyword
+		return x.Account
 	}
 	return ""
 }
 
-func (x *SearchDefaultGroupReq) GetPagination() *sdkws.RequestPagination {
+func (x *SetAdminLevelReq) GetLevel() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.Level
 	}
-	return nil
+	return 0
 }
 
-type GroupAttribute struct {
+type SetAdminLevelResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupID    string           `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
-	CreateTime int64            `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	Group      *sdkws.GroupInfo `protobuf:"bytes,3,opt,name=group,proto3" json:"group"`
 }
 
-func (x *GroupAttribute) Reset() {
-	*x = GroupAttribute{}
+func (x *SetAdminLevelResp) Reset() {
+	*x = SetAdminLevelResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1301,13 +1452,13 @@ func (x *GroupAttribute) Reset() {
 	}
 }
 
-func (x *GroupAttribute) String() string {
+func (x *SetAdminLevelResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAttribute) ProtoMessage() {}
+func (*SetAdminLevelResp) ProtoMessage() {}
 
-func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
+func (x *SetAdminLevelResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1319,43 +1470,27 @@ func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAttribute.ProtoReflect.Descriptor instead.
-func (*GroupAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminLevelResp.ProtoReflect.Descriptor instead.
+func (*SetAdminLevelResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GroupAttribute) GetGroupID() string {
-	if x != nil {
-		return x.GroupID
-	}
-	return ""
-}
-
-func (x *GroupAttribute) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-func (x *GroupAttribute) GetGroup() *sdkws.GroupInfo {
-	if x != nil {
-		return x.Group
-	}
-	return nil
-}
-
-type SearchDefaultGroupResp struct {
+// ################### 管理员账号 ###################
+type AddAdminAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL  string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
+	Level    int32  `protobuf:"varint,5,opt,name=level,proto3" json:"level"`
+	ImUserID string `protobuf:"bytes,6,opt,name=imUserID,proto3" json:"imUserID"`
 }
 
-func (x *SearchDefaultGroupResp) Reset() {
-	*x = SearchDefaultGroupResp{}
+func (x *AddAdminAccountReq) Reset() {
+	*x = AddAdminAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1363,13 +1498,13 @@ func (x *SearchDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *SearchDefaultGroupResp) String() string {
+func (x *AddAdminAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultGroupResp) ProtoMessage() {}
+func (*AddAdminAccountReq) ProtoMessage() {}
 
-func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *AddAdminAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,35 +1516,63 @@ func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*SearchDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminAccountReq.ProtoReflect.Descriptor instead.
+func (*AddAdminAccountReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *SearchDefaultGroupResp) GetTotal() uint32 {
+func (x *AddAdminAccountReq) GetAccount() string {
 	if x != nil {
-		return x.Total
+		return x.Account
+	}
+	return ""
+}
+
+func (x *AddAdminAccountReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddAdminAccountReq) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AddAdminAccountReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *AddAdminAccountReq) GetLevel() int32 {
+	if x != nil {
+		return x.Level
 	}
 	return 0
 }
 
-func (x *SearchDefaultGroupResp) GetGroupIDs() []string {
+func (x *AddAdminAccountReq) GetImUserID() string {
 	if x != nil {
-		return x.GroupIDs
+		return x.ImUserID
 	}
-	return nil
+	return ""
 }
 
-type AddInvitationCodeReq struct {
+type AddAdminAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *AddInvitationCodeReq) Reset() {
-	*x = AddInvitationCodeReq{}
+func (x *AddAdminAccountResp) Reset() {
+	*x = AddAdminAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1417,13 +1580,13 @@ func (x *AddInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *AddInvitationCodeReq) String() string {
+func (x *AddAdminAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddInvitationCodeReq) ProtoMessage() {}
+func (*AddAdminAccountResp) ProtoMessage() {}
 
-func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *AddAdminAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1435,26 +1598,28 @@ func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*AddInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminAccountResp.ProtoReflect.Descriptor instead.
+func (*AddAdminAccountResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AddInvitationCodeReq) GetCodes() []string {
+func (x *AddAdminAccountResp) GetUserID() string {
 	if x != nil {
-		return x.Codes
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type AddInvitationCodeResp struct {
+type DelAdminAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
 }
 
-func (x *AddInvitationCodeResp) Reset() {
-	*x = AddInvitationCodeResp{}
+func (x *DelAdminAccountReq) Reset() {
+	*x = DelAdminAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,13 +1627,13 @@ func (x *AddInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *AddInvitationCodeResp) String() string {
+func (x *DelAdminAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddInvitationCodeResp) ProtoMessage() {}
+func (*DelAdminAccountReq) ProtoMessage() {}
 
-func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *DelAdminAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1480,23 +1645,26 @@ func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*AddInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminAccountReq.ProtoReflect.Descriptor instead.
+func (*DelAdminAccountReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{27}
 }
 
-type GenInvitationCodeReq struct {
+func (x *DelAdminAccountReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type DelAdminAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Len   int32  `protobuf:"varint,1,opt,name=len,proto3" json:"len"`
-	Num   int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num"`
-	Chars string `protobuf:"bytes,3,opt,name=chars,proto3" json:"chars"`
 }
 
-func (x *GenInvitationCodeReq) Reset() {
-	*x = GenInvitationCodeReq{}
+func (x *DelAdminAccountResp) Reset() {
+	*x = DelAdminAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1504,13 +1672,13 @@ func (x *GenInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *GenInvitationCodeReq) String() string {
+func (x *DelAdminAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenInvitationCodeReq) ProtoMessage() {}
+func (*DelAdminAccountResp) ProtoMessage() {}
 
-func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *DelAdminAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1522,40 +1690,22 @@ func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*GenInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminAccountResp.ProtoReflect.Descriptor instead.
+func (*DelAdminAccountResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GenInvitationCodeReq) GetLen() int32 {
-	if x != nil {
-		return x.Len
-	}
-	return 0
-}
-
-func (x *GenInvitationCodeReq) GetNum() int32 {
-	if x != nil {
-		return x.Num
-	}
-	return 0
-}
-
-func (x *GenInvitationCodeReq) GetChars() string {
-	if x != nil {
-		return x.Chars
-	}
-	return ""
-}
-
-type GenInvitationCodeResp struct {
+type SetAdminStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Status  int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status"` // 0正常 1禁用
 }
 
-func (x *GenInvitationCodeResp) Reset() {
-	*x = GenInvitationCodeResp{}
+func (x *SetAdminStatusReq) Reset() {
+	*x = SetAdminStatusReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1563,13 +1713,13 @@ func (x *GenInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *GenInvitationCodeResp) String() string {
+func (x *SetAdminStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenInvitationCodeResp) ProtoMessage() {}
+func (*SetAdminStatusReq) ProtoMessage() {}
 
-func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *SetAdminStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1581,21 +1731,33 @@ func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*GenInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminStatusReq.ProtoReflect.Descriptor instead.
+func (*SetAdminStatusReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{29}
 }
 
-type FindInvitationCodeReq struct {
+func (x *SetAdminStatusReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *SetAdminStatusReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type SetAdminStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *FindInvitationCodeReq) Reset() {
-	*x = FindInvitationCodeReq{}
+func (x *SetAdminStatusResp) Reset() {
+	*x = SetAdminStatusResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1603,13 +1765,13 @@ func (x *FindInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *FindInvitationCodeReq) String() string {
+func (x *SetAdminStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindInvitationCodeReq) ProtoMessage() {}
+func (*SetAdminStatusResp) ProtoMessage() {}
 
-func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *SetAdminStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1621,28 +1783,22 @@ func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*FindInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminStatusResp.ProtoReflect.Descriptor instead.
+func (*SetAdminStatusResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *FindInvitationCodeReq) GetCodes() []string {
-	if x != nil {
-		return x.Codes
-	}
-	return nil
-}
-
-type FindInvitationCodeResp struct {
+type BindIMAdminReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []*InvitationRegister `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	ImUserID string `protobuf:"bytes,2,opt,name=imUserID,proto3" json:"imUserID"`
 }
 
-func (x *FindInvitationCodeResp) Reset() {
-	*x = FindInvitationCodeResp{}
+func (x *BindIMAdminReq) Reset() {
+	*x = BindIMAdminReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1650,13 +1806,13 @@ func (x *FindInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *FindInvitationCodeResp) String() string {
+func (x *BindIMAdminReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindInvitationCodeResp) ProtoMessage() {}
+func (*BindIMAdminReq) ProtoMessage() {}
 
-func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *BindIMAdminReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1668,29 +1824,33 @@ func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*FindInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use BindIMAdminReq.ProtoReflect.Descriptor instead.
+func (*BindIMAdminReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *FindInvitationCodeResp) GetCodes() []*InvitationRegister {
+func (x *BindIMAdminReq) GetAccount() string {
 	if x != nil {
-		return x.Codes
+		return x.Account
 	}
-	return nil
+	return ""
 }
 
-type UseInvitationCodeReq struct {
+func (x *BindIMAdminReq) GetImUserID() string {
+	if x != nil {
+		return x.ImUserID
+	}
+	return ""
+}
+
+type BindIMAdminResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
-	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *UseInvitationCodeReq) Reset() {
-	*x = UseInvitationCodeReq{}
+func (x *BindIMAdminResp) Reset() {
+	*x = BindIMAdminResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,13 +1858,13 @@ func (x *UseInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *UseInvitationCodeReq) String() string {
+func (x *BindIMAdminResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseInvitationCodeReq) ProtoMessage() {}
+func (*BindIMAdminResp) ProtoMessage() {}
 
-func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *BindIMAdminResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1716,33 +1876,21 @@ func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*UseInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use BindIMAdminResp.ProtoReflect.Descriptor instead.
+func (*BindIMAdminResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UseInvitationCodeReq) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *UseInvitationCodeReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-type UseInvitationCodeResp struct {
+type GetIMAdminReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *UseInvitationCodeResp) Reset() {
-	*x = UseInvitationCodeResp{}
+func (x *GetIMAdminReq) Reset() {
+	*x = GetIMAdminReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1750,13 +1898,13 @@ func (x *UseInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *UseInvitationCodeResp) String() string {
+func (x *GetIMAdminReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseInvitationCodeResp) ProtoMessage() {}
+func (*GetIMAdminReq) ProtoMessage() {}
 
-func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *GetIMAdminReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1768,21 +1916,28 @@ func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*UseInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIMAdminReq.ProtoReflect.Descriptor instead.
+func (*GetIMAdminReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{33}
 }
 
-type DelInvitationCodeReq struct {
+func (x *GetIMAdminReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetIMAdminResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	ImUserID string `protobuf:"bytes,1,opt,name=imUserID,proto3" json:"imUserID"`
 }
 
-func (x *DelInvitationCodeReq) Reset() {
-	*x = DelInvitationCodeReq{}
+func (x *GetIMAdminResp) Reset() {
+	*x = GetIMAdminResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1790,13 +1945,13 @@ func (x *DelInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *DelInvitationCodeReq) String() string {
+func (x *GetIMAdminResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelInvitationCodeReq) ProtoMessage() {}
+func (*GetIMAdminResp) ProtoMessage() {}
 
-func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *GetIMAdminResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1808,26 +1963,38 @@ func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*DelInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetIMAdminResp.ProtoReflect.Descriptor instead.
+func (*GetIMAdminResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DelInvitationCodeReq) GetCodes() []string {
+func (x *GetIMAdminResp) GetImUserID() string {
 	if x != nil {
-		return x.Codes
+		return x.ImUserID
 	}
-	return nil
+	return ""
 }
 
-type DelInvitationCodeResp struct {
+// ################### 审计日志 ###################
+type AuditLogInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	OpUserID   string `protobuf:"bytes,2,opt,name=opUserID,proto3" json:"opUserID"`
+	Action     string `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+	Target     string `protobuf:"bytes,4,opt,name=target,proto3" json:"target"`
+	Request    string `protobuf:"bytes,5,opt,name=request,proto3" json:"request"`
+	Previous   string `protobuf:"bytes,6,opt,name=previous,proto3" json:"previous"`
+	Ip         string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip"`
+	ErrCode    int32  `protobuf:"varint,8,opt,name=errCode,proto3" json:"errCode"`
+	ErrMsg     string `protobuf:"bytes,9,opt,name=errMsg,proto3" json:"errMsg"`
+	CreateTime int64  `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *DelInvitationCodeResp) Reset() {
-	*x = DelInvitationCodeResp{}
+func (x *AuditLogInfo) Reset() {
+	*x = AuditLogInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1835,13 +2002,13 @@ func (x *DelInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *DelInvitationCodeResp) String() string {
+func (x *AuditLogInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelInvitationCodeResp) ProtoMessage() {}
+func (*AuditLogInfo) ProtoMessage() {}
 
-func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *AuditLogInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1853,111 +2020,113 @@ func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*DelInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditLogInfo.ProtoReflect.Descriptor instead.
+func (*AuditLogInfo) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{35}
 }
 
-type InvitationRegister struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	InvitationCode string                 `protobuf:"bytes,1,opt,name=invitationCode,proto3" json:"invitationCode"`
-	CreateTime     int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	UsedUserID     string                 `protobuf:"bytes,3,opt,name=usedUserID,proto3" json:"usedUserID"`
-	UsedUser       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=usedUser,proto3" json:"usedUser"`
+func (x *AuditLogInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-func (x *InvitationRegister) Reset() {
-	*x = InvitationRegister{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AuditLogInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
 	}
+	return ""
 }
 
-func (x *InvitationRegister) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AuditLogInfo) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (*InvitationRegister) ProtoMessage() {}
+func (x *AuditLogInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
 
-func (x *InvitationRegister) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AuditLogInfo) GetRequest() string {
+	if x != nil {
+		return x.Request
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use InvitationRegister.ProtoReflect.Descriptor instead.
-func (*InvitationRegister) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{36}
+func (x *AuditLogInfo) GetPrevious() string {
+	if x != nil {
+		return x.Previous
+	}
+	return ""
 }
 
-func (x *InvitationRegister) GetInvitationCode() string {
+func (x *AuditLogInfo) GetIp() string {
 	if x != nil {
-		return x.InvitationCode
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *InvitationRegister) GetCreateTime() int64 {
+func (x *AuditLogInfo) GetErrCode() int32 {
 	if x != nil {
-		return x.CreateTime
+		return x.ErrCode
 	}
 	return 0
 }
 
-func (x *InvitationRegister) GetUsedUserID() string {
+func (x *AuditLogInfo) GetErrMsg() string {
 	if x != nil {
-		return x.UsedUserID
+		return x.ErrMsg
 	}
 	return ""
 }
 
-func (x *InvitationRegister) GetUsedUser() *common.UserPublicInfo {
+func (x *AuditLogInfo) GetCreateTime() int64 {
 	if x != nil {
-		return x.UsedUser
+		return x.CreateTime
 	}
-	return nil
+	return 0
 }
 
-type SearchInvitationCodeReq struct {
+// 其他服务记录审计日志, 操作者和ip取自调用上下文
+type AddAuditLogReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	UserIDs    []string                 `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
-	Codes      []string                 `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes"`
-	Keyword    string                   `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+	Action     string `protobuf:"bytes,1,opt,name=action,proto3" json:"action"`
+	Target     string `protobuf:"bytes,2,opt,name=target,proto3" json:"target"`
+	Request    string `protobuf:"bytes,3,opt,name=request,proto3" json:"request"`
+	Previous   string `protobuf:"bytes,4,opt,name=previous,proto3" json:"previous"`
+	ErrCode    int32  `protobuf:"varint,5,opt,name=errCode,proto3" json:"errCode"`
+	ErrMsg     string `protobuf:"bytes,6,opt,name=errMsg,proto3" json:"errMsg"`
+	CreateTime int64  `protobuf:"varint,7,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *SearchInvitationCodeReq) Reset() {
-	*x = SearchInvitationCodeReq{}
+func (x *AddAuditLogReq) Reset() {
+	*x = AddAuditLogReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[37]
+		mi := &file_admin_admin_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchInvitationCodeReq) String() string {
+func (x *AddAuditLogReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchInvitationCodeReq) ProtoMessage() {}
+func (*AddAuditLogReq) ProtoMessage() {}
 
-func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[37]
+func (x *AddAuditLogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,57 +2137,114 @@ func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*SearchInvitationCodeReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use AddAuditLogReq.ProtoReflect.Descriptor instead.
+func (*AddAuditLogReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *SearchInvitationCodeReq) GetStatus() int32 {
+func (x *AddAuditLogReq) GetAction() string {
 	if x != nil {
-		return x.Status
+		return x.Action
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchInvitationCodeReq) GetUserIDs() []string {
+func (x *AddAuditLogReq) GetTarget() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchInvitationCodeReq) GetCodes() []string {
+func (x *AddAuditLogReq) GetRequest() string {
 	if x != nil {
-		return x.Codes
+		return x.Request
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchInvitationCodeReq) GetKeyword() string {
+func (x *AddAuditLogReq) GetPrevious() string {
 	if x != nil {
-		return x.Keyword
+		return x.Previous
 	}
 	return ""
 }
 
-func (x *SearchInvitationCodeReq) GetPagination() *sdkws.RequestPagination {
+func (x *AddAuditLogReq) GetErrCode() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.ErrCode
 	}
-	return nil
+	return 0
 }
 
-type SearchInvitationCodeResp struct {
+func (x *AddAuditLogReq) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *AddAuditLogReq) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AddAuditLogResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Total uint32                `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	List  []*InvitationRegister `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+func (x *AddAuditLogResp) Reset() {
+	*x = AddAuditLogResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *SearchInvitationCodeResp) Reset() {
-	*x = SearchInvitationCodeResp{}
+func (x *AddAuditLogResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAuditLogResp) ProtoMessage() {}
+
+func (x *AddAuditLogResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAuditLogResp.ProtoReflect.Descriptor instead.
+func (*AddAuditLogResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{37}
+}
+
+type SearchAuditLogReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OpUserID   string                   `protobuf:"bytes,1,opt,name=opUserID,proto3" json:"opUserID"`
+	Action     string                   `protobuf:"bytes,2,opt,name=action,proto3" json:"action"`
+	Keyword    string                   `protobuf:"bytes,3,opt,name=keyword,proto3" json:"keyword"`
+	Start      int64                    `protobuf:"varint,4,opt,name=start,proto3" json:"start"`
+	End        int64                    `protobuf:"varint,5,opt,name=end,proto3" json:"end"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination"`
+	BeforeID   uint64                   `protobuf:"varint,7,opt,name=beforeID,proto3" json:"beforeID"` // 大于0时只查询id小于该值的记录, 导出时按id游标分页, 避免新记录导致翻页重复
+}
+
+func (x *SearchAuditLogReq) Reset() {
+	*x = SearchAuditLogReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,13 +2252,13 @@ func (x *SearchInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *SearchInvitationCodeResp) String() string {
+func (x *SearchAuditLogReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchInvitationCodeResp) ProtoMessage() {}
+func (*SearchAuditLogReq) ProtoMessage() {}
 
-func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *SearchAuditLogReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,36 +2270,71 @@ func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*SearchInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAuditLogReq.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SearchInvitationCodeResp) GetTotal() uint32 {
+func (x *SearchAuditLogReq) GetOpUserID() string {
 	if x != nil {
-		return x.Total
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *SearchAuditLogReq) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *SearchAuditLogReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAuditLogReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
 	}
 	return 0
 }
 
-func (x *SearchInvitationCodeResp) GetList() []*InvitationRegister {
+func (x *SearchAuditLogReq) GetEnd() int64 {
 	if x != nil {
-		return x.List
+		return x.End
+	}
+	return 0
+}
+
+func (x *SearchAuditLogReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type SearchUserIPLimitLoginReq struct {
+func (x *SearchAuditLogReq) GetBeforeID() uint64 {
+	if x != nil {
+		return x.BeforeID
+	}
+	return 0
+}
+
+type SearchAuditLogResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Total uint32          `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Logs  []*AuditLogInfo `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs"`
 }
 
-func (x *SearchUserIPLimitLoginReq) Reset() {
-	*x = SearchUserIPLimitLoginReq{}
+func (x *SearchAuditLogResp) Reset() {
+	*x = SearchAuditLogResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2081,13 +2342,13 @@ func (x *SearchUserIPLimitLoginReq) Reset() {
 	}
 }
 
-func (x *SearchUserIPLimitLoginReq) String() string {
+func (x *SearchAuditLogResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserIPLimitLoginReq) ProtoMessage() {}
+func (*SearchAuditLogResp) ProtoMessage() {}
 
-func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAuditLogResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2099,38 +2360,37 @@ func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*SearchUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAuditLogResp.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SearchUserIPLimitLoginReq) GetKeyword() string {
+func (x *SearchAuditLogResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Keyword
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *SearchUserIPLimitLoginReq) GetPagination() *sdkws.RequestPagination {
+func (x *SearchAuditLogResp) GetLogs() []*AuditLogInfo {
 	if x != nil {
-		return x.Pagination
+		return x.Logs
 	}
 	return nil
 }
 
-type LimitUserLoginIP struct {
+type AdminIPAllowInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Ip         string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
-	CreateTime int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
-	User       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
+	Cidr       string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr"`
+	Remark     string `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark"`
+	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *LimitUserLoginIP) Reset() {
-	*x = LimitUserLoginIP{}
+func (x *AdminIPAllowInfo) Reset() {
+	*x = AdminIPAllowInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2138,13 +2398,13 @@ func (x *LimitUserLoginIP) Reset() {
 	}
 }
 
-func (x *LimitUserLoginIP) String() string {
+func (x *AdminIPAllowInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LimitUserLoginIP) ProtoMessage() {}
+func (*AdminIPAllowInfo) ProtoMessage() {}
 
-func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
+func (x *AdminIPAllowInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2156,50 +2416,43 @@ func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LimitUserLoginIP.ProtoReflect.Descriptor instead.
-func (*LimitUserLoginIP) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminIPAllowInfo.ProtoReflect.Descriptor instead.
+func (*AdminIPAllowInfo) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LimitUserLoginIP) GetUserID() string {
+func (x *AdminIPAllowInfo) GetCidr() string {
 	if x != nil {
-		return x.UserID
+		return x.Cidr
 	}
 	return ""
 }
 
-func (x *LimitUserLoginIP) GetIp() string {
+func (x *AdminIPAllowInfo) GetRemark() string {
 	if x != nil {
-		return x.Ip
+		return x.Remark
 	}
 	return ""
 }
 
-func (x *LimitUserLoginIP) GetCreateTime() int64 {
+func (x *AdminIPAllowInfo) GetCreateTime() int64 {
 	if x != nil {
 		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *LimitUserLoginIP) GetUser() *common.UserPublicInfo {
-	if x != nil {
-		return x.User
-	}
-	return nil
-}
-
-type SearchUserIPLimitLoginResp struct {
+type AddAdminIPAllowReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total  uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Limits []*LimitUserLoginIP `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+	Cidr   string `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr"`
+	Remark string `protobuf:"bytes,2,opt,name=remark,proto3" json:"remark"`
 }
 
-func (x *SearchUserIPLimitLoginResp) Reset() {
-	*x = SearchUserIPLimitLoginResp{}
+func (x *AddAdminIPAllowReq) Reset() {
+	*x = AddAdminIPAllowReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2207,13 +2460,13 @@ func (x *SearchUserIPLimitLoginResp) Reset() {
 	}
 }
 
-func (x *SearchUserIPLimitLoginResp) String() string {
+func (x *AddAdminIPAllowReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserIPLimitLoginResp) ProtoMessage() {}
+func (*AddAdminIPAllowReq) ProtoMessage() {}
 
-func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+func (x *AddAdminIPAllowReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2225,36 +2478,33 @@ func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*SearchUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminIPAllowReq.ProtoReflect.Descriptor instead.
+func (*AddAdminIPAllowReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *SearchUserIPLimitLoginResp) GetTotal() uint32 {
+func (x *AddAdminIPAllowReq) GetCidr() string {
 	if x != nil {
-		return x.Total
+		return x.Cidr
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchUserIPLimitLoginResp) GetLimits() []*LimitUserLoginIP {
+func (x *AddAdminIPAllowReq) GetRemark() string {
 	if x != nil {
-		return x.Limits
+		return x.Remark
 	}
-	return nil
+	return ""
 }
 
-type UserIPLimitLogin struct {
+type AddAdminIPAllowResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Ip     string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *UserIPLimitLogin) Reset() {
-	*x = UserIPLimitLogin{}
+func (x *AddAdminIPAllowResp) Reset() {
+	*x = AddAdminIPAllowResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2262,13 +2512,13 @@ func (x *UserIPLimitLogin) Reset() {
 	}
 }
 
-func (x *UserIPLimitLogin) String() string {
+func (x *AddAdminIPAllowResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserIPLimitLogin) ProtoMessage() {}
+func (*AddAdminIPAllowResp) ProtoMessage() {}
 
-func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
+func (x *AddAdminIPAllowResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2280,35 +2530,21 @@ func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserIPLimitLogin.ProtoReflect.Descriptor instead.
-func (*UserIPLimitLogin) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminIPAllowResp.ProtoReflect.Descriptor instead.
+func (*AddAdminIPAllowResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UserIPLimitLogin) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *UserIPLimitLogin) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
-}
-
-type AddUserIPLimitLoginReq struct {
+type DelAdminIPAllowReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	Cidrs []string `protobuf:"bytes,1,rep,name=cidrs,proto3" json:"cidrs"`
 }
 
-func (x *AddUserIPLimitLoginReq) Reset() {
-	*x = AddUserIPLimitLoginReq{}
+func (x *DelAdminIPAllowReq) Reset() {
+	*x = DelAdminIPAllowReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2316,13 +2552,13 @@ func (x *AddUserIPLimitLoginReq) Reset() {
 	}
 }
 
-func (x *AddUserIPLimitLoginReq) String() string {
+func (x *DelAdminIPAllowReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserIPLimitLoginReq) ProtoMessage() {}
+func (*DelAdminIPAllowReq) ProtoMessage() {}
 
-func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+func (x *DelAdminIPAllowReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2334,26 +2570,26 @@ func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*AddUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminIPAllowReq.ProtoReflect.Descriptor instead.
+func (*DelAdminIPAllowReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
+func (x *DelAdminIPAllowReq) GetCidrs() []string {
 	if x != nil {
-		return x.Limits
+		return x.Cidrs
 	}
 	return nil
 }
 
-type AddUserIPLimitLoginResp struct {
+type DelAdminIPAllowResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddUserIPLimitLoginResp) Reset() {
-	*x = AddUserIPLimitLoginResp{}
+func (x *DelAdminIPAllowResp) Reset() {
+	*x = DelAdminIPAllowResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2361,13 +2597,13 @@ func (x *AddUserIPLimitLoginResp) Reset() {
 	}
 }
 
-func (x *AddUserIPLimitLoginResp) String() string {
+func (x *DelAdminIPAllowResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserIPLimitLoginResp) ProtoMessage() {}
+func (*DelAdminIPAllowResp) ProtoMessage() {}
 
-func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+func (x *DelAdminIPAllowResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2379,21 +2615,22 @@ func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*AddUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminIPAllowResp.ProtoReflect.Descriptor instead.
+func (*DelAdminIPAllowResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{44}
 }
 
-type DelUserIPLimitLoginReq struct {
+type SearchAdminIPAllowReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *DelUserIPLimitLoginReq) Reset() {
-	*x = DelUserIPLimitLoginReq{}
+func (x *SearchAdminIPAllowReq) Reset() {
+	*x = SearchAdminIPAllowReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2401,13 +2638,13 @@ func (x *DelUserIPLimitLoginReq) Reset() {
 	}
 }
 
-func (x *DelUserIPLimitLoginReq) String() string {
+func (x *SearchAdminIPAllowReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserIPLimitLoginReq) ProtoMessage() {}
+func (*SearchAdminIPAllowReq) ProtoMessage() {}
 
-func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminIPAllowReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2419,26 +2656,36 @@ func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*DelUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminIPAllowReq.ProtoReflect.Descriptor instead.
+func (*SearchAdminIPAllowReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *DelUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
+func (x *SearchAdminIPAllowReq) GetKeyword() string {
 	if x != nil {
-		return x.Limits
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAdminIPAllowReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type DelUserIPLimitLoginResp struct {
+type SearchAdminIPAllowResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Total uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Items []*AdminIPAllowInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items"`
 }
 
-func (x *DelUserIPLimitLoginResp) Reset() {
-	*x = DelUserIPLimitLoginResp{}
+func (x *SearchAdminIPAllowResp) Reset() {
+	*x = SearchAdminIPAllowResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2446,13 +2693,13 @@ func (x *DelUserIPLimitLoginResp) Reset() {
 	}
 }
 
-func (x *DelUserIPLimitLoginResp) String() string {
+func (x *SearchAdminIPAllowResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserIPLimitLoginResp) ProtoMessage() {}
+func (*SearchAdminIPAllowResp) ProtoMessage() {}
 
-func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminIPAllowResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2464,24 +2711,35 @@ func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*DelUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminIPAllowResp.ProtoReflect.Descriptor instead.
+func (*SearchAdminIPAllowResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{46}
 }
 
-type IPForbidden struct {
+func (x *SearchAdminIPAllowResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAdminIPAllowResp) GetItems() []*AdminIPAllowInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckAdminIPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
-	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
-	CreateTime    int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *IPForbidden) Reset() {
-	*x = IPForbidden{}
+func (x *CheckAdminIPReq) Reset() {
+	*x = CheckAdminIPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2489,13 +2747,13 @@ func (x *IPForbidden) Reset() {
 	}
 }
 
-func (x *IPForbidden) String() string {
+func (x *CheckAdminIPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPForbidden) ProtoMessage() {}
+func (*CheckAdminIPReq) ProtoMessage() {}
 
-func (x *IPForbidden) ProtoReflect() protoreflect.Message {
+func (x *CheckAdminIPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2507,51 +2765,26 @@ func (x *IPForbidden) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPForbidden.ProtoReflect.Descriptor instead.
-func (*IPForbidden) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckAdminIPReq.ProtoReflect.Descriptor instead.
+func (*CheckAdminIPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *IPForbidden) GetIp() string {
+func (x *CheckAdminIPReq) GetIp() string {
 	if x != nil {
 		return x.Ip
 	}
 	return ""
 }
 
-func (x *IPForbidden) GetLimitRegister() bool {
-	if x != nil {
-		return x.LimitRegister
-	}
-	return false
-}
-
-func (x *IPForbidden) GetLimitLogin() bool {
-	if x != nil {
-		return x.LimitLogin
-	}
-	return false
-}
-
-func (x *IPForbidden) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-type IPForbiddenAdd struct {
+type CheckAdminIPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
-	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
 }
 
-func (x *IPForbiddenAdd) Reset() {
-	*x = IPForbiddenAdd{}
+func (x *CheckAdminIPResp) Reset() {
+	*x = CheckAdminIPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2559,13 +2792,13 @@ func (x *IPForbiddenAdd) Reset() {
 	}
 }
 
-func (x *IPForbiddenAdd) String() string {
+func (x *CheckAdminIPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPForbiddenAdd) ProtoMessage() {}
+func (*CheckAdminIPResp) ProtoMessage() {}
 
-func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
+func (x *CheckAdminIPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2577,44 +2810,21 @@ func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPForbiddenAdd.ProtoReflect.Descriptor instead.
-func (*IPForbiddenAdd) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckAdminIPResp.ProtoReflect.Descriptor instead.
+func (*CheckAdminIPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *IPForbiddenAdd) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
-}
-
-func (x *IPForbiddenAdd) GetLimitRegister() bool {
-	if x != nil {
-		return x.LimitRegister
-	}
-	return false
-}
-
-func (x *IPForbiddenAdd) GetLimitLogin() bool {
-	if x != nil {
-		return x.LimitLogin
-	}
-	return false
-}
-
-type SearchIPForbiddenReq struct {
+type AddDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Status     int32                    `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *SearchIPForbiddenReq) Reset() {
-	*x = SearchIPForbiddenReq{}
+func (x *AddDefaultFriendReq) Reset() {
+	*x = AddDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2622,13 +2832,13 @@ func (x *SearchIPForbiddenReq) Reset() {
 	}
 }
 
-func (x *SearchIPForbiddenReq) String() string {
+func (x *AddDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIPForbiddenReq) ProtoMessage() {}
+func (*AddDefaultFriendReq) ProtoMessage() {}
 
-func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2640,43 +2850,26 @@ func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*SearchIPForbiddenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*AddDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SearchIPForbiddenReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-func (x *SearchIPForbiddenReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *SearchIPForbiddenReq) GetPagination() *sdkws.RequestPagination {
+func (x *AddDefaultFriendReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Pagination
+		return x.UserIDs
 	}
 	return nil
 }
 
-type SearchIPForbiddenResp struct {
+type AddDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Total      uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Forbiddens []*IPForbidden `protobuf:"bytes,2,rep,name=forbiddens,proto3" json:"forbiddens"`
 }
 
-func (x *SearchIPForbiddenResp) Reset() {
-	*x = SearchIPForbiddenResp{}
+func (x *AddDefaultFriendResp) Reset() {
+	*x = AddDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2684,13 +2877,13 @@ func (x *SearchIPForbiddenResp) Reset() {
 	}
 }
 
-func (x *SearchIPForbiddenResp) String() string {
+func (x *AddDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIPForbiddenResp) ProtoMessage() {}
+func (*AddDefaultFriendResp) ProtoMessage() {}
 
-func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2702,35 +2895,21 @@ func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*SearchIPForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*AddDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SearchIPForbiddenResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchIPForbiddenResp) GetForbiddens() []*IPForbidden {
-	if x != nil {
-		return x.Forbiddens
-	}
-	return nil
-}
-
-type AddIPForbiddenReq struct {
+type DelDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forbiddens []*IPForbiddenAdd `protobuf:"bytes,1,rep,name=forbiddens,proto3" json:"forbiddens"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *AddIPForbiddenReq) Reset() {
-	*x = AddIPForbiddenReq{}
+func (x *DelDefaultFriendReq) Reset() {
+	*x = DelDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2738,13 +2917,13 @@ func (x *AddIPForbiddenReq) Reset() {
 	}
 }
 
-func (x *AddIPForbiddenReq) String() string {
+func (x *DelDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddIPForbiddenReq) ProtoMessage() {}
+func (*DelDefaultFriendReq) ProtoMessage() {}
 
-func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2756,26 +2935,26 @@ func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*AddIPForbiddenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*DelDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AddIPForbiddenReq) GetForbiddens() []*IPForbiddenAdd {
+func (x *DelDefaultFriendReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Forbiddens
+		return x.UserIDs
 	}
 	return nil
 }
 
-type AddIPForbiddenResp struct {
+type DelDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddIPForbiddenResp) Reset() {
-	*x = AddIPForbiddenResp{}
+func (x *DelDefaultFriendResp) Reset() {
+	*x = DelDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2783,13 +2962,13 @@ func (x *AddIPForbiddenResp) Reset() {
 	}
 }
 
-func (x *AddIPForbiddenResp) String() string {
+func (x *DelDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddIPForbiddenResp) ProtoMessage() {}
+func (*DelDefaultFriendResp) ProtoMessage() {}
 
-func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2801,21 +2980,19 @@ func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*AddIPForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*DelDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{52}
 }
 
-type DelIPForbiddenReq struct {
+type FindDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ips []string `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips"`
 }
 
-func (x *DelIPForbiddenReq) Reset() {
-	*x = DelIPForbiddenReq{}
+func (x *FindDefaultFriendReq) Reset() {
+	*x = FindDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2823,13 +3000,13 @@ func (x *DelIPForbiddenReq) Reset() {
 	}
 }
 
-func (x *DelIPForbiddenReq) String() string {
+func (x *FindDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelIPForbiddenReq) ProtoMessage() {}
+func (*FindDefaultFriendReq) ProtoMessage() {}
 
-func (x *DelIPForbiddenReq) ProtoReflect() protoreflect.Message {
+func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2841,26 +3018,21 @@ func (x *DelIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*DelIPForbiddenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*FindDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *DelIPForbiddenReq) GetIps() []string {
-	if x != nil {
-		return x.Ips
-	}
-	return nil
-}
-
-type DelIPForbiddenResp struct {
+type FindDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *DelIPForbiddenResp) Reset() {
-	*x = DelIPForbiddenResp{}
+func (x *FindDefaultFriendResp) Reset() {
+	*x = FindDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2868,13 +3040,13 @@ func (x *DelIPForbiddenResp) Reset() {
 	}
 }
 
-func (x *DelIPForbiddenResp) String() string {
+func (x *FindDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelIPForbiddenResp) ProtoMessage() {}
+func (*FindDefaultFriendResp) ProtoMessage() {}
 
-func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2886,22 +3058,29 @@ func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*DelIPForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*FindDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{54}
 }
 
-// ################### 用户限制 ###################
-type CheckRegisterForbiddenReq struct {
+func (x *FindDefaultFriendResp) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SearchDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *CheckRegisterForbiddenReq) Reset() {
-	*x = CheckRegisterForbiddenReq{}
+func (x *SearchDefaultFriendReq) Reset() {
+	*x = SearchDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,13 +3088,13 @@ func (x *CheckRegisterForbiddenReq) Reset() {
 	}
 }
 
-func (x *CheckRegisterForbiddenReq) String() string {
+func (x *SearchDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRegisterForbiddenReq) ProtoMessage() {}
+func (*SearchDefaultFriendReq) ProtoMessage() {}
 
-func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
+func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2927,26 +3106,37 @@ func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRegisterForbiddenReq.ProtoReflect.Descriptor instead.
-func (*CheckRegisterForbiddenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*SearchDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CheckRegisterForbiddenReq) GetIp() string {
+func (x *SearchDefaultFriendReq) GetKeyword() string {
 	if x != nil {
-		return x.Ip
+		return x.Keyword
 	}
 	return ""
 }
 
-type CheckRegisterForbiddenResp struct {
+func (x *SearchDefaultFriendReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type DefaultFriendAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CreateTime int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	User       *common.UserPublicInfo `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
 }
 
-func (x *CheckRegisterForbiddenResp) Reset() {
-	*x = CheckRegisterForbiddenResp{}
+func (x *DefaultFriendAttribute) Reset() {
+	*x = DefaultFriendAttribute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2954,13 +3144,13 @@ func (x *CheckRegisterForbiddenResp) Reset() {
 	}
 }
 
-func (x *CheckRegisterForbiddenResp) String() string {
+func (x *DefaultFriendAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRegisterForbiddenResp) ProtoMessage() {}
+func (*DefaultFriendAttribute) ProtoMessage() {}
 
-func (x *CheckRegisterForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2972,22 +3162,43 @@ func (x *CheckRegisterForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRegisterForbiddenResp.ProtoReflect.Descriptor instead.
-func (*CheckRegisterForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DefaultFriendAttribute.ProtoReflect.Descriptor instead.
+func (*DefaultFriendAttribute) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{56}
 }
 
-type CheckLoginForbiddenReq struct {
+func (x *DefaultFriendAttribute) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DefaultFriendAttribute) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *DefaultFriendAttribute) GetUser() *common.UserPublicInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SearchDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip     string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Total uint32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*DefaultFriendAttribute `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *CheckLoginForbiddenReq) Reset() {
-	*x = CheckLoginForbiddenReq{}
+func (x *SearchDefaultFriendResp) Reset() {
+	*x = SearchDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2995,13 +3206,13 @@ func (x *CheckLoginForbiddenReq) Reset() {
 	}
 }
 
-func (x *CheckLoginForbiddenReq) String() string {
+func (x *SearchDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckLoginForbiddenReq) ProtoMessage() {}
+func (*SearchDefaultFriendResp) ProtoMessage() {}
 
-func (x *CheckLoginForbiddenReq) ProtoReflect() protoreflect.Message {
+func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3013,33 +3224,35 @@ func (x *CheckLoginForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckLoginForbiddenReq.ProtoReflect.Descriptor instead.
-func (*CheckLoginForbiddenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*SearchDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CheckLoginForbiddenReq) GetIp() string {
+func (x *SearchDefaultFriendResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Ip
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckLoginForbiddenReq) GetUserID() string {
+func (x *SearchDefaultFriendResp) GetUsers() []*DefaultFriendAttribute {
 	if x != nil {
-		return x.UserID
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-type CheckLoginForbiddenResp struct {
+type AddDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *CheckLoginForbiddenResp) Reset() {
-	*x = CheckLoginForbiddenResp{}
+func (x *AddDefaultGroupReq) Reset() {
+	*x = AddDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3047,13 +3260,13 @@ func (x *CheckLoginForbiddenResp) Reset() {
 	}
 }
 
-func (x *CheckLoginForbiddenResp) String() string {
+func (x *AddDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckLoginForbiddenResp) ProtoMessage() {}
+func (*AddDefaultGroupReq) ProtoMessage() {}
 
-func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3065,23 +3278,26 @@ func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckLoginForbiddenResp.ProtoReflect.Descriptor instead.
-func (*CheckLoginForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*AddDefaultGroupReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{58}
 }
 
-// ################### 注销 ###################
-type CancellationUserReq struct {
+func (x *AddDefaultGroupReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type AddDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
 }
 
-func (x *CancellationUserReq) Reset() {
-	*x = CancellationUserReq{}
+func (x *AddDefaultGroupResp) Reset() {
+	*x = AddDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3089,13 +3305,13 @@ func (x *CancellationUserReq) Reset() {
 	}
 }
 
-func (x *CancellationUserReq) String() string {
+func (x *AddDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancellationUserReq) ProtoMessage() {}
+func (*AddDefaultGroupResp) ProtoMessage() {}
 
-func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3107,33 +3323,21 @@ func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancellationUserReq.ProtoReflect.Descriptor instead.
-func (*CancellationUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*AddDefaultGroupResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *CancellationUserReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *CancellationUserReq) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type CancellationUserResp struct {
+type DelDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *CancellationUserResp) Reset() {
-	*x = CancellationUserResp{}
+func (x *DelDefaultGroupReq) Reset() {
+	*x = DelDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3141,13 +3345,13 @@ func (x *CancellationUserResp) Reset() {
 	}
 }
 
-func (x *CancellationUserResp) String() string {
+func (x *DelDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancellationUserResp) ProtoMessage() {}
+func (*DelDefaultGroupReq) ProtoMessage() {}
 
-func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3159,23 +3363,26 @@ func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancellationUserResp.ProtoReflect.Descriptor instead.
-func (*CancellationUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*DelDefaultGroupReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{60}
 }
 
-// ################### 封号、解封 ###################
-type BlockUserReq struct {
+func (x *DelDefaultGroupReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type DelDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
 }
 
-func (x *BlockUserReq) Reset() {
-	*x = BlockUserReq{}
+func (x *DelDefaultGroupResp) Reset() {
+	*x = DelDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3183,13 +3390,13 @@ func (x *BlockUserReq) Reset() {
 	}
 }
 
-func (x *BlockUserReq) String() string {
+func (x *DelDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserReq) ProtoMessage() {}
+func (*DelDefaultGroupResp) ProtoMessage() {}
 
-func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3201,33 +3408,19 @@ func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
-func (*BlockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*DelDefaultGroupResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *BlockUserReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *BlockUserReq) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type BlockUserResp struct {
+type FindDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BlockUserResp) Reset() {
-	*x = BlockUserResp{}
+func (x *FindDefaultGroupReq) Reset() {
+	*x = FindDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3235,13 +3428,13 @@ func (x *BlockUserResp) Reset() {
 	}
 }
 
-func (x *BlockUserResp) String() string {
+func (x *FindDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserResp) ProtoMessage() {}
+func (*FindDefaultGroupReq) ProtoMessage() {}
 
-func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
+func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3253,21 +3446,21 @@ func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserResp.ProtoReflect.Descriptor instead.
-func (*BlockUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*FindDefaultGroupReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{62}
 }
 
-type UnblockUserReq struct {
+type FindDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *UnblockUserReq) Reset() {
-	*x = UnblockUserReq{}
+func (x *FindDefaultGroupResp) Reset() {
+	*x = FindDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3275,13 +3468,13 @@ func (x *UnblockUserReq) Reset() {
 	}
 }
 
-func (x *UnblockUserReq) String() string {
+func (x *FindDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserReq) ProtoMessage() {}
+func (*FindDefaultGroupResp) ProtoMessage() {}
 
-func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3293,26 +3486,29 @@ func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
-func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*FindDefaultGroupResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UnblockUserReq) GetUserIDs() []string {
+func (x *FindDefaultGroupResp) GetGroupIDs() []string {
 	if x != nil {
-		return x.UserIDs
+		return x.GroupIDs
 	}
 	return nil
 }
 
-type UnblockUserResp struct {
+type SearchDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *UnblockUserResp) Reset() {
-	*x = UnblockUserResp{}
+func (x *SearchDefaultGroupReq) Reset() {
+	*x = SearchDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3320,13 +3516,13 @@ func (x *UnblockUserResp) Reset() {
 	}
 }
 
-func (x *UnblockUserResp) String() string {
+func (x *SearchDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserResp) ProtoMessage() {}
+func (*SearchDefaultGroupReq) ProtoMessage() {}
 
-func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
+func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3338,22 +3534,37 @@ func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserResp.ProtoReflect.Descriptor instead.
-func (*UnblockUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*SearchDefaultGroupReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{64}
 }
 
-type SearchBlockUserReq struct {
+func (x *SearchDefaultGroupReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchDefaultGroupReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GroupAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	GroupID    string           `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	CreateTime int64            `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	Group      *sdkws.GroupInfo `protobuf:"bytes,3,opt,name=group,proto3" json:"group"`
 }
 
-func (x *SearchBlockUserReq) Reset() {
-	*x = SearchBlockUserReq{}
+func (x *GroupAttribute) Reset() {
+	*x = GroupAttribute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3361,13 +3572,13 @@ func (x *SearchBlockUserReq) Reset() {
 	}
 }
 
-func (x *SearchBlockUserReq) String() string {
+func (x *GroupAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlockUserReq) ProtoMessage() {}
+func (*GroupAttribute) ProtoMessage() {}
 
-func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
+func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3379,45 +3590,43 @@ func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlockUserReq.ProtoReflect.Descriptor instead.
-func (*SearchBlockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupAttribute.ProtoReflect.Descriptor instead.
+func (*GroupAttribute) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SearchBlockUserReq) GetKeyword() string {
+func (x *GroupAttribute) GetGroupID() string {
 	if x != nil {
-		return x.Keyword
+		return x.GroupID
 	}
 	return ""
 }
 
-func (x *SearchBlockUserReq) GetPagination() *sdkws.RequestPagination {
+func (x *GroupAttribute) GetCreateTime() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GroupAttribute) GetGroup() *sdkws.GroupInfo {
+	if x != nil {
+		return x.Group
 	}
 	return nil
 }
 
-type BlockUserInfo struct {
+type SearchDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Account     string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
-	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	AreaCode    string `protobuf:"bytes,4,opt,name=areaCode,proto3" json:"areaCode"`
-	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
-	Nickname    string `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname"`
-	FaceURL     string `protobuf:"bytes,7,opt,name=faceURL,proto3" json:"faceURL"`
-	Gender      int32  `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
-	Reason      string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason"`
-	OpUserID    string `protobuf:"bytes,10,opt,name=opUserID,proto3" json:"opUserID"`
-	CreateTime  int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+	Total    uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *BlockUserInfo) Reset() {
-	*x = BlockUserInfo{}
+func (x *SearchDefaultGroupResp) Reset() {
+	*x = SearchDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3425,13 +3634,13 @@ func (x *BlockUserInfo) Reset() {
 	}
 }
 
-func (x *BlockUserInfo) String() string {
+func (x *SearchDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserInfo) ProtoMessage() {}
+func (*SearchDefaultGroupResp) ProtoMessage() {}
 
-func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
+func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3443,99 +3652,35 @@ func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserInfo.ProtoReflect.Descriptor instead.
-func (*BlockUserInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*SearchDefaultGroupResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *BlockUserInfo) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetPhoneNumber() string {
-	if x != nil {
-		return x.PhoneNumber
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetAreaCode() string {
-	if x != nil {
-		return x.AreaCode
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetFaceURL() string {
-	if x != nil {
-		return x.FaceURL
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetGender() int32 {
+func (x *SearchDefaultGroupResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Gender
+		return x.Total
 	}
 	return 0
 }
 
-func (x *BlockUserInfo) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetOpUserID() string {
-	if x != nil {
-		return x.OpUserID
-	}
-	return ""
-}
-
-func (x *BlockUserInfo) GetCreateTime() int64 {
+func (x *SearchDefaultGroupResp) GetGroupIDs() []string {
 	if x != nil {
-		return x.CreateTime
+		return x.GroupIDs
 	}
-	return 0
+	return nil
 }
 
-type SearchBlockUserResp struct {
+type AddInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*BlockUserInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *SearchBlockUserResp) Reset() {
-	*x = SearchBlockUserResp{}
+func (x *AddInvitationCodeReq) Reset() {
+	*x = AddInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3543,13 +3688,13 @@ func (x *SearchBlockUserResp) Reset() {
 	}
 }
 
-func (x *SearchBlockUserResp) String() string {
+func (x *AddInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlockUserResp) ProtoMessage() {}
+func (*AddInvitationCodeReq) ProtoMessage() {}
 
-func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
+func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3561,35 +3706,26 @@ func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlockUserResp.ProtoReflect.Descriptor instead.
-func (*SearchBlockUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*AddInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *SearchBlockUserResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchBlockUserResp) GetUsers() []*BlockUserInfo {
+func (x *AddInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.Users
+		return x.Codes
 	}
 	return nil
 }
 
-type FindUserBlockInfoReq struct {
+type AddInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *FindUserBlockInfoReq) Reset() {
-	*x = FindUserBlockInfoReq{}
+func (x *AddInvitationCodeResp) Reset() {
+	*x = AddInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3597,13 +3733,13 @@ func (x *FindUserBlockInfoReq) Reset() {
 	}
 }
 
-func (x *FindUserBlockInfoReq) String() string {
+func (x *AddInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserBlockInfoReq) ProtoMessage() {}
+func (*AddInvitationCodeResp) ProtoMessage() {}
 
-func (x *FindUserBlockInfoReq) ProtoReflect() protoreflect.Message {
+func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3615,31 +3751,23 @@ func (x *FindUserBlockInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserBlockInfoReq.ProtoReflect.Descriptor instead.
-func (*FindUserBlockInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*AddInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *FindUserBlockInfoReq) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type BlockInfo struct {
+type GenInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
-	OpUserID   string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
-	CreateTime int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	Len   int32  `protobuf:"varint,1,opt,name=len,proto3" json:"len"`
+	Num   int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num"`
+	Chars string `protobuf:"bytes,3,opt,name=chars,proto3" json:"chars"`
 }
 
-func (x *BlockInfo) Reset() {
-	*x = BlockInfo{}
+func (x *GenInvitationCodeReq) Reset() {
+	*x = GenInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3647,13 +3775,13 @@ func (x *BlockInfo) Reset() {
 	}
 }
 
-func (x *BlockInfo) String() string {
+func (x *GenInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockInfo) ProtoMessage() {}
+func (*GenInvitationCodeReq) ProtoMessage() {}
 
-func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3665,49 +3793,40 @@ func (x *BlockInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
-func (*BlockInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*GenInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *BlockInfo) GetUserID() string {
+func (x *GenInvitationCodeReq) GetLen() int32 {
 	if x != nil {
-		return x.UserID
+		return x.Len
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockInfo) GetReason() string {
+func (x *GenInvitationCodeReq) GetNum() int32 {
 	if x != nil {
-		return x.Reason
+		return x.Num
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockInfo) GetOpUserID() string {
+func (x *GenInvitationCodeReq) GetChars() string {
 	if x != nil {
-		return x.OpUserID
+		return x.Chars
 	}
 	return ""
 }
 
-func (x *BlockInfo) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-type FindUserBlockInfoResp struct {
+type GenInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Blocks []*BlockInfo `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks"`
 }
 
-func (x *FindUserBlockInfoResp) Reset() {
-	*x = FindUserBlockInfoResp{}
+func (x *GenInvitationCodeResp) Reset() {
+	*x = GenInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3715,13 +3834,13 @@ func (x *FindUserBlockInfoResp) Reset() {
 	}
 }
 
-func (x *FindUserBlockInfoResp) String() string {
+func (x *GenInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserBlockInfoResp) ProtoMessage() {}
+func (*GenInvitationCodeResp) ProtoMessage() {}
 
-func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
+func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3733,29 +3852,21 @@ func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserBlockInfoResp.ProtoReflect.Descriptor instead.
-func (*FindUserBlockInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*GenInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *FindUserBlockInfoResp) GetBlocks() []*BlockInfo {
-	if x != nil {
-		return x.Blocks
-	}
-	return nil
-}
-
-type CreateTokenReq struct {
+type FindInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
-	UserType int32  `protobuf:"varint,3,opt,name=userType,proto3" json:"userType"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *CreateTokenReq) Reset() {
-	*x = CreateTokenReq{}
+func (x *FindInvitationCodeReq) Reset() {
+	*x = FindInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3763,13 +3874,13 @@ func (x *CreateTokenReq) Reset() {
 	}
 }
 
-func (x *CreateTokenReq) String() string {
+func (x *FindInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenReq) ProtoMessage() {}
+func (*FindInvitationCodeReq) ProtoMessage() {}
 
-func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
+func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3781,35 +3892,28 @@ func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenReq.ProtoReflect.Descriptor instead.
-func (*CreateTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*FindInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CreateTokenReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *CreateTokenReq) GetUserType() int32 {
+func (x *FindInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.UserType
+		return x.Codes
 	}
-	return 0
+	return nil
 }
 
-type CreateTokenResp struct {
+type FindInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	Codes []*InvitationRegister `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *CreateTokenResp) Reset() {
-	*x = CreateTokenResp{}
+func (x *FindInvitationCodeResp) Reset() {
+	*x = FindInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3817,13 +3921,13 @@ func (x *CreateTokenResp) Reset() {
 	}
 }
 
-func (x *CreateTokenResp) String() string {
+func (x *FindInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenResp) ProtoMessage() {}
+func (*FindInvitationCodeResp) ProtoMessage() {}
 
-func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
+func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3835,28 +3939,29 @@ func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenResp.ProtoReflect.Descriptor instead.
-func (*CreateTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*FindInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CreateTokenResp) GetToken() string {
+func (x *FindInvitationCodeResp) GetCodes() []*InvitationRegister {
 	if x != nil {
-		return x.Token
+		return x.Codes
 	}
-	return ""
+	return nil
 }
 
-type ParseTokenReq struct {
+type UseInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *ParseTokenReq) Reset() {
-	*x = ParseTokenReq{}
+func (x *UseInvitationCodeReq) Reset() {
+	*x = UseInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3864,13 +3969,13 @@ func (x *ParseTokenReq) Reset() {
 	}
 }
 
-func (x *ParseTokenReq) String() string {
+func (x *UseInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseTokenReq) ProtoMessage() {}
+func (*UseInvitationCodeReq) ProtoMessage() {}
 
-func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
+func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3882,30 +3987,33 @@ func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseTokenReq.ProtoReflect.Descriptor instead.
-func (*ParseTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*UseInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ParseTokenReq) GetToken() string {
+func (x *UseInvitationCodeReq) GetCode() string {
 	if x != nil {
-		return x.Token
+		return x.Code
 	}
 	return ""
 }
 
-type ParseTokenResp struct {
+func (x *UseInvitationCodeReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UseInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID            string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
-	UserType          int32  `protobuf:"varint,3,opt,name=userType,proto3" json:"userType"`
-	ExpireTimeSeconds int64  `protobuf:"varint,4,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
 }
 
-func (x *ParseTokenResp) Reset() {
-	*x = ParseTokenResp{}
+func (x *UseInvitationCodeResp) Reset() {
+	*x = UseInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3913,13 +4021,13 @@ func (x *ParseTokenResp) Reset() {
 	}
 }
 
-func (x *ParseTokenResp) String() string {
+func (x *UseInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseTokenResp) ProtoMessage() {}
+func (*UseInvitationCodeResp) ProtoMessage() {}
 
-func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
+func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3931,52 +4039,21 @@ func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseTokenResp.ProtoReflect.Descriptor instead.
-func (*ParseTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*UseInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ParseTokenResp) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ParseTokenResp) GetUserType() int32 {
-	if x != nil {
-		return x.UserType
-	}
-	return 0
-}
-
-func (x *ParseTokenResp) GetExpireTimeSeconds() int64 {
-	if x != nil {
-		return x.ExpireTimeSeconds
-	}
-	return 0
-}
-
-type AddAppletReq struct {
+type DelInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	AppID      string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
-	Icon       string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
-	Url        string `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
-	Md5        string `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
-	Size       int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size"`
-	Version    string `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
-	Priority   uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority"`
-	Status     uint32 `protobuf:"varint,10,opt,name=status,proto3" json:"status"`
-	CreateTime int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *AddAppletReq) Reset() {
-	*x = AddAppletReq{}
+func (x *DelInvitationCodeReq) Reset() {
+	*x = DelInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3984,13 +4061,13 @@ func (x *AddAppletReq) Reset() {
 	}
 }
 
-func (x *AddAppletReq) String() string {
+func (x *DelInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAppletReq) ProtoMessage() {}
+func (*DelInvitationCodeReq) ProtoMessage() {}
 
-func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
+func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4002,96 +4079,26 @@ func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAppletReq.ProtoReflect.Descriptor instead.
-func (*AddAppletReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*DelInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *AddAppletReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetAppID() string {
-	if x != nil {
-		return x.AppID
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetIcon() string {
-	if x != nil {
-		return x.Icon
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetMd5() string {
-	if x != nil {
-		return x.Md5
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *AddAppletReq) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *AddAppletReq) GetPriority() uint32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
-}
-
-func (x *AddAppletReq) GetStatus() uint32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *AddAppletReq) GetCreateTime() int64 {
+func (x *DelInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.CreateTime
+		return x.Codes
 	}
-	return 0
+	return nil
 }
 
-type AddAppletResp struct {
+type DelInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddAppletResp) Reset() {
-	*x = AddAppletResp{}
+func (x *DelInvitationCodeResp) Reset() {
+	*x = DelInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4099,13 +4106,13 @@ func (x *AddAppletResp) Reset() {
 	}
 }
 
-func (x *AddAppletResp) String() string {
+func (x *DelInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAppletResp) ProtoMessage() {}
+func (*DelInvitationCodeResp) ProtoMessage() {}
 
-func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
+func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4117,21 +4124,24 @@ func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAppletResp.ProtoReflect.Descriptor instead.
-func (*AddAppletResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*DelInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{76}
 }
 
-type DelAppletReq struct {
+type InvitationRegister struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppletIds []string `protobuf:"bytes,1,rep,name=appletIds,proto3" json:"appletIds"`
+	InvitationCode string                 `protobuf:"bytes,1,opt,name=invitationCode,proto3" json:"invitationCode"`
+	CreateTime     int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	UsedUserID     string                 `protobuf:"bytes,3,opt,name=usedUserID,proto3" json:"usedUserID"`
+	UsedUser       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=usedUser,proto3" json:"usedUser"`
 }
 
-func (x *DelAppletReq) Reset() {
-	*x = DelAppletReq{}
+func (x *InvitationRegister) Reset() {
+	*x = InvitationRegister{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4139,13 +4149,13 @@ func (x *DelAppletReq) Reset() {
 	}
 }
 
-func (x *DelAppletReq) String() string {
+func (x *InvitationRegister) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelAppletReq) ProtoMessage() {}
+func (*InvitationRegister) ProtoMessage() {}
 
-func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
+func (x *InvitationRegister) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4157,91 +4167,68 @@ func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelAppletReq.ProtoReflect.Descriptor instead.
-func (*DelAppletReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvitationRegister.ProtoReflect.Descriptor instead.
+func (*InvitationRegister) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *DelAppletReq) GetAppletIds() []string {
+func (x *InvitationRegister) GetInvitationCode() string {
 	if x != nil {
-		return x.AppletIds
+		return x.InvitationCode
 	}
-	return nil
-}
-
-type DelAppletResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return ""
 }
 
-func (x *DelAppletResp) Reset() {
-	*x = DelAppletResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[78]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *InvitationRegister) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
 	}
+	return 0
 }
 
-func (x *DelAppletResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DelAppletResp) ProtoMessage() {}
-
-func (x *DelAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[78]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *InvitationRegister) GetUsedUserID() string {
+	if x != nil {
+		return x.UsedUserID
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DelAppletResp.ProtoReflect.Descriptor instead.
-func (*DelAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{78}
+func (x *InvitationRegister) GetUsedUser() *common.UserPublicInfo {
+	if x != nil {
+		return x.UsedUser
+	}
+	return nil
 }
 
-type UpdateAppletReq struct {
+type SearchInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	AppID      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
-	Icon       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
-	Url        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
-	Md5        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
-	Size       *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=size,proto3" json:"size"`
-	Version    *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
-	Priority   *wrapperspb.UInt32Value `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority"`
-	Status     *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
-	CreateTime *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=createTime,proto3" json:"createTime"`
+	Status     int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	UserIDs    []string                 `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	Codes      []string                 `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes"`
+	Keyword    string                   `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *UpdateAppletReq) Reset() {
-	*x = UpdateAppletReq{}
+func (x *SearchInvitationCodeReq) Reset() {
+	*x = SearchInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[79]
+		mi := &file_admin_admin_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAppletReq) String() string {
+func (x *SearchInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppletReq) ProtoMessage() {}
+func (*SearchInvitationCodeReq) ProtoMessage() {}
 
-func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[79]
+func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4252,96 +4239,112 @@ func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppletReq.ProtoReflect.Descriptor instead.
-func (*UpdateAppletReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use SearchInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*SearchInvitationCodeReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *UpdateAppletReq) GetId() string {
+func (x *SearchInvitationCodeReq) GetStatus() int32 {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateAppletReq) GetName() *wrapperspb.StringValue {
+func (x *SearchInvitationCodeReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Name
+		return x.UserIDs
 	}
 	return nil
 }
 
-func (x *UpdateAppletReq) GetAppID() *wrapperspb.StringValue {
+func (x *SearchInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.AppID
+		return x.Codes
 	}
 	return nil
 }
 
-func (x *UpdateAppletReq) GetIcon() *wrapperspb.StringValue {
+func (x *SearchInvitationCodeReq) GetKeyword() string {
 	if x != nil {
-		return x.Icon
+		return x.Keyword
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateAppletReq) GetUrl() *wrapperspb.StringValue {
+func (x *SearchInvitationCodeReq) GetPagination() *sdkws.RequestPagination {
 	if x != nil {
-		return x.Url
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *UpdateAppletReq) GetMd5() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Md5
-	}
-	return nil
+type SearchInvitationCodeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32                `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List  []*InvitationRegister `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 }
 
-func (x *UpdateAppletReq) GetSize() *wrapperspb.Int64Value {
-	if x != nil {
-		return x.Size
+func (x *SearchInvitationCodeResp) Reset() {
+	*x = SearchInvitationCodeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *UpdateAppletReq) GetVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Version
-	}
-	return nil
+func (x *SearchInvitationCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateAppletReq) GetPriority() *wrapperspb.UInt32Value {
-	if x != nil {
-		return x.Priority
+func (*SearchInvitationCodeResp) ProtoMessage() {}
+
+func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateAppletReq) GetStatus() *wrapperspb.UInt32Value {
+// Deprecated: Use SearchInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*SearchInvitationCodeResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SearchInvitationCodeResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Status
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *UpdateAppletReq) GetCreateTime() *wrapperspb.Int64Value {
+func (x *SearchInvitationCodeResp) GetList() []*InvitationRegister {
 	if x != nil {
-		return x.CreateTime
+		return x.List
 	}
 	return nil
 }
 
-type UpdateAppletResp struct {
+type SearchUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *UpdateAppletResp) Reset() {
-	*x = UpdateAppletResp{}
+func (x *SearchUserIPLimitLoginReq) Reset() {
+	*x = SearchUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4349,13 +4352,13 @@ func (x *UpdateAppletResp) Reset() {
 	}
 }
 
-func (x *UpdateAppletResp) String() string {
+func (x *SearchUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppletResp) ProtoMessage() {}
+func (*SearchUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
+func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4367,19 +4370,38 @@ func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppletResp.ProtoReflect.Descriptor instead.
-func (*UpdateAppletResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*SearchUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{80}
 }
 
-type FindAppletReq struct {
+func (x *SearchUserIPLimitLoginReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUserIPLimitLoginReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type LimitUserLoginIP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Ip         string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
+	CreateTime int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
+	User       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
 }
 
-func (x *FindAppletReq) Reset() {
-	*x = FindAppletReq{}
+func (x *LimitUserLoginIP) Reset() {
+	*x = LimitUserLoginIP{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4387,13 +4409,13 @@ func (x *FindAppletReq) Reset() {
 	}
 }
 
-func (x *FindAppletReq) String() string {
+func (x *LimitUserLoginIP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAppletReq) ProtoMessage() {}
+func (*LimitUserLoginIP) ProtoMessage() {}
 
-func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
+func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4405,35 +4427,64 @@ func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAppletReq.ProtoReflect.Descriptor instead.
-func (*FindAppletReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use LimitUserLoginIP.ProtoReflect.Descriptor instead.
+func (*LimitUserLoginIP) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{81}
 }
 
-type FindAppletResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Applets []*common.AppletInfo `protobuf:"bytes,1,rep,name=applets,proto3" json:"applets"`
+func (x *LimitUserLoginIP) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
 }
 
-func (x *FindAppletResp) Reset() {
-	*x = FindAppletResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[82]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LimitUserLoginIP) GetIp() string {
+	if x != nil {
+		return x.Ip
 	}
+	return ""
 }
 
-func (x *FindAppletResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LimitUserLoginIP) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
 }
 
-func (*FindAppletResp) ProtoMessage() {}
+func (x *LimitUserLoginIP) GetUser() *common.UserPublicInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
 
-func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
+type SearchUserIPLimitLoginResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Limits []*LimitUserLoginIP `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+}
+
+func (x *SearchUserIPLimitLoginResp) Reset() {
+	*x = SearchUserIPLimitLoginResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUserIPLimitLoginResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserIPLimitLoginResp) ProtoMessage() {}
+
+func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4445,29 +4496,36 @@ func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAppletResp.ProtoReflect.Descriptor instead.
-func (*FindAppletResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*SearchUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *FindAppletResp) GetApplets() []*common.AppletInfo {
+func (x *SearchUserIPLimitLoginResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Applets
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUserIPLimitLoginResp) GetLimits() []*LimitUserLoginIP {
+	if x != nil {
+		return x.Limits
 	}
 	return nil
 }
 
-type SearchAppletReq struct {
+type UserIPLimitLogin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Ip     string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *SearchAppletReq) Reset() {
-	*x = SearchAppletReq{}
+func (x *UserIPLimitLogin) Reset() {
+	*x = UserIPLimitLogin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4475,13 +4533,13 @@ func (x *SearchAppletReq) Reset() {
 	}
 }
 
-func (x *SearchAppletReq) String() string {
+func (x *UserIPLimitLogin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchAppletReq) ProtoMessage() {}
+func (*UserIPLimitLogin) ProtoMessage() {}
 
-func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
+func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4493,36 +4551,35 @@ func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchAppletReq.ProtoReflect.Descriptor instead.
-func (*SearchAppletReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserIPLimitLogin.ProtoReflect.Descriptor instead.
+func (*UserIPLimitLogin) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *SearchAppletReq) GetKeyword() string {
+func (x *UserIPLimitLogin) GetUserID() string {
 	if x != nil {
-		return x.Keyword
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SearchAppletReq) GetPagination() *sdkws.RequestPagination {
+func (x *UserIPLimitLogin) GetIp() string {
 	if x != nil {
-		return x.Pagination
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type SearchAppletResp struct {
+type AddUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total   uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Applets []*common.AppletInfo `protobuf:"bytes,2,rep,name=applets,proto3" json:"applets"`
+	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
 }
 
-func (x *SearchAppletResp) Reset() {
-	*x = SearchAppletResp{}
+func (x *AddUserIPLimitLoginReq) Reset() {
+	*x = AddUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4530,13 +4587,13 @@ func (x *SearchAppletResp) Reset() {
 	}
 }
 
-func (x *SearchAppletResp) String() string {
+func (x *AddUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchAppletResp) ProtoMessage() {}
+func (*AddUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *SearchAppletResp) ProtoReflect() protoreflect.Message {
+func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4548,35 +4605,26 @@ func (x *SearchAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchAppletResp.ProtoReflect.Descriptor instead.
-func (*SearchAppletResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*AddUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *SearchAppletResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchAppletResp) GetApplets() []*common.AppletInfo {
+func (x *AddUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
 	if x != nil {
-		return x.Applets
+		return x.Limits
 	}
 	return nil
 }
 
-type SetClientConfigReq struct {
+type AddUserIPLimitLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *SetClientConfigReq) Reset() {
-	*x = SetClientConfigReq{}
+func (x *AddUserIPLimitLoginResp) Reset() {
+	*x = AddUserIPLimitLoginResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4584,13 +4632,13 @@ func (x *SetClientConfigReq) Reset() {
 	}
 }
 
-func (x *SetClientConfigReq) String() string {
+func (x *AddUserIPLimitLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetClientConfigReq) ProtoMessage() {}
+func (*AddUserIPLimitLoginResp) ProtoMessage() {}
 
-func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4602,26 +4650,21 @@ func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetClientConfigReq.ProtoReflect.Descriptor instead.
-func (*SetClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*AddUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *SetClientConfigReq) GetConfig() map[string]string {
-	if x != nil {
-		return x.Config
-	}
-	return nil
-}
-
-type SetClientConfigResp struct {
+type DelUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
 }
 
-func (x *SetClientConfigResp) Reset() {
-	*x = SetClientConfigResp{}
+func (x *DelUserIPLimitLoginReq) Reset() {
+	*x = DelUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4629,13 +4672,13 @@ func (x *SetClientConfigResp) Reset() {
 	}
 }
 
-func (x *SetClientConfigResp) String() string {
+func (x *DelUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetClientConfigResp) ProtoMessage() {}
+func (*DelUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4647,21 +4690,26 @@ func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetClientConfigResp.ProtoReflect.Descriptor instead.
-func (*SetClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*DelUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{86}
 }
 
-type DelClientConfigReq struct {
+func (x *DelUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type DelUserIPLimitLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
 }
 
-func (x *DelClientConfigReq) Reset() {
-	*x = DelClientConfigReq{}
+func (x *DelUserIPLimitLoginResp) Reset() {
+	*x = DelUserIPLimitLoginResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4669,13 +4717,13 @@ func (x *DelClientConfigReq) Reset() {
 	}
 }
 
-func (x *DelClientConfigReq) String() string {
+func (x *DelUserIPLimitLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelClientConfigReq) ProtoMessage() {}
+func (*DelUserIPLimitLoginResp) ProtoMessage() {}
 
-func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4687,26 +4735,24 @@ func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelClientConfigReq.ProtoReflect.Descriptor instead.
-func (*DelClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*DelUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *DelClientConfigReq) GetKeys() []string {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-type DelClientConfigResp struct {
+type IPForbidden struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
+	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
+	CreateTime    int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *DelClientConfigResp) Reset() {
-	*x = DelClientConfigResp{}
+func (x *IPForbidden) Reset() {
+	*x = IPForbidden{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4714,13 +4760,13 @@ func (x *DelClientConfigResp) Reset() {
 	}
 }
 
-func (x *DelClientConfigResp) String() string {
+func (x *IPForbidden) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelClientConfigResp) ProtoMessage() {}
+func (*IPForbidden) ProtoMessage() {}
 
-func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *IPForbidden) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4732,19 +4778,51 @@ func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelClientConfigResp.ProtoReflect.Descriptor instead.
-func (*DelClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use IPForbidden.ProtoReflect.Descriptor instead.
+func (*IPForbidden) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{88}
 }
 
-type GetClientConfigReq struct {
+func (x *IPForbidden) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *IPForbidden) GetLimitRegister() bool {
+	if x != nil {
+		return x.LimitRegister
+	}
+	return false
+}
+
+func (x *IPForbidden) GetLimitLogin() bool {
+	if x != nil {
+		return x.LimitLogin
+	}
+	return false
+}
+
+func (x *IPForbidden) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type IPForbiddenAdd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
+	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
 }
 
-func (x *GetClientConfigReq) Reset() {
-	*x = GetClientConfigReq{}
+func (x *IPForbiddenAdd) Reset() {
+	*x = IPForbiddenAdd{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4752,13 +4830,13 @@ func (x *GetClientConfigReq) Reset() {
 	}
 }
 
-func (x *GetClientConfigReq) String() string {
+func (x *IPForbiddenAdd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientConfigReq) ProtoMessage() {}
+func (*IPForbiddenAdd) ProtoMessage() {}
 
-func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4770,21 +4848,44 @@ func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientConfigReq.ProtoReflect.Descriptor instead.
-func (*GetClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use IPForbiddenAdd.ProtoReflect.Descriptor instead.
+func (*IPForbiddenAdd) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{89}
 }
 
-type GetClientConfigResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (x *IPForbiddenAdd) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-func (x *GetClientConfigResp) Reset() {
-	*x = GetClientConfigResp{}
+func (x *IPForbiddenAdd) GetLimitRegister() bool {
+	if x != nil {
+		return x.LimitRegister
+	}
+	return false
+}
+
+func (x *IPForbiddenAdd) GetLimitLogin() bool {
+	if x != nil {
+		return x.LimitLogin
+	}
+	return false
+}
+
+type SearchIPForbiddenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Status     int32                    `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchIPForbiddenReq) Reset() {
+	*x = SearchIPForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4792,13 +4893,13 @@ func (x *GetClientConfigResp) Reset() {
 	}
 }
 
-func (x *GetClientConfigResp) String() string {
+func (x *SearchIPForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientConfigResp) ProtoMessage() {}
+func (*SearchIPForbiddenReq) ProtoMessage() {}
 
-func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4810,28 +4911,43 @@ func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientConfigResp.ProtoReflect.Descriptor instead.
-func (*GetClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*SearchIPForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetClientConfigResp) GetConfig() map[string]string {
+func (x *SearchIPForbiddenReq) GetKeyword() string {
 	if x != nil {
-		return x.Config
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchIPForbiddenReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SearchIPForbiddenReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type GetUserTokenReq struct {
+type SearchIPForbiddenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Total      uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Forbiddens []*IPForbidden `protobuf:"bytes,2,rep,name=forbiddens,proto3" json:"forbiddens"`
 }
 
-func (x *GetUserTokenReq) Reset() {
-	*x = GetUserTokenReq{}
+func (x *SearchIPForbiddenResp) Reset() {
+	*x = SearchIPForbiddenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4839,13 +4955,13 @@ func (x *GetUserTokenReq) Reset() {
 	}
 }
 
-func (x *GetUserTokenReq) String() string {
+func (x *SearchIPForbiddenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenReq) ProtoMessage() {}
+func (*SearchIPForbiddenResp) ProtoMessage() {}
 
-func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
+func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4857,28 +4973,35 @@ func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
-func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*SearchIPForbiddenResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetUserTokenReq) GetUserID() string {
+func (x *SearchIPForbiddenResp) GetTotal() uint32 {
 	if x != nil {
-		return x.UserID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type GetUserTokenResp struct {
+func (x *SearchIPForbiddenResp) GetForbiddens() []*IPForbidden {
+	if x != nil {
+		return x.Forbiddens
+	}
+	return nil
+}
+
+type AddIPForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokensMap map[string]int32 `protobuf:"bytes,1,rep,name=tokensMap,proto3" json:"tokensMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Forbiddens []*IPForbiddenAdd `protobuf:"bytes,1,rep,name=forbiddens,proto3" json:"forbiddens"`
 }
 
-func (x *GetUserTokenResp) Reset() {
-	*x = GetUserTokenResp{}
+func (x *AddIPForbiddenReq) Reset() {
+	*x = AddIPForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4886,13 +5009,13 @@ func (x *GetUserTokenResp) Reset() {
 	}
 }
 
-func (x *GetUserTokenResp) String() string {
+func (x *AddIPForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenResp) ProtoMessage() {}
+func (*AddIPForbiddenReq) ProtoMessage() {}
 
-func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
+func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4904,1033 +5027,5164 @@ func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
-func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*AddIPForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetUserTokenResp) GetTokensMap() map[string]int32 {
+func (x *AddIPForbiddenReq) GetForbiddens() []*IPForbiddenAdd {
 	if x != nil {
-		return x.TokensMap
+		return x.Forbiddens
 	}
 	return nil
 }
 
-var File_admin_admin_proto protoreflect.FileDescriptor
-
-var file_admin_admin_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x14, 0x70, 0x75, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
-	0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x75, 0x62,
-	0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x40, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0xc9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66,
-	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x3e, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6e, 0x69,
-	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22,
-	0x63, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61,
-	0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63,
-	0x65, 0x55, 0x52, 0x4c, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22, 0xcc,
-	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
-	0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2f, 0x0a,
-	0x13, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x16,
-	0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x16, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x6f, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x3e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x30, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12,
-	0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x22, 0x32, 0x0a, 0x14, 0x46, 0x69, 0x6e,
-	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x78, 0x0a,
-	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x14, 0x47,
-	0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x73, 0x22, 0x17, 0x0a,
-	0x15, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x3a, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x55,
-	0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
-	0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0xbb, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x3d,
-	0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x22, 0xc2, 0x01,
-	0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73,
-	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x7c,
-	0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a,
-	0x10, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49,
-	0x50, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x22, 0x6e, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x22, 0x3a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x54, 0x0a, 0x16,
-	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x54, 0x0a,
-	0x16, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83,
-	0x01, 0x0a, 0x0b, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24,
-	0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x8f, 0x01, 0x0a,
-	0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
-	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c,
-	0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3d, 0x0a,
-	0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x55, 0x0a, 0x11,
-	0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x12, 0x40, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x25, 0x0a, 0x11, 0x44, 0x65, 0x6c,
-	0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10,
-	0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73,
-	0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2b, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46,
-	0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x45,
-	0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3e, 0x0a,
-	0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x0f, 0x0a,
-	0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2a,
-	0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x6e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x75, 0x0a,
-	0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72,
-	0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72,
-	0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
-	0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x62,
-	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
-	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4c, 0x0a,
-	0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70,
-	0x65, 0x22, 0x27, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x61,
-	0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0x72, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75,
-	0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70,
-	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x70,
-	0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44,
-	0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x41, 0x64,
-	0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x0c, 0x44,
-	0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xeb, 0x04, 0x0a, 0x0f, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44,
-	0x12, 0x36, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x34,
-	0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x03, 0x6d, 0x64, 0x35, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72, 0x69,
-	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0x0a, 0x0d,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x22, 0x49, 0x0a,
-	0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x37, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
-	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a, 0x10,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22,
-	0x99, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x48, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13, 0x53,
-	0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x15, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x49, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0xc1, 0x1f, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x12, 0x40, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x5e, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64,
-	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x44, 0x65, 0x6c,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11,
-	0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x6a, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
-	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
-	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61,
-	0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x41, 0x64,
-	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x64, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65,
-	0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x14, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x2a,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x16, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x6a, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
-	0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x13, 0x44,
-	0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50,
-	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a,
-	0x0e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12,
-	0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x44, 0x65,
-	0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
-	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11,
-	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x73, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x28,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x73, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x41,
-	0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70,
-	0x6c, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x46,
-	0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x44, 0x4b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type AddIPForbiddenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_admin_admin_proto_rawDescOnce sync.Once
-	file_admin_admin_proto_rawDescData = file_admin_admin_proto_rawDesc
-)
-
-func file_admin_admin_proto_rawDescGZIP() []byte {
-	file_admin_admin_proto_rawDescOnce.Do(func() {
-		file_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_admin_proto_rawDescData)
-	})
-	return file_admin_admin_proto_rawDescData
+func (x *AddIPForbiddenResp) Reset() {
+	*x = AddIPForbiddenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
-var file_admin_admin_proto_goTypes = []interface{}{
-	(*LoginReq)(nil),                   // 0: OpenIMChat.admin.LoginReq
-	(*LoginResp)(nil),                  // 1: OpenIMChat.admin.LoginResp
-	(*AdminUpdateInfoReq)(nil),         // 2: OpenIMChat.admin.AdminUpdateInfoReq
-	(*AdminUpdateInfoResp)(nil),        // 3: OpenIMChat.admin.AdminUpdateInfoResp
-	(*ChangePasswordReq)(nil),          // 4: OpenIMChat.admin.ChangePasswordReq
-	(*ChangePasswordResp)(nil),         // 5: OpenIMChat.admin.ChangePasswordResp
-	(*GetAdminInfoReq)(nil),            // 6: OpenIMChat.admin.GetAdminInfoReq
-	(*GetAdminInfoResp)(nil),           // 7: OpenIMChat.admin.GetAdminInfoResp
-	(*AddDefaultFriendReq)(nil),        // 8: OpenIMChat.admin.AddDefaultFriendReq
-	(*AddDefaultFriendResp)(nil),       // 9: OpenIMChat.admin.AddDefaultFriendResp
-	(*DelDefaultFriendReq)(nil),        // 10: OpenIMChat.admin.DelDefaultFriendReq
-	(*DelDefaultFriendResp)(nil),       // 11: OpenIMChat.admin.DelDefaultFriendResp
-	(*FindDefaultFriendReq)(nil),       // 12: OpenIMChat.admin.FindDefaultFriendReq
-	(*FindDefaultFriendResp)(nil),      // 13: OpenIMChat.admin.FindDefaultFriendResp
-	(*SearchDefaultFriendReq)(nil),     // 14: OpenIMChat.admin.SearchDefaultFriendReq
-	(*DefaultFriendAttribute)(nil),     // 15: OpenIMChat.admin.DefaultFriendAttribute
-	(*SearchDefaultFriendResp)(nil),    // 16: OpenIMChat.admin.SearchDefaultFriendResp
-	(*AddDefaultGroupReq)(nil),         // 17: OpenIMChat.admin.AddDefaultGroupReq
-	(*AddDefaultGroupResp)(nil),        // 18: OpenIMChat.admin.AddDefaultGroupResp
-	(*DelDefaultGroupReq)(nil),         // 19: OpenIMChat.admin.DelDefaultGroupReq
-	(*DelDefaultGroupResp)(nil),        // 20: OpenIMChat.admin.DelDefaultGroupResp
-	(*FindDefaultGroupReq)(nil),        // 21: OpenIMChat.admin.FindDefaultGroupReq
-	(*FindDefaultGroupResp)(nil),       // 22: OpenIMChat.admin.FindDefaultGroupResp
-	(*SearchDefaultGroupReq)(nil),      // 23: OpenIMChat.admin.SearchDefaultGroupReq
-	(*GroupAttribute)(nil),             // 24: OpenIMChat.admin.GroupAttribute
-	(*SearchDefaultGroupResp)(nil),     // 25: OpenIMChat.admin.SearchDefaultGroupResp
-	(*AddInvitationCodeReq)(nil),       // 26: OpenIMChat.admin.AddInvitationCodeReq
-	(*AddInvitationCodeResp)(nil),      // 27: OpenIMChat.admin.AddInvitationCodeResp
-	(*GenInvitationCodeReq)(nil),       // 28: OpenIMChat.admin.GenInvitationCodeReq
-	(*GenInvitationCodeResp)(nil),      // 29: OpenIMChat.admin.GenInvitationCodeResp
-	(*FindInvitationCodeReq)(nil),      // 30: OpenIMChat.admin.FindInvitationCodeReq
-	(*FindInvitationCodeResp)(nil),     // 31: OpenIMChat.admin.FindInvitationCodeResp
-	(*UseInvitationCodeReq)(nil),       // 32: OpenIMChat.admin.UseInvitationCodeReq
-	(*UseInvitationCodeResp)(nil),      // 33: OpenIMChat.admin.UseInvitationCodeResp
-	(*DelInvitationCodeReq)(nil),       // 34: OpenIMChat.admin.DelInvitationCodeReq
-	(*DelInvitationCodeResp)(nil),      // 35: OpenIMChat.admin.DelInvitationCodeResp
-	(*InvitationRegister)(nil),         // 36: OpenIMChat.admin.InvitationRegister
-	(*SearchInvitationCodeReq)(nil),    // 37: OpenIMChat.admin.SearchInvitationCodeReq
-	(*SearchInvitationCodeResp)(nil),   // 38: OpenIMChat.admin.SearchInvitationCodeResp
-	(*SearchUserIPLimitLoginReq)(nil),  // 39: OpenIMChat.admin.SearchUserIPLimitLoginReq
-	(*LimitUserLoginIP)(nil),           // 40: OpenIMChat.admin.LimitUserLoginIP
-	(*SearchUserIPLimitLoginResp)(nil), // 41: OpenIMChat.admin.SearchUserIPLimitLoginResp
-	(*UserIPLimitLogin)(nil),           // 42: OpenIMChat.admin.UserIPLimitLogin
-	(*AddUserIPLimitLoginReq)(nil),     // 43: OpenIMChat.admin.AddUserIPLimitLoginReq
-	(*AddUserIPLimitLoginResp)(nil),    // 44: OpenIMChat.admin.AddUserIPLimitLoginResp
-	(*DelUserIPLimitLoginReq)(nil),     // 45: OpenIMChat.admin.DelUserIPLimitLoginReq
-	(*DelUserIPLimitLoginResp)(nil),    // 46: OpenIMChat.admin.DelUserIPLimitLoginResp
-	(*IPForbidden)(nil),                // 47: OpenIMChat.admin.IPForbidden
-	(*IPForbiddenAdd)(nil),             // 48: OpenIMChat.admin.IPForbiddenAdd
-	(*SearchIPForbiddenReq)(nil),       // 49: OpenIMChat.admin.SearchIPForbiddenReq
-	(*SearchIPForbiddenResp)(nil),      // 50: OpenIMChat.admin.SearchIPForbiddenResp
-	(*AddIPForbiddenReq)(nil),          // 51: OpenIMChat.admin.AddIPForbiddenReq
-	(*AddIPForbiddenResp)(nil),         // 52: OpenIMChat.admin.AddIPForbiddenResp
-	(*DelIPForbiddenReq)(nil),          // 53: OpenIMChat.admin.DelIPForbiddenReq
-	(*DelIPForbiddenResp)(nil),         // 54: OpenIMChat.admin.DelIPForbiddenResp
-	(*CheckRegisterForbiddenReq)(nil),  // 55: OpenIMChat.admin.CheckRegisterForbiddenReq
-	(*CheckRegisterForbiddenResp)(nil), // 56: OpenIMChat.admin.CheckRegisterForbiddenResp
-	(*CheckLoginForbiddenReq)(nil),     // 57: OpenIMChat.admin.CheckLoginForbiddenReq
-	(*CheckLoginForbiddenResp)(nil),    // 58: OpenIMChat.admin.CheckLoginForbiddenResp
-	(*CancellationUserReq)(nil),        // 59: OpenIMChat.admin.CancellationUserReq
-	(*CancellationUserResp)(nil),       // 60: OpenIMChat.admin.CancellationUserResp
-	(*BlockUserReq)(nil),               // 61: OpenIMChat.admin.BlockUserReq
-	(*BlockUserResp)(nil),              // 62: OpenIMChat.admin.BlockUserResp
-	(*UnblockUserReq)(nil),             // 63: OpenIMChat.admin.UnblockUserReq
-	(*UnblockUserResp)(nil),            // 64: OpenIMChat.admin.UnblockUserResp
-	(*SearchBlockUserReq)(nil),         // 65: OpenIMChat.admin.SearchBlockUserReq
-	(*BlockUserInfo)(nil),              // 66: OpenIMChat.admin.BlockUserInfo
-	(*SearchBlockUserResp)(nil),        // 67: OpenIMChat.admin.SearchBlockUserResp
-	(*FindUserBlockInfoReq)(nil),       // 68: OpenIMChat.admin.FindUserBlockInfoReq
-	(*BlockInfo)(nil),                  // 69: OpenIMChat.admin.BlockInfo
-	(*FindUserBlockInfoResp)(nil),      // 70: OpenIMChat.admin.FindUserBlockInfoResp
-	(*CreateTokenReq)(nil),             // 71: OpenIMChat.admin.CreateTokenReq
-	(*CreateTokenResp)(nil),            // 72: OpenIMChat.admin.CreateTokenResp
-	(*ParseTokenReq)(nil),              // 73: OpenIMChat.admin.ParseTokenReq
-	(*ParseTokenResp)(nil),             // 74: OpenIMChat.admin.ParseTokenResp
-	(*AddAppletReq)(nil),               // 75: OpenIMChat.admin.AddAppletReq
-	(*AddAppletResp)(nil),              // 76: OpenIMChat.admin.AddAppletResp
-	(*DelAppletReq)(nil),               // 77: OpenIMChat.admin.DelAppletReq
-	(*DelAppletResp)(nil),              // 78: OpenIMChat.admin.DelAppletResp
-	(*UpdateAppletReq)(nil),            // 79: OpenIMChat.admin.UpdateAppletReq
-	(*UpdateAppletResp)(nil),           // 80: OpenIMChat.admin.UpdateAppletResp
-	(*FindAppletReq)(nil),              // 81: OpenIMChat.admin.FindAppletReq
-	(*FindAppletResp)(nil),             // 82: OpenIMChat.admin.FindAppletResp
-	(*SearchAppletReq)(nil),            // 83: OpenIMChat.admin.SearchAppletReq
-	(*SearchAppletResp)(nil),           // 84: OpenIMChat.admin.SearchAppletResp
-	(*SetClientConfigReq)(nil),         // 85: OpenIMChat.admin.SetClientConfigReq
-	(*SetClientConfigResp)(nil),        // 86: OpenIMChat.admin.SetClientConfigResp
-	(*DelClientConfigReq)(nil),         // 87: OpenIMChat.admin.DelClientConfigReq
-	(*DelClientConfigResp)(nil),        // 88: OpenIMChat.admin.DelClientConfigResp
-	(*GetClientConfigReq)(nil),         // 89: OpenIMChat.admin.GetClientConfigReq
-	(*GetClientConfigResp)(nil),        // 90: OpenIMChat.admin.GetClientConfigResp
-	(*GetUserTokenReq)(nil),            // 91: OpenIMChat.admin.GetUserTokenReq
-	(*GetUserTokenResp)(nil),           // 92: OpenIMChat.admin.GetUserTokenResp
-	nil,                                // 93: OpenIMChat.admin.SetClientConfigReq.ConfigEntry
-	nil,                                // 94: OpenIMChat.admin.GetClientConfigResp.ConfigEntry
-	nil,                                // 95: OpenIMChat.admin.GetUserTokenResp.TokensMapEntry
-	(*wrapperspb.StringValue)(nil),     // 96: OpenIMServer.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),      // 97: OpenIMServer.protobuf.Int32Value
-	(*sdkws.RequestPagination)(nil),    // 98: OpenIMServer.sdkws.RequestPagination
-	(*common.UserPublicInfo)(nil),      // 99: OpenIMChat.common.UserPublicInfo
-	(*sdkws.GroupInfo)(nil),            // 100: OpenIMServer.sdkws.GroupInfo
-	(*wrapperspb.Int64Value)(nil),      // 101: OpenIMServer.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil),     // 102: OpenIMServer.protobuf.UInt32Value
-	(*common.AppletInfo)(nil),          // 103: OpenIMChat.common.AppletInfo
-}
-var file_admin_admin_proto_depIdxs = []int32{
-	96,  // 0: OpenIMChat.admin.AdminUpdateInfoReq.account:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 1: OpenIMChat.admin.AdminUpdateInfoReq.password:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 2: OpenIMChat.admin.AdminUpdateInfoReq.faceURL:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 3: OpenIMChat.admin.AdminUpdateInfoReq.nickname:type_name -> OpenIMServer.protobuf.StringValue
-	97,  // 4: OpenIMChat.admin.AdminUpdateInfoReq.level:type_name -> OpenIMServer.protobuf.Int32Value
-	98,  // 5: OpenIMChat.admin.SearchDefaultFriendReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	99,  // 6: OpenIMChat.admin.DefaultFriendAttribute.user:type_name -> OpenIMChat.common.UserPublicInfo
-	15,  // 7: OpenIMChat.admin.SearchDefaultFriendResp.users:type_name -> OpenIMChat.admin.DefaultFriendAttribute
-	98,  // 8: OpenIMChat.admin.SearchDefaultGroupReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	100, // 9: OpenIMChat.admin.GroupAttribute.group:type_name -> OpenIMServer.sdkws.GroupInfo
-	36,  // 10: OpenIMChat.admin.FindInvitationCodeResp.codes:type_name -> OpenIMChat.admin.InvitationRegister
-	99,  // 11: OpenIMChat.admin.InvitationRegister.usedUser:type_name -> OpenIMChat.common.UserPublicInfo
-	98,  // 12: OpenIMChat.admin.SearchInvitationCodeReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	36,  // 13: OpenIMChat.admin.SearchInvitationCodeResp.list:type_name -> OpenIMChat.admin.InvitationRegister
-	98,  // 14: OpenIMChat.admin.SearchUserIPLimitLoginReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	99,  // 15: OpenIMChat.admin.LimitUserLoginIP.user:type_name -> OpenIMChat.common.UserPublicInfo
-	40,  // 16: OpenIMChat.admin.SearchUserIPLimitLoginResp.limits:type_name -> OpenIMChat.admin.LimitUserLoginIP
-	42,  // 17: OpenIMChat.admin.AddUserIPLimitLoginReq.limits:type_name -> OpenIMChat.admin.UserIPLimitLogin
-	42,  // 18: OpenIMChat.admin.DelUserIPLimitLoginReq.limits:type_name -> OpenIMChat.admin.UserIPLimitLogin
-	98,  // 19: OpenIMChat.admin.SearchIPForbiddenReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	47,  // 20: OpenIMChat.admin.SearchIPForbiddenResp.forbiddens:type_name -> OpenIMChat.admin.IPForbidden
-	48,  // 21: OpenIMChat.admin.AddIPForbiddenReq.forbiddens:type_name -> OpenIMChat.admin.IPForbiddenAdd
-	98,  // 22: OpenIMChat.admin.SearchBlockUserReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	66,  // 23: OpenIMChat.admin.SearchBlockUserResp.users:type_name -> OpenIMChat.admin.BlockUserInfo
-	69,  // 24: OpenIMChat.admin.FindUserBlockInfoResp.blocks:type_name -> OpenIMChat.admin.BlockInfo
-	96,  // 25: OpenIMChat.admin.UpdateAppletReq.name:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 26: OpenIMChat.admin.UpdateAppletReq.appID:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 27: OpenIMChat.admin.UpdateAppletReq.icon:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 28: OpenIMChat.admin.UpdateAppletReq.url:type_name -> OpenIMServer.protobuf.StringValue
-	96,  // 29: OpenIMChat.admin.UpdateAppletReq.md5:type_name -> OpenIMServer.protobuf.StringValue
-	101, // 30: OpenIMChat.admin.UpdateAppletReq.size:type_name -> OpenIMServer.protobuf.Int64Value
-	96,  // 31: OpenIMChat.admin.UpdateAppletReq.version:type_name -> OpenIMServer.protobuf.StringValue
-	102, // 32: OpenIMChat.admin.UpdateAppletReq.priority:type_name -> OpenIMServer.protobuf.UInt32Value
-	102, // 33: OpenIMChat.admin.UpdateAppletReq.status:type_name -> OpenIMServer.protobuf.UInt32Value
-	101, // 34: OpenIMChat.admin.UpdateAppletReq.createTime:type_name -> OpenIMServer.protobuf.Int64Value
-	103, // 35: OpenIMChat.admin.FindAppletResp.applets:type_name -> OpenIMChat.common.AppletInfo
-	98,  // 36: OpenIMChat.admin.SearchAppletReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	103, // 37: OpenIMChat.admin.SearchAppletResp.applets:type_name -> OpenIMChat.common.AppletInfo
-	93,  // 38: OpenIMChat.admin.SetClientConfigReq.config:type_name -> OpenIMChat.admin.SetClientConfigReq.ConfigEntry
-	94,  // 39: OpenIMChat.admin.GetClientConfigResp.config:type_name -> OpenIMChat.admin.GetClientConfigResp.ConfigEntry
-	95,  // 40: OpenIMChat.admin.GetUserTokenResp.tokensMap:type_name -> OpenIMChat.admin.GetUserTokenResp.TokensMapEntry
-	0,   // 41: OpenIMChat.admin.admin.Login:input_type -> OpenIMChat.admin.LoginReq
-	4,   // 42: OpenIMChat.admin.admin.ChangePassword:input_type -> OpenIMChat.admin.ChangePasswordReq
-	2,   // 43: OpenIMChat.admin.admin.AdminUpdateInfo:input_type -> OpenIMChat.admin.AdminUpdateInfoReq
-	6,   // 44: OpenIMChat.admin.admin.GetAdminInfo:input_type -> OpenIMChat.admin.GetAdminInfoReq
-	8,   // 45: OpenIMChat.admin.admin.AddDefaultFriend:input_type -> OpenIMChat.admin.AddDefaultFriendReq
-	10,  // 46: OpenIMChat.admin.admin.DelDefaultFriend:input_type -> OpenIMChat.admin.DelDefaultFriendReq
-	12,  // 47: OpenIMChat.admin.admin.FindDefaultFriend:input_type -> OpenIMChat.admin.FindDefaultFriendReq
-	14,  // 48: OpenIMChat.admin.admin.SearchDefaultFriend:input_type -> OpenIMChat.admin.SearchDefaultFriendReq
-	17,  // 49: OpenIMChat.admin.admin.AddDefaultGroup:input_type -> OpenIMChat.admin.AddDefaultGroupReq
-	19,  // 50: OpenIMChat.admin.admin.DelDefaultGroup:input_type -> OpenIMChat.admin.DelDefaultGroupReq
-	21,  // 51: OpenIMChat.admin.admin.FindDefaultGroup:input_type -> OpenIMChat.admin.FindDefaultGroupReq
-	23,  // 52: OpenIMChat.admin.admin.SearchDefaultGroup:input_type -> OpenIMChat.admin.SearchDefaultGroupReq
-	26,  // 53: OpenIMChat.admin.admin.AddInvitationCode:input_type -> OpenIMChat.admin.AddInvitationCodeReq
-	28,  // 54: OpenIMChat.admin.admin.GenInvitationCode:input_type -> OpenIMChat.admin.GenInvitationCodeReq
-	30,  // 55: OpenIMChat.admin.admin.FindInvitationCode:input_type -> OpenIMChat.admin.FindInvitationCodeReq
-	32,  // 56: OpenIMChat.admin.admin.UseInvitationCode:input_type -> OpenIMChat.admin.UseInvitationCodeReq
-	34,  // 57: OpenIMChat.admin.admin.DelInvitationCode:input_type -> OpenIMChat.admin.DelInvitationCodeReq
-	37,  // 58: OpenIMChat.admin.admin.SearchInvitationCode:input_type -> OpenIMChat.admin.SearchInvitationCodeReq
-	39,  // 59: OpenIMChat.admin.admin.SearchUserIPLimitLogin:input_type -> OpenIMChat.admin.SearchUserIPLimitLoginReq
-	43,  // 60: OpenIMChat.admin.admin.AddUserIPLimitLogin:input_type -> OpenIMChat.admin.AddUserIPLimitLoginReq
-	45,  // 61: OpenIMChat.admin.admin.DelUserIPLimitLogin:input_type -> OpenIMChat.admin.DelUserIPLimitLoginReq
-	49,  // 62: OpenIMChat.admin.admin.SearchIPForbidden:input_type -> OpenIMChat.admin.SearchIPForbiddenReq
-	51,  // 63: OpenIMChat.admin.admin.AddIPForbidden:input_type -> OpenIMChat.admin.AddIPForbiddenReq
-	53,  // 64: OpenIMChat.admin.admin.DelIPForbidden:input_type -> OpenIMChat.admin.DelIPForbiddenReq
-	59,  // 65: OpenIMChat.admin.admin.CancellationUser:input_type -> OpenIMChat.admin.CancellationUserReq
-	61,  // 66: OpenIMChat.admin.admin.BlockUser:input_type -> OpenIMChat.admin.BlockUserReq
-	63,  // 67: OpenIMChat.admin.admin.UnblockUser:input_type -> OpenIMChat.admin.UnblockUserReq
-	65,  // 68: OpenIMChat.admin.admin.SearchBlockUser:input_type -> OpenIMChat.admin.SearchBlockUserReq
-	68,  // 69: OpenIMChat.admin.admin.FindUserBlockInfo:input_type -> OpenIMChat.admin.FindUserBlockInfoReq
-	55,  // 70: OpenIMChat.admin.admin.CheckRegisterForbidden:input_type -> OpenIMChat.admin.CheckRegisterForbiddenReq
-	57,  // 71: OpenIMChat.admin.admin.CheckLoginForbidden:input_type -> OpenIMChat.admin.CheckLoginForbiddenReq
-	71,  // 72: OpenIMChat.admin.admin.CreateToken:input_type -> OpenIMChat.admin.CreateTokenReq
-	73,  // 73: OpenIMChat.admin.admin.ParseToken:input_type -> OpenIMChat.admin.ParseTokenReq
-	75,  // 74: OpenIMChat.admin.admin.AddApplet:input_type -> OpenIMChat.admin.AddAppletReq
-	77,  // 75: OpenIMChat.admin.admin.DelApplet:input_type -> OpenIMChat.admin.DelAppletReq
-	79,  // 76: OpenIMChat.admin.admin.UpdateApplet:input_type -> OpenIMChat.admin.UpdateAppletReq
-	81,  // 77: OpenIMChat.admin.admin.FindApplet:input_type -> OpenIMChat.admin.FindAppletReq
-	83,  // 78: OpenIMChat.admin.admin.SearchApplet:input_type -> OpenIMChat.admin.SearchAppletReq
-	89,  // 79: OpenIMChat.admin.admin.GetClientConfig:input_type -> OpenIMChat.admin.GetClientConfigReq
-	85,  // 80: OpenIMChat.admin.admin.SetClientConfig:input_type -> OpenIMChat.admin.SetClientConfigReq
-	87,  // 81: OpenIMChat.admin.admin.DelClientConfig:input_type -> OpenIMChat.admin.DelClientConfigReq
-	91,  // 82: OpenIMChat.admin.admin.GetUserToken:input_type -> OpenIMChat.admin.GetUserTokenReq
-	1,   // 83: OpenIMChat.admin.admin.Login:output_type -> OpenIMChat.admin.LoginResp
-	5,   // 84: OpenIMChat.admin.admin.ChangePassword:output_type -> OpenIMChat.admin.ChangePasswordResp
-	3,   // 85: OpenIMChat.admin.admin.AdminUpdateInfo:output_type -> OpenIMChat.admin.AdminUpdateInfoResp
-	7,   // 86: OpenIMChat.admin.admin.GetAdminInfo:output_type -> OpenIMChat.admin.GetAdminInfoResp
-	9,   // 87: OpenIMChat.admin.admin.AddDefaultFriend:output_type -> OpenIMChat.admin.AddDefaultFriendResp
-	11,  // 88: OpenIMChat.admin.admin.DelDefaultFriend:output_type -> OpenIMChat.admin.DelDefaultFriendResp
-	13,  // 89: OpenIMChat.admin.admin.FindDefaultFriend:output_type -> OpenIMChat.admin.FindDefaultFriendResp
-	16,  // 90: OpenIMChat.admin.admin.SearchDefaultFriend:output_type -> OpenIMChat.admin.SearchDefaultFriendResp
-	18,  // 91: OpenIMChat.admin.admin.AddDefaultGroup:output_type -> OpenIMChat.admin.AddDefaultGroupResp
-	20,  // 92: OpenIMChat.admin.admin.DelDefaultGroup:output_type -> OpenIMChat.admin.DelDefaultGroupResp
-	22,  // 93: OpenIMChat.admin.admin.FindDefaultGroup:output_type -> OpenIMChat.admin.FindDefaultGroupResp
-	25,  // 94: OpenIMChat.admin.admin.SearchDefaultGroup:output_type -> OpenIMChat.admin.SearchDefaultGroupResp
-	27,  // 95: OpenIMChat.admin.admin.AddInvitationCode:output_type -> OpenIMChat.admin.AddInvitationCodeResp
-	29,  // 96: OpenIMChat.admin.admin.GenInvitationCode:output_type -> OpenIMChat.admin.GenInvitationCodeResp
-	31,  // 97: OpenIMChat.admin.admin.FindInvitationCode:output_type -> OpenIMChat.admin.FindInvitationCodeResp
-	33,  // 98: OpenIMChat.admin.admin.UseInvitationCode:output_type -> OpenIMChat.admin.UseInvitationCodeResp
-	35,  // 99: OpenIMChat.admin.admin.DelInvitationCode:output_type -> OpenIMChat.admin.DelInvitationCodeResp
-	38,  // 100: OpenIMChat.admin.admin.SearchInvitationCode:output_type -> OpenIMChat.admin.SearchInvitationCodeResp
-	41,  // 101: OpenIMChat.admin.admin.SearchUserIPLimitLogin:output_type -> OpenIMChat.admin.SearchUserIPLimitLoginResp
-	44,  // 102: OpenIMChat.admin.admin.AddUserIPLimitLogin:output_type -> OpenIMChat.admin.AddUserIPLimitLoginResp
-	46,  // 103: OpenIMChat.admin.admin.DelUserIPLimitLogin:output_type -> OpenIMChat.admin.DelUserIPLimitLoginResp
-	50,  // 104: OpenIMChat.admin.admin.SearchIPForbidden:output_type -> OpenIMChat.admin.SearchIPForbiddenResp
-	52,  // 105: OpenIMChat.admin.admin.AddIPForbidden:output_type -> OpenIMChat.admin.AddIPForbiddenResp
-	54,  // 106: OpenIMChat.admin.admin.DelIPForbidden:output_type -> OpenIMChat.admin.DelIPForbiddenResp
-	60,  // 107: OpenIMChat.admin.admin.CancellationUser:output_type -> OpenIMChat.admin.CancellationUserResp
-	62,  // 108: OpenIMChat.admin.admin.BlockUser:output_type -> OpenIMChat.admin.BlockUserResp
-	64,  // 109: OpenIMChat.admin.admin.UnblockUser:output_type -> OpenIMChat.admin.UnblockUserResp
-	67,  // 110: OpenIMChat.admin.admin.SearchBlockUser:output_type -> OpenIMChat.admin.SearchBlockUserResp
-	70,  // 111: OpenIMChat.admin.admin.FindUserBlockInfo:output_type -> OpenIMChat.admin.FindUserBlockInfoResp
-	56,  // 112: OpenIMChat.admin.admin.CheckRegisterForbidden:output_type -> OpenIMChat.admin.CheckRegisterForbiddenResp
-	58,  // 113: OpenIMChat.admin.admin.CheckLoginForbidden:output_type -> OpenIMChat.admin.CheckLoginForbiddenResp
-	72,  // 114: OpenIMChat.admin.admin.CreateToken:output_type -> OpenIMChat.admin.CreateTokenResp
-	74,  // 115: OpenIMChat.admin.admin.ParseToken:output_type -> OpenIMChat.admin.ParseTokenResp
-	76,  // 116: OpenIMChat.admin.admin.AddApplet:output_type -> OpenIMChat.admin.AddAppletResp
-	78,  // 117: OpenIMChat.admin.admin.DelApplet:output_type -> OpenIMChat.admin.DelAppletResp
-	80,  // 118: OpenIMChat.admin.admin.UpdateApplet:output_type -> OpenIMChat.admin.UpdateAppletResp
-	82,  // 119: OpenIMChat.admin.admin.FindApplet:output_type -> OpenIMChat.admin.FindAppletResp
-	84,  // 120: OpenIMChat.admin.admin.SearchApplet:output_type -> OpenIMChat.admin.SearchAppletResp
-	90,  // 121: OpenIMChat.admin.admin.GetClientConfig:output_type -> OpenIMChat.admin.GetClientConfigResp
-	86,  // 122: OpenIMChat.admin.admin.SetClientConfig:output_type -> OpenIMChat.admin.SetClientConfigResp
-	88,  // 123: OpenIMChat.admin.admin.DelClientConfig:output_type -> OpenIMChat.admin.DelClientConfigResp
-	92,  // 124: OpenIMChat.admin.admin.GetUserToken:output_type -> OpenIMChat.admin.GetUserTokenResp
-	83,  // [83:125] is the sub-list for method output_type
-	41,  // [41:83] is the sub-list for method input_type
-	41,  // [41:41] is the sub-list for extension type_name
-	41,  // [41:41] is the sub-list for extension extendee
-	0,   // [0:41] is the sub-list for field type_name
+func (x *AddIPForbiddenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_admin_admin_proto_init() }
-func file_admin_admin_proto_init() {
-	if File_admin_admin_proto != nil {
-		return
+func (*AddIPForbiddenResp) ProtoMessage() {}
+
+func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_admin_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReq); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*AddIPForbiddenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{93}
+}
+
+type DelIPForbiddenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ips []string `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips"`
+}
+
+func (x *DelIPForbiddenReq) Reset() {
+	*x = DelIPForbiddenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelIPForbiddenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelIPForbiddenReq) ProtoMessage() {}
+
+func (x *DelIPForbiddenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*DelIPForbiddenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DelIPForbiddenReq) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type DelIPForbiddenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelIPForbiddenResp) Reset() {
+	*x = DelIPForbiddenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelIPForbiddenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelIPForbiddenResp) ProtoMessage() {}
+
+func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*DelIPForbiddenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{95}
+}
+
+// ################### 用户限制 ###################
+type CheckRegisterForbiddenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+}
+
+func (x *CheckRegisterForbiddenReq) Reset() {
+	*x = CheckRegisterForbiddenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRegisterForbiddenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRegisterForbiddenReq) ProtoMessage() {}
+
+func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRegisterForbiddenReq.ProtoReflect.Descriptor instead.
+func (*CheckRegisterForbiddenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CheckRegisterForbiddenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type CheckRegisterForbiddenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckRegisterForbiddenResp) Reset() {
+	*x = CheckRegisterForbiddenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckRegisterForbiddenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckRegisterForbiddenResp) ProtoMessage() {}
+
+func (x *CheckRegisterForbiddenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckRegisterForbiddenResp.ProtoReflect.Descriptor instead.
+func (*CheckRegisterForbiddenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{97}
+}
+
+type CheckLoginForbiddenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip     string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *CheckLoginForbiddenReq) Reset() {
+	*x = CheckLoginForbiddenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLoginForbiddenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLoginForbiddenReq) ProtoMessage() {}
+
+func (x *CheckLoginForbiddenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLoginForbiddenReq.ProtoReflect.Descriptor instead.
+func (*CheckLoginForbiddenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CheckLoginForbiddenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CheckLoginForbiddenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type CheckLoginForbiddenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckLoginForbiddenResp) Reset() {
+	*x = CheckLoginForbiddenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckLoginForbiddenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckLoginForbiddenResp) ProtoMessage() {}
+
+func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckLoginForbiddenResp.ProtoReflect.Descriptor instead.
+func (*CheckLoginForbiddenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{99}
+}
+
+// ################### 注销 ###################
+type CancellationUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+}
+
+func (x *CancellationUserReq) Reset() {
+	*x = CancellationUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancellationUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationUserReq) ProtoMessage() {}
+
+func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationUserReq.ProtoReflect.Descriptor instead.
+func (*CancellationUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *CancellationUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancellationUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancellationUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancellationUserResp) Reset() {
+	*x = CancellationUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancellationUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationUserResp) ProtoMessage() {}
+
+func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationUserResp.ProtoReflect.Descriptor instead.
+func (*CancellationUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{101}
+}
+
+// ################### 封号、解封 ###################
+type BlockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category"` // spam fraud abuse other, 为空时为other
+	Days     int32  `protobuf:"varint,4,opt,name=days,proto3" json:"days"`        // 封禁天数, 0为永久
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *BlockUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type BlockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockUserResp) Reset() {
+	*x = BlockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResp) ProtoMessage() {}
+
+func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResp.ProtoReflect.Descriptor instead.
+func (*BlockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{103}
+}
+
+type UnblockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *UnblockUserReq) Reset() {
+	*x = UnblockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserReq) ProtoMessage() {}
+
+func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
+func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UnblockUserReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type UnblockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockUserResp) Reset() {
+	*x = UnblockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResp) ProtoMessage() {}
+
+func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResp.ProtoReflect.Descriptor instead.
+func (*UnblockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{105}
+}
+
+type SearchBlockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchBlockUserReq) Reset() {
+	*x = SearchBlockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockUserReq) ProtoMessage() {}
+
+func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockUserReq.ProtoReflect.Descriptor instead.
+func (*SearchBlockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *SearchBlockUserReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchBlockUserReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type BlockUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Account          string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	PhoneNumber      string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	AreaCode         string `protobuf:"bytes,4,opt,name=areaCode,proto3" json:"areaCode"`
+	Email            string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Nickname         string `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL          string `protobuf:"bytes,7,opt,name=faceURL,proto3" json:"faceURL"`
+	Gender           int32  `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
+	Reason           string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason"`
+	OpUserID         string `protobuf:"bytes,10,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime       int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+	Category         string `protobuf:"bytes,12,opt,name=category,proto3" json:"category"`
+	ExpireTime       int64  `protobuf:"varint,13,opt,name=expireTime,proto3" json:"expireTime"`             // 0为永久
+	RemainingSeconds int64  `protobuf:"varint,14,opt,name=remainingSeconds,proto3" json:"remainingSeconds"` // 剩余封禁时间, 永久封禁为0
+}
+
+func (x *BlockUserInfo) Reset() {
+	*x = BlockUserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserInfo) ProtoMessage() {}
+
+func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserInfo.ProtoReflect.Descriptor instead.
+func (*BlockUserInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *BlockUserInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *BlockUserInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockUserInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BlockUserInfo) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type SearchBlockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*BlockUserInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *SearchBlockUserResp) Reset() {
+	*x = SearchBlockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockUserResp) ProtoMessage() {}
+
+func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockUserResp.ProtoReflect.Descriptor instead.
+func (*SearchBlockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SearchBlockUserResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchBlockUserResp) GetUsers() []*BlockUserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type FindUserBlockInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *FindUserBlockInfoReq) Reset() {
+	*x = FindUserBlockInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserBlockInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserBlockInfoReq) ProtoMessage() {}
+
+func (x *FindUserBlockInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserBlockInfoReq.ProtoReflect.Descriptor instead.
+func (*FindUserBlockInfoReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *FindUserBlockInfoReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason           string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	OpUserID         string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime       int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	Category         string `protobuf:"bytes,5,opt,name=category,proto3" json:"category"`
+	ExpireTime       int64  `protobuf:"varint,6,opt,name=expireTime,proto3" json:"expireTime"`             // 0为永久
+	RemainingSeconds int64  `protobuf:"varint,7,opt,name=remainingSeconds,proto3" json:"remainingSeconds"` // 剩余封禁时间, 永久封禁为0
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *BlockInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetRemainingSeconds() int64 {
+	if x != nil {
+		return x.RemainingSeconds
+	}
+	return 0
+}
+
+type FindUserBlockInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockInfo `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (x *FindUserBlockInfoResp) Reset() {
+	*x = FindUserBlockInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserBlockInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserBlockInfoResp) ProtoMessage() {}
+
+func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserBlockInfoResp.ProtoReflect.Descriptor instead.
+func (*FindUserBlockInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *FindUserBlockInfoResp) GetBlocks() []*BlockInfo {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type BlockHistoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	Category        string `protobuf:"bytes,3,opt,name=category,proto3" json:"category"`
+	OpUserID        string `protobuf:"bytes,4,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime      int64  `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime      int64  `protobuf:"varint,6,opt,name=expireTime,proto3" json:"expireTime"`
+	UnblockType     int32  `protobuf:"varint,7,opt,name=unblockType,proto3" json:"unblockType"` // 1:管理员解封 2:到期自动解封
+	UnblockOpUserID string `protobuf:"bytes,8,opt,name=unblockOpUserID,proto3" json:"unblockOpUserID"`
+	UnblockTime     int64  `protobuf:"varint,9,opt,name=unblockTime,proto3" json:"unblockTime"`
+}
+
+func (x *BlockHistoryInfo) Reset() {
+	*x = BlockHistoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHistoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHistoryInfo) ProtoMessage() {}
+
+func (x *BlockHistoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHistoryInfo.ProtoReflect.Descriptor instead.
+func (*BlockHistoryInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *BlockHistoryInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockHistoryInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockHistoryInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *BlockHistoryInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockHistoryInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockHistoryInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BlockHistoryInfo) GetUnblockType() int32 {
+	if x != nil {
+		return x.UnblockType
+	}
+	return 0
+}
+
+func (x *BlockHistoryInfo) GetUnblockOpUserID() string {
+	if x != nil {
+		return x.UnblockOpUserID
+	}
+	return ""
+}
+
+func (x *BlockHistoryInfo) GetUnblockTime() int64 {
+	if x != nil {
+		return x.UnblockTime
+	}
+	return 0
+}
+
+type SearchBlockHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchBlockHistoryReq) Reset() {
+	*x = SearchBlockHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockHistoryReq) ProtoMessage() {}
+
+func (x *SearchBlockHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockHistoryReq.ProtoReflect.Descriptor instead.
+func (*SearchBlockHistoryReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *SearchBlockHistoryReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchBlockHistoryReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchBlockHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Histories []*BlockHistoryInfo `protobuf:"bytes,2,rep,name=histories,proto3" json:"histories"`
+}
+
+func (x *SearchBlockHistoryResp) Reset() {
+	*x = SearchBlockHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockHistoryResp) ProtoMessage() {}
+
+func (x *SearchBlockHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockHistoryResp.ProtoReflect.Descriptor instead.
+func (*SearchBlockHistoryResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *SearchBlockHistoryResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchBlockHistoryResp) GetHistories() []*BlockHistoryInfo {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type TokenSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform int32  `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
+	DeviceID string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip       string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+}
+
+func (x *TokenSession) Reset() {
+	*x = TokenSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenSession) ProtoMessage() {}
+
+func (x *TokenSession) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenSession.ProtoReflect.Descriptor instead.
+func (*TokenSession) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *TokenSession) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *TokenSession) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *TokenSession) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type CreateTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string        `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	UserType int32         `protobuf:"varint,3,opt,name=userType,proto3" json:"userType"`
+	Session  *TokenSession `protobuf:"bytes,4,opt,name=session,proto3" json:"session"` // 登录设备信息, 用于设备管理
+}
+
+func (x *CreateTokenReq) Reset() {
+	*x = CreateTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenReq) ProtoMessage() {}
+
+func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenReq.ProtoReflect.Descriptor instead.
+func (*CreateTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CreateTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *CreateTokenReq) GetSession() *TokenSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type CreateTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token        string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"` // 未配置refreshExpire时为空
+}
+
+func (x *CreateTokenResp) Reset() {
+	*x = CreateTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResp) ProtoMessage() {}
+
+func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResp.ProtoReflect.Descriptor instead.
+func (*CreateTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreateTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ParseTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+}
+
+func (x *ParseTokenReq) Reset() {
+	*x = ParseTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseTokenReq) ProtoMessage() {}
+
+func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseTokenReq.ProtoReflect.Descriptor instead.
+func (*ParseTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ParseTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ParseTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID            string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	UserType          int32  `protobuf:"varint,3,opt,name=userType,proto3" json:"userType"`
+	ExpireTimeSeconds int64  `protobuf:"varint,4,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+}
+
+func (x *ParseTokenResp) Reset() {
+	*x = ParseTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseTokenResp) ProtoMessage() {}
+
+func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseTokenResp.ProtoReflect.Descriptor instead.
+func (*ParseTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ParseTokenResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ParseTokenResp) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *ParseTokenResp) GetExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.ExpireTimeSeconds
+	}
+	return 0
+}
+
+type AddAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	AppID      string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
+	Icon       string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
+	Url        string `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
+	Md5        string `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
+	Size       int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size"`
+	Version    string `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
+	Priority   uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority"`
+	Status     uint32 `protobuf:"varint,10,opt,name=status,proto3" json:"status"`
+	CreateTime int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *AddAppletReq) Reset() {
+	*x = AddAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAppletReq) ProtoMessage() {}
+
+func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAppletReq.ProtoReflect.Descriptor instead.
+func (*AddAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *AddAppletReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetAppID() string {
+	if x != nil {
+		return x.AppID
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetMd5() string {
+	if x != nil {
+		return x.Md5
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AddAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddAppletResp) Reset() {
+	*x = AddAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAppletResp) ProtoMessage() {}
+
+func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAppletResp.ProtoReflect.Descriptor instead.
+func (*AddAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{121}
+}
+
+type DelAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppletIds []string `protobuf:"bytes,1,rep,name=appletIds,proto3" json:"appletIds"`
+}
+
+func (x *DelAppletReq) Reset() {
+	*x = DelAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAppletReq) ProtoMessage() {}
+
+func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAppletReq.ProtoReflect.Descriptor instead.
+func (*DelAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DelAppletReq) GetAppletIds() []string {
+	if x != nil {
+		return x.AppletIds
+	}
+	return nil
+}
+
+type DelAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelAppletResp) Reset() {
+	*x = DelAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAppletResp) ProtoMessage() {}
+
+func (x *DelAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAppletResp.ProtoReflect.Descriptor instead.
+func (*DelAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{123}
+}
+
+type UpdateAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	AppID      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
+	Icon       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
+	Url        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
+	Md5        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
+	Size       *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=size,proto3" json:"size"`
+	Version    *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
+	Priority   *wrapperspb.UInt32Value `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority"`
+	Status     *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
+	CreateTime *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *UpdateAppletReq) Reset() {
+	*x = UpdateAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppletReq) ProtoMessage() {}
+
+func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppletReq.ProtoReflect.Descriptor instead.
+func (*UpdateAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *UpdateAppletReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAppletReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetAppID() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AppID
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetIcon() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetMd5() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetSize() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetPriority() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetStatus() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetCreateTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type UpdateAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateAppletResp) Reset() {
+	*x = UpdateAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppletResp) ProtoMessage() {}
+
+func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppletResp.ProtoReflect.Descriptor instead.
+func (*UpdateAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{125}
+}
+
+type FindAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAppletReq) Reset() {
+	*x = FindAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAppletReq) ProtoMessage() {}
+
+func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAppletReq.ProtoReflect.Descriptor instead.
+func (*FindAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{126}
+}
+
+type FindAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applets []*common.AppletInfo `protobuf:"bytes,1,rep,name=applets,proto3" json:"applets"`
+}
+
+func (x *FindAppletResp) Reset() {
+	*x = FindAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAppletResp) ProtoMessage() {}
+
+func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAppletResp.ProtoReflect.Descriptor instead.
+func (*FindAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *FindAppletResp) GetApplets() []*common.AppletInfo {
+	if x != nil {
+		return x.Applets
+	}
+	return nil
+}
+
+type SearchAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchAppletReq) Reset() {
+	*x = SearchAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppletReq) ProtoMessage() {}
+
+func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppletReq.ProtoReflect.Descriptor instead.
+func (*SearchAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SearchAppletReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAppletReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Applets []*common.AppletInfo `protobuf:"bytes,2,rep,name=applets,proto3" json:"applets"`
+}
+
+func (x *SearchAppletResp) Reset() {
+	*x = SearchAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppletResp) ProtoMessage() {}
+
+func (x *SearchAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppletResp.ProtoReflect.Descriptor instead.
+func (*SearchAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *SearchAppletResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAppletResp) GetApplets() []*common.AppletInfo {
+	if x != nil {
+		return x.Applets
+	}
+	return nil
+}
+
+type SetClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetClientConfigReq) Reset() {
+	*x = SetClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClientConfigReq) ProtoMessage() {}
+
+func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClientConfigReq.ProtoReflect.Descriptor instead.
+func (*SetClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetClientConfigReq) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetClientConfigResp) Reset() {
+	*x = SetClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClientConfigResp) ProtoMessage() {}
+
+func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClientConfigResp.ProtoReflect.Descriptor instead.
+func (*SetClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{131}
+}
+
+type DelClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+}
+
+func (x *DelClientConfigReq) Reset() {
+	*x = DelClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelClientConfigReq) ProtoMessage() {}
+
+func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelClientConfigReq.ProtoReflect.Descriptor instead.
+func (*DelClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *DelClientConfigReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DelClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelClientConfigResp) Reset() {
+	*x = DelClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelClientConfigResp) ProtoMessage() {}
+
+func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelClientConfigResp.ProtoReflect.Descriptor instead.
+func (*DelClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{133}
+}
+
+type GetClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetClientConfigReq) Reset() {
+	*x = GetClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigReq) ProtoMessage() {}
+
+func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigReq.ProtoReflect.Descriptor instead.
+func (*GetClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{134}
+}
+
+type GetClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetClientConfigResp) Reset() {
+	*x = GetClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigResp) ProtoMessage() {}
+
+func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigResp.ProtoReflect.Descriptor instead.
+func (*GetClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetClientConfigResp) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetUserTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"` // 当前请求的token, 不为空时更新该设备最后活跃时间
+	Ip     string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+}
+
+func (x *GetUserTokenReq) Reset() {
+	*x = GetUserTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenReq) ProtoMessage() {}
+
+func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
+func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetUserTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetUserTokenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type GetUserTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokensMap map[string]int32 `protobuf:"bytes,1,rep,name=tokensMap,proto3" json:"tokensMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetUserTokenResp) Reset() {
+	*x = GetUserTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenResp) ProtoMessage() {}
+
+func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
+func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetUserTokenResp) GetTokensMap() map[string]int32 {
+	if x != nil {
+		return x.TokensMap
+	}
+	return nil
+}
+
+type InvalidateTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Token       string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`             // 为空时使该用户所有token失效
+	ExceptToken string `protobuf:"bytes,3,opt,name=exceptToken,proto3" json:"exceptToken"` // token为空时保留该token, 用于踢出当前设备以外的登录
+}
+
+func (x *InvalidateTokenReq) Reset() {
+	*x = InvalidateTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTokenReq) ProtoMessage() {}
+
+func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTokenReq.ProtoReflect.Descriptor instead.
+func (*InvalidateTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *InvalidateTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *InvalidateTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *InvalidateTokenReq) GetExceptToken() string {
+	if x != nil {
+		return x.ExceptToken
+	}
+	return ""
+}
+
+type InvalidateTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platforms []int32 `protobuf:"varint,1,rep,packed,name=platforms,proto3" json:"platforms"` // 被踢出设备的平台, 不包含保留token所在平台
+}
+
+func (x *InvalidateTokenResp) Reset() {
+	*x = InvalidateTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTokenResp) ProtoMessage() {}
+
+func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTokenResp.ProtoReflect.Descriptor instead.
+func (*InvalidateTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *InvalidateTokenResp) GetPlatforms() []int32 {
+	if x != nil {
+		return x.Platforms
+	}
+	return nil
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID    string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID"`
+	Platform     int32  `protobuf:"varint,2,opt,name=platform,proto3" json:"platform"`
+	DeviceID     string `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip           string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip"`
+	LoginTime    int64  `protobuf:"varint,5,opt,name=loginTime,proto3" json:"loginTime"`
+	LastSeenTime int64  `protobuf:"varint,6,opt,name=lastSeenTime,proto3" json:"lastSeenTime"`
+	Current      bool   `protobuf:"varint,7,opt,name=current,proto3" json:"current"`
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *SessionInfo) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetLoginTime() int64 {
+	if x != nil {
+		return x.LoginTime
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetLastSeenTime() int64 {
+	if x != nil {
+		return x.LastSeenTime
+	}
+	return 0
+}
+
+func (x *SessionInfo) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type GetUserSessionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Token  string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"` // 当前请求的token, 用于标记当前设备
+}
+
+func (x *GetUserSessionsReq) Reset() {
+	*x = GetUserSessionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserSessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSessionsReq) ProtoMessage() {}
+
+func (x *GetUserSessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSessionsReq.ProtoReflect.Descriptor instead.
+func (*GetUserSessionsReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetUserSessionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetUserSessionsReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetUserSessionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*SessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+}
+
+func (x *GetUserSessionsResp) Reset() {
+	*x = GetUserSessionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserSessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSessionsResp) ProtoMessage() {}
+
+func (x *GetUserSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSessionsResp.ProtoReflect.Descriptor instead.
+func (*GetUserSessionsResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetUserSessionsResp) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeUserSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID"`
+}
+
+func (x *RevokeUserSessionReq) Reset() {
+	*x = RevokeUserSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeUserSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionReq) ProtoMessage() {}
+
+func (x *RevokeUserSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionReq.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *RevokeUserSessionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeUserSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RevokeUserSessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform     int32 `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
+	RevokedToken bool  `protobuf:"varint,2,opt,name=revokedToken,proto3" json:"revokedToken"` // 是否吊销了access token, 只吊销refresh token时为false, 不需要踢下线
+}
+
+func (x *RevokeUserSessionResp) Reset() {
+	*x = RevokeUserSessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeUserSessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeUserSessionResp) ProtoMessage() {}
+
+func (x *RevokeUserSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeUserSessionResp.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RevokeUserSessionResp) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *RevokeUserSessionResp) GetRevokedToken() bool {
+	if x != nil {
+		return x.RevokedToken
+	}
+	return false
+}
+
+type RefreshTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken"`
+	UserType     int32  `protobuf:"varint,2,opt,name=userType,proto3" json:"userType"`
+	Ip           string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+}
+
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenReq) ProtoMessage() {}
+
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *RefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenReq) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *RefreshTokenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type RefreshTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Token        string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
+	Platform     int32  `protobuf:"varint,4,opt,name=platform,proto3" json:"platform"`
+}
+
+func (x *RefreshTokenResp) Reset() {
+	*x = RefreshTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResp) ProtoMessage() {}
+
+func (x *RefreshTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RefreshTokenResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+var File_admin_admin_proto protoreflect.FileDescriptor
+
+var file_admin_admin_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x14, 0x70, 0x75, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x75, 0x62,
+	0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x50, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x22, 0x89, 0x02, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x0f, 0x0a, 0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71,
+	0x22, 0x3a, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x24, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x37, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x24, 0x0a, 0x0e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x60, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f,
+	0x54, 0x50, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0xc9, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x3c, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x3e, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0x63, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22, 0xee,
+	0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
+	0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x34, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x56, 0x0a, 0x08,
+	0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x0d, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x22, 0x40, 0x0a, 0x0c, 0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5c, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x73, 0x22, 0x42, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0xb2, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x45, 0x0a, 0x11, 0x53, 0x65,
+	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x46, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x64, 0x49,
+	0x4d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22,
+	0x11, 0x0a, 0x0f, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x4d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x49, 0x4d, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x49, 0x4d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65,
+	0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc8,
+	0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0xec, 0x01, 0x0a,
+	0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x12, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x22, 0x5e, 0x0a, 0x10, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x40, 0x0a, 0x12, 0x41,
+	0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x22, 0x15, 0x0a,
+	0x13, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x2a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69,
+	0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x22, 0x78, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x68, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x21, 0x0a, 0x0f, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x65, 0x71, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x12,
+	0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x15,
+	0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x79, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x6f, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30,
+	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x22, 0x32,
+	0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x73, 0x22, 0x78, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x0e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4a, 0x0a,
+	0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61,
+	0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x15, 0x46,
+	0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x46, 0x69,
+	0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x22, 0x42, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0xbb, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x3d, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x22, 0xc2, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0x7c, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x91, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6e, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x70, 0x22, 0x54, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x41, 0x64, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x54, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x3a, 0x0a, 0x06,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x49, 0x50, 0x46,
+	0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65,
+	0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46,
+	0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x3d, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x73, 0x22, 0x55, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x50,
+	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x52, 0x0a, 0x66, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x49,
+	0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x25,
+	0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2b, 0x0a, 0x19, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x40, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x6e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61,
+	0x79, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x2a, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x11, 0x0a, 0x0f, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x75, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x03, 0x0a, 0x0d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x62, 0x0a, 0x13, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x30, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0xdf, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x22, 0xa8, 0x02, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x70, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x6e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x75, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x56, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x7e,
+	0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4b,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x50,
+	0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x72, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xeb, 0x04, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x36, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x34, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x3c, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x70, 0x72,
+	0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x0f, 0x0a,
+	0x0d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x22, 0x49,
+	0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x37, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x72, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x61, 0x0a,
+	0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73,
+	0x22, 0x99, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x48, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x15, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x9b, 0x01, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x49, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0xa1, 0x01, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f,
+	0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x64, 0x0a,
+	0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x33, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x50,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x4c, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x57,
+	0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x61, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x80, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x32, 0xc2, 0x30,
+	0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x1a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c,
+	0x54, 0x4f, 0x54, 0x50, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54,
+	0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x54, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x08,
+	0x46, 0x69, 0x6e, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x53,
+	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x4d, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x4d, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x4d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x4d, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x4d, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x4d, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x23, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x24, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x24, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44,
+	0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x27,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x50, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x55, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x50, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x50, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x49, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x25, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x44,
+	0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12,
+	0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x28, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5e, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5e, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x61, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x64, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a,
+	0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x6a, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x29,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x26,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46,
+	0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e,
+	0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x23,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55,
+	0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
+	0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a,
+	0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6a, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74,
+	0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1e,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x55, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x21, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x22,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_admin_admin_proto_rawDescOnce sync.Once
+	file_admin_admin_proto_rawDescData = file_admin_admin_proto_rawDesc
+)
+
+func file_admin_admin_proto_rawDescGZIP() []byte {
+	file_admin_admin_proto_rawDescOnce.Do(func() {
+		file_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_admin_proto_rawDescData)
+	})
+	return file_admin_admin_proto_rawDescData
+}
+
+var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 150)
+var file_admin_admin_proto_goTypes = []interface{}{
+	(*LoginReq)(nil),                   // 0: OpenIMChat.admin.LoginReq
+	(*LoginResp)(nil),                  // 1: OpenIMChat.admin.LoginResp
+	(*EnrollTOTPReq)(nil),              // 2: OpenIMChat.admin.EnrollTOTPReq
+	(*EnrollTOTPResp)(nil),             // 3: OpenIMChat.admin.EnrollTOTPResp
+	(*ConfirmTOTPReq)(nil),             // 4: OpenIMChat.admin.ConfirmTOTPReq
+	(*ConfirmTOTPResp)(nil),            // 5: OpenIMChat.admin.ConfirmTOTPResp
+	(*DisableTOTPReq)(nil),             // 6: OpenIMChat.admin.DisableTOTPReq
+	(*DisableTOTPResp)(nil),            // 7: OpenIMChat.admin.DisableTOTPResp
+	(*VerifyTOTPLoginReq)(nil),         // 8: OpenIMChat.admin.VerifyTOTPLoginReq
+	(*AdminUpdateInfoReq)(nil),         // 9: OpenIMChat.admin.AdminUpdateInfoReq
+	(*AdminUpdateInfoResp)(nil),        // 10: OpenIMChat.admin.AdminUpdateInfoResp
+	(*ChangePasswordReq)(nil),          // 11: OpenIMChat.admin.ChangePasswordReq
+	(*ChangePasswordResp)(nil),         // 12: OpenIMChat.admin.ChangePasswordResp
+	(*GetAdminInfoReq)(nil),            // 13: OpenIMChat.admin.GetAdminInfoReq
+	(*GetAdminInfoResp)(nil),           // 14: OpenIMChat.admin.GetAdminInfoResp
+	(*CheckPermissionReq)(nil),         // 15: OpenIMChat.admin.CheckPermissionReq
+	(*CheckPermissionResp)(nil),        // 16: OpenIMChat.admin.CheckPermissionResp
+	(*RoleInfo)(nil),                   // 17: OpenIMChat.admin.RoleInfo
+	(*FindRoleReq)(nil),                // 18: OpenIMChat.admin.FindRoleReq
+	(*FindRoleResp)(nil),               // 19: OpenIMChat.admin.FindRoleResp
+	(*AdminInfo)(nil),                  // 20: OpenIMChat.admin.AdminInfo
+	(*SearchAdminReq)(nil),             // 21: OpenIMChat.admin.SearchAdminReq
+	(*SearchAdminResp)(nil),            // 22: OpenIMChat.admin.SearchAdminResp
+	(*SetAdminLevelReq)(nil),           // 23: OpenIMChat.admin.SetAdminLevelReq
+	(*SetAdminLevelResp)(nil),          // 24: OpenIMChat.admin.SetAdminLevelResp
+	(*AddAdminAccountReq)(nil),         // 25: OpenIMChat.admin.AddAdminAccountReq
+	(*AddAdminAccountResp)(nil),        // 26: OpenIMChat.admin.AddAdminAccountResp
+	(*DelAdminAccountReq)(nil),         // 27: OpenIMChat.admin.DelAdminAccountReq
+	(*DelAdminAccountResp)(nil),        // 28: OpenIMChat.admin.DelAdminAccountResp
+	(*SetAdminStatusReq)(nil),          // 29: OpenIMChat.admin.SetAdminStatusReq
+	(*SetAdminStatusResp)(nil),         // 30: OpenIMChat.admin.SetAdminStatusResp
+	(*BindIMAdminReq)(nil),             // 31: OpenIMChat.admin.BindIMAdminReq
+	(*BindIMAdminResp)(nil),            // 32: OpenIMChat.admin.BindIMAdminResp
+	(*GetIMAdminReq)(nil),              // 33: OpenIMChat.admin.GetIMAdminReq
+	(*GetIMAdminResp)(nil),             // 34: OpenIMChat.admin.GetIMAdminResp
+	(*AuditLogInfo)(nil),               // 35: OpenIMChat.admin.AuditLogInfo
+	(*AddAuditLogReq)(nil),             // 36: OpenIMChat.admin.AddAuditLogReq
+	(*AddAuditLogResp)(nil),            // 37: OpenIMChat.admin.AddAuditLogResp
+	(*SearchAuditLogReq)(nil),          // 38: OpenIMChat.admin.SearchAuditLogReq
+	(*SearchAuditLogResp)(nil),         // 39: OpenIMChat.admin.SearchAuditLogResp
+	(*AdminIPAllowInfo)(nil),           // 40: OpenIMChat.admin.AdminIPAllowInfo
+	(*AddAdminIPAllowReq)(nil),         // 41: OpenIMChat.admin.AddAdminIPAllowReq
+	(*AddAdminIPAllowResp)(nil),        // 42: OpenIMChat.admin.AddAdminIPAllowResp
+	(*DelAdminIPAllowReq)(nil),         // 43: OpenIMChat.admin.DelAdminIPAllowReq
+	(*DelAdminIPAllowResp)(nil),        // 44: OpenIMChat.admin.DelAdminIPAllowResp
+	(*SearchAdminIPAllowReq)(nil),      // 45: OpenIMChat.admin.SearchAdminIPAllowReq
+	(*SearchAdminIPAllowResp)(nil),     // 46: OpenIMChat.admin.SearchAdminIPAllowResp
+	(*CheckAdminIPReq)(nil),            // 47: OpenIMChat.admin.CheckAdminIPReq
+	(*CheckAdminIPResp)(nil),           // 48: OpenIMChat.admin.CheckAdminIPResp
+	(*AddDefaultFriendReq)(nil),        // 49: OpenIMChat.admin.AddDefaultFriendReq
+	(*AddDefaultFriendResp)(nil),       // 50: OpenIMChat.admin.AddDefaultFriendResp
+	(*DelDefaultFriendReq)(nil),        // 51: OpenIMChat.admin.DelDefaultFriendReq
+	(*DelDefaultFriendResp)(nil),       // 52: OpenIMChat.admin.DelDefaultFriendResp
+	(*FindDefaultFriendReq)(nil),       // 53: OpenIMChat.admin.FindDefaultFriendReq
+	(*FindDefaultFriendResp)(nil),      // 54: OpenIMChat.admin.FindDefaultFriendResp
+	(*SearchDefaultFriendReq)(nil),     // 55: OpenIMChat.admin.SearchDefaultFriendReq
+	(*DefaultFriendAttribute)(nil),     // 56: OpenIMChat.admin.DefaultFriendAttribute
+	(*SearchDefaultFriendResp)(nil),    // 57: OpenIMChat.admin.SearchDefaultFriendResp
+	(*AddDefaultGroupReq)(nil),         // 58: OpenIMChat.admin.AddDefaultGroupReq
+	(*AddDefaultGroupResp)(nil),        // 59: OpenIMChat.admin.AddDefaultGroupResp
+	(*DelDefaultGroupReq)(nil),         // 60: OpenIMChat.admin.DelDefaultGroupReq
+	(*DelDefaultGroupResp)(nil),        // 61: OpenIMChat.admin.DelDefaultGroupResp
+	(*FindDefaultGroupReq)(nil),        // 62: OpenIMChat.admin.FindDefaultGroupReq
+	(*FindDefaultGroupResp)(nil),       // 63: OpenIMChat.admin.FindDefaultGroupResp
+	(*SearchDefaultGroupReq)(nil),      // 64: OpenIMChat.admin.SearchDefaultGroupReq
+	(*GroupAttribute)(nil),             // 65: OpenIMChat.admin.GroupAttribute
+	(*SearchDefaultGroupResp)(nil),     // 66: OpenIMChat.admin.SearchDefaultGroupResp
+	(*AddInvitationCodeReq)(nil),       // 67: OpenIMChat.admin.AddInvitationCodeReq
+	(*AddInvitationCodeResp)(nil),      // 68: OpenIMChat.admin.AddInvitationCodeResp
+	(*GenInvitationCodeReq)(nil),       // 69: OpenIMChat.admin.GenInvitationCodeReq
+	(*GenInvitationCodeResp)(nil),      // 70: OpenIMChat.admin.GenInvitationCodeResp
+	(*FindInvitationCodeReq)(nil),      // 71: OpenIMChat.admin.FindInvitationCodeReq
+	(*FindInvitationCodeResp)(nil),     // 72: OpenIMChat.admin.FindInvitationCodeResp
+	(*UseInvitationCodeReq)(nil),       // 73: OpenIMChat.admin.UseInvitationCodeReq
+	(*UseInvitationCodeResp)(nil),      // 74: OpenIMChat.admin.UseInvitationCodeResp
+	(*DelInvitationCodeReq)(nil),       // 75: OpenIMChat.admin.DelInvitationCodeReq
+	(*DelInvitationCodeResp)(nil),      // 76: OpenIMChat.admin.DelInvitationCodeResp
+	(*InvitationRegister)(nil),         // 77: OpenIMChat.admin.InvitationRegister
+	(*SearchInvitationCodeReq)(nil),    // 78: OpenIMChat.admin.SearchInvitationCodeReq
+	(*SearchInvitationCodeResp)(nil),   // 79: OpenIMChat.admin.SearchInvitationCodeResp
+	(*SearchUserIPLimitLoginReq)(nil),  // 80: OpenIMChat.admin.SearchUserIPLimitLoginReq
+	(*LimitUserLoginIP)(nil),           // 81: OpenIMChat.admin.LimitUserLoginIP
+	(*SearchUserIPLimitLoginResp)(nil), // 82: OpenIMChat.admin.SearchUserIPLimitLoginResp
+	(*UserIPLimitLogin)(nil),           // 83: OpenIMChat.admin.UserIPLimitLogin
+	(*AddUserIPLimitLoginReq)(nil),     // 84: OpenIMChat.admin.AddUserIPLimitLoginReq
+	(*AddUserIPLimitLoginResp)(nil),    // 85: OpenIMChat.admin.AddUserIPLimitLoginResp
+	(*DelUserIPLimitLoginReq)(nil),     // 86: OpenIMChat.admin.DelUserIPLimitLoginReq
+	(*DelUserIPLimitLoginResp)(nil),    // 87: OpenIMChat.admin.DelUserIPLimitLoginResp
+	(*IPForbidden)(nil),                // 88: OpenIMChat.admin.IPForbidden
+	(*IPForbiddenAdd)(nil),             // 89: OpenIMChat.admin.IPForbiddenAdd
+	(*SearchIPForbiddenReq)(nil),       // 90: OpenIMChat.admin.SearchIPForbiddenReq
+	(*SearchIPForbiddenResp)(nil),      // 91: OpenIMChat.admin.SearchIPForbiddenResp
+	(*AddIPForbiddenReq)(nil),          // 92: OpenIMChat.admin.AddIPForbiddenReq
+	(*AddIPForbiddenResp)(nil),         // 93: OpenIMChat.admin.AddIPForbiddenResp
+	(*DelIPForbiddenReq)(nil),          // 94: OpenIMChat.admin.DelIPForbiddenReq
+	(*DelIPForbiddenResp)(nil),         // 95: OpenIMChat.admin.DelIPForbiddenResp
+	(*CheckRegisterForbiddenReq)(nil),  // 96: OpenIMChat.admin.CheckRegisterForbiddenReq
+	(*CheckRegisterForbiddenResp)(nil), // 97: OpenIMChat.admin.CheckRegisterForbiddenResp
+	(*CheckLoginForbiddenReq)(nil),     // 98: OpenIMChat.admin.CheckLoginForbiddenReq
+	(*CheckLoginForbiddenResp)(nil),    // 99: OpenIMChat.admin.CheckLoginForbiddenResp
+	(*CancellationUserReq)(nil),        // 100: OpenIMChat.admin.CancellationUserReq
+	(*CancellationUserResp)(nil),       // 101: OpenIMChat.admin.CancellationUserResp
+	(*BlockUserReq)(nil),               // 102: OpenIMChat.admin.BlockUserReq
+	(*BlockUserResp)(nil),              // 103: OpenIMChat.admin.BlockUserResp
+	(*UnblockUserReq)(nil),             // 104: OpenIMChat.admin.UnblockUserReq
+	(*UnblockUserResp)(nil),            // 105: OpenIMChat.admin.UnblockUserResp
+	(*SearchBlockUserReq)(nil),         // 106: OpenIMChat.admin.SearchBlockUserReq
+	(*BlockUserInfo)(nil),              // 107: OpenIMChat.admin.BlockUserInfo
+	(*SearchBlockUserResp)(nil),        // 108: OpenIMChat.admin.SearchBlockUserResp
+	(*FindUserBlockInfoReq)(nil),       // 109: OpenIMChat.admin.FindUserBlockInfoReq
+	(*BlockInfo)(nil),                  // 110: OpenIMChat.admin.BlockInfo
+	(*FindUserBlockInfoResp)(nil),      // 111: OpenIMChat.admin.FindUserBlockInfoResp
+	(*BlockHistoryInfo)(nil),           // 112: OpenIMChat.admin.BlockHistoryInfo
+	(*SearchBlockHistoryReq)(nil),      // 113: OpenIMChat.admin.SearchBlockHistoryReq
+	(*SearchBlockHistoryResp)(nil),     // 114: OpenIMChat.admin.SearchBlockHistoryResp
+	(*TokenSession)(nil),               // 115: OpenIMChat.admin.TokenSession
+	(*CreateTokenReq)(nil),             // 116: OpenIMChat.admin.CreateTokenReq
+	(*CreateTokenResp)(nil),            // 117: OpenIMChat.admin.CreateTokenResp
+	(*ParseTokenReq)(nil),              // 118: OpenIMChat.admin.ParseTokenReq
+	(*ParseTokenResp)(nil),             // 119: OpenIMChat.admin.ParseTokenResp
+	(*AddAppletReq)(nil),               // 120: OpenIMChat.admin.AddAppletReq
+	(*AddAppletResp)(nil),              // 121: OpenIMChat.admin.AddAppletResp
+	(*DelAppletReq)(nil),               // 122: OpenIMChat.admin.DelAppletReq
+	(*DelAppletResp)(nil),              // 123: OpenIMChat.admin.DelAppletResp
+	(*UpdateAppletReq)(nil),            // 124: OpenIMChat.admin.UpdateAppletReq
+	(*UpdateAppletResp)(nil),           // 125: OpenIMChat.admin.UpdateAppletResp
+	(*FindAppletReq)(nil),              // 126: OpenIMChat.admin.FindAppletReq
+	(*FindAppletResp)(nil),             // 127: OpenIMChat.admin.FindAppletResp
+	(*SearchAppletReq)(nil),            // 128: OpenIMChat.admin.SearchAppletReq
+	(*SearchAppletResp)(nil),           // 129: OpenIMChat.admin.SearchAppletResp
+	(*SetClientConfigReq)(nil),         // 130: OpenIMChat.admin.SetClientConfigReq
+	(*SetClientConfigResp)(nil),        // 131: OpenIMChat.admin.SetClientConfigResp
+	(*DelClientConfigReq)(nil),         // 132: OpenIMChat.admin.DelClientConfigReq
+	(*DelClientConfigResp)(nil),        // 133: OpenIMChat.admin.DelClientConfigResp
+	(*GetClientConfigReq)(nil),         // 134: OpenIMChat.admin.GetClientConfigReq
+	(*GetClientConfigResp)(nil),        // 135: OpenIMChat.admin.GetClientConfigResp
+	(*GetUserTokenReq)(nil),            // 136: OpenIMChat.admin.GetUserTokenReq
+	(*GetUserTokenResp)(nil),           // 137: OpenIMChat.admin.GetUserTokenResp
+	(*InvalidateTokenReq)(nil),         // 138: OpenIMChat.admin.InvalidateTokenReq
+	(*InvalidateTokenResp)(nil),        // 139: OpenIMChat.admin.InvalidateTokenResp
+	(*SessionInfo)(nil),                // 140: OpenIMChat.admin.SessionInfo
+	(*GetUserSessionsReq)(nil),         // 141: OpenIMChat.admin.GetUserSessionsReq
+	(*GetUserSessionsResp)(nil),        // 142: OpenIMChat.admin.GetUserSessionsResp
+	(*RevokeUserSessionReq)(nil),       // 143: OpenIMChat.admin.RevokeUserSessionReq
+	(*RevokeUserSessionResp)(nil),      // 144: OpenIMChat.admin.RevokeUserSessionResp
+	(*RefreshTokenReq)(nil),            // 145: OpenIMChat.admin.RefreshTokenReq
+	(*RefreshTokenResp)(nil),           // 146: OpenIMChat.admin.RefreshTokenResp
+	nil,                                // 147: OpenIMChat.admin.SetClientConfigReq.ConfigEntry
+	nil,                                // 148: OpenIMChat.admin.GetClientConfigResp.ConfigEntry
+	nil,                                // 149: OpenIMChat.admin.GetUserTokenResp.TokensMapEntry
+	(*wrapperspb.StringValue)(nil),     // 150: OpenIMServer.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),      // 151: OpenIMServer.protobuf.Int32Value
+	(*sdkws.RequestPagination)(nil),    // 152: OpenIMServer.sdkws.RequestPagination
+	(*common.UserPublicInfo)(nil),      // 153: OpenIMChat.common.UserPublicInfo
+	(*sdkws.GroupInfo)(nil),            // 154: OpenIMServer.sdkws.GroupInfo
+	(*wrapperspb.Int64Value)(nil),      // 155: OpenIMServer.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),     // 156: OpenIMServer.protobuf.UInt32Value
+	(*common.AppletInfo)(nil),          // 157: OpenIMChat.common.AppletInfo
+}
+var file_admin_admin_proto_depIdxs = []int32{
+	150, // 0: OpenIMChat.admin.AdminUpdateInfoReq.account:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 1: OpenIMChat.admin.AdminUpdateInfoReq.password:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 2: OpenIMChat.admin.AdminUpdateInfoReq.faceURL:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 3: OpenIMChat.admin.AdminUpdateInfoReq.nickname:type_name -> OpenIMServer.protobuf.StringValue
+	151, // 4: OpenIMChat.admin.AdminUpdateInfoReq.level:type_name -> OpenIMServer.protobuf.Int32Value
+	17,  // 5: OpenIMChat.admin.FindRoleResp.roles:type_name -> OpenIMChat.admin.RoleInfo
+	152, // 6: OpenIMChat.admin.SearchAdminReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	20,  // 7: OpenIMChat.admin.SearchAdminResp.admins:type_name -> OpenIMChat.admin.AdminInfo
+	152, // 8: OpenIMChat.admin.SearchAuditLogReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	35,  // 9: OpenIMChat.admin.SearchAuditLogResp.logs:type_name -> OpenIMChat.admin.AuditLogInfo
+	152, // 10: OpenIMChat.admin.SearchAdminIPAllowReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	40,  // 11: OpenIMChat.admin.SearchAdminIPAllowResp.items:type_name -> OpenIMChat.admin.AdminIPAllowInfo
+	152, // 12: OpenIMChat.admin.SearchDefaultFriendReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	153, // 13: OpenIMChat.admin.DefaultFriendAttribute.user:type_name -> OpenIMChat.common.UserPublicInfo
+	56,  // 14: OpenIMChat.admin.SearchDefaultFriendResp.users:type_name -> OpenIMChat.admin.DefaultFriendAttribute
+	152, // 15: OpenIMChat.admin.SearchDefaultGroupReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	154, // 16: OpenIMChat.admin.GroupAttribute.group:type_name -> OpenIMServer.sdkws.GroupInfo
+	77,  // 17: OpenIMChat.admin.FindInvitationCodeResp.codes:type_name -> OpenIMChat.admin.InvitationRegister
+	153, // 18: OpenIMChat.admin.InvitationRegister.usedUser:type_name -> OpenIMChat.common.UserPublicInfo
+	152, // 19: OpenIMChat.admin.SearchInvitationCodeReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	77,  // 20: OpenIMChat.admin.SearchInvitationCodeResp.list:type_name -> OpenIMChat.admin.InvitationRegister
+	152, // 21: OpenIMChat.admin.SearchUserIPLimitLoginReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	153, // 22: OpenIMChat.admin.LimitUserLoginIP.user:type_name -> OpenIMChat.common.UserPublicInfo
+	81,  // 23: OpenIMChat.admin.SearchUserIPLimitLoginResp.limits:type_name -> OpenIMChat.admin.LimitUserLoginIP
+	83,  // 24: OpenIMChat.admin.AddUserIPLimitLoginReq.limits:type_name -> OpenIMChat.admin.UserIPLimitLogin
+	83,  // 25: OpenIMChat.admin.DelUserIPLimitLoginReq.limits:type_name -> OpenIMChat.admin.UserIPLimitLogin
+	152, // 26: OpenIMChat.admin.SearchIPForbiddenReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	88,  // 27: OpenIMChat.admin.SearchIPForbiddenResp.forbiddens:type_name -> OpenIMChat.admin.IPForbidden
+	89,  // 28: OpenIMChat.admin.AddIPForbiddenReq.forbiddens:type_name -> OpenIMChat.admin.IPForbiddenAdd
+	152, // 29: OpenIMChat.admin.SearchBlockUserReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	107, // 30: OpenIMChat.admin.SearchBlockUserResp.users:type_name -> OpenIMChat.admin.BlockUserInfo
+	110, // 31: OpenIMChat.admin.FindUserBlockInfoResp.blocks:type_name -> OpenIMChat.admin.BlockInfo
+	152, // 32: OpenIMChat.admin.SearchBlockHistoryReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	112, // 33: OpenIMChat.admin.SearchBlockHistoryResp.histories:type_name -> OpenIMChat.admin.BlockHistoryInfo
+	115, // 34: OpenIMChat.admin.CreateTokenReq.session:type_name -> OpenIMChat.admin.TokenSession
+	150, // 35: OpenIMChat.admin.UpdateAppletReq.name:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 36: OpenIMChat.admin.UpdateAppletReq.appID:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 37: OpenIMChat.admin.UpdateAppletReq.icon:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 38: OpenIMChat.admin.UpdateAppletReq.url:type_name -> OpenIMServer.protobuf.StringValue
+	150, // 39: OpenIMChat.admin.UpdateAppletReq.md5:type_name -> OpenIMServer.protobuf.StringValue
+	155, // 40: OpenIMChat.admin.UpdateAppletReq.size:type_name -> OpenIMServer.protobuf.Int64Value
+	150, // 41: OpenIMChat.admin.UpdateAppletReq.version:type_name -> OpenIMServer.protobuf.StringValue
+	156, // 42: OpenIMChat.admin.UpdateAppletReq.priority:type_name -> OpenIMServer.protobuf.UInt32Value
+	156, // 43: OpenIMChat.admin.UpdateAppletReq.status:type_name -> OpenIMServer.protobuf.UInt32Value
+	155, // 44: OpenIMChat.admin.UpdateAppletReq.createTime:type_name -> OpenIMServer.protobuf.Int64Value
+	157, // 45: OpenIMChat.admin.FindAppletResp.applets:type_name -> OpenIMChat.common.AppletInfo
+	152, // 46: OpenIMChat.admin.SearchAppletReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	157, // 47: OpenIMChat.admin.SearchAppletResp.applets:type_name -> OpenIMChat.common.AppletInfo
+	147, // 48: OpenIMChat.admin.SetClientConfigReq.config:type_name -> OpenIMChat.admin.SetClientConfigReq.ConfigEntry
+	148, // 49: OpenIMChat.admin.GetClientConfigResp.config:type_name -> OpenIMChat.admin.GetClientConfigResp.ConfigEntry
+	149, // 50: OpenIMChat.admin.GetUserTokenResp.tokensMap:type_name -> OpenIMChat.admin.GetUserTokenResp.TokensMapEntry
+	140, // 51: OpenIMChat.admin.GetUserSessionsResp.sessions:type_name -> OpenIMChat.admin.SessionInfo
+	0,   // 52: OpenIMChat.admin.admin.Login:input_type -> OpenIMChat.admin.LoginReq
+	11,  // 53: OpenIMChat.admin.admin.ChangePassword:input_type -> OpenIMChat.admin.ChangePasswordReq
+	2,   // 54: OpenIMChat.admin.admin.EnrollTOTP:input_type -> OpenIMChat.admin.EnrollTOTPReq
+	4,   // 55: OpenIMChat.admin.admin.ConfirmTOTP:input_type -> OpenIMChat.admin.ConfirmTOTPReq
+	6,   // 56: OpenIMChat.admin.admin.DisableTOTP:input_type -> OpenIMChat.admin.DisableTOTPReq
+	8,   // 57: OpenIMChat.admin.admin.VerifyTOTPLogin:input_type -> OpenIMChat.admin.VerifyTOTPLoginReq
+	9,   // 58: OpenIMChat.admin.admin.AdminUpdateInfo:input_type -> OpenIMChat.admin.AdminUpdateInfoReq
+	13,  // 59: OpenIMChat.admin.admin.GetAdminInfo:input_type -> OpenIMChat.admin.GetAdminInfoReq
+	15,  // 60: OpenIMChat.admin.admin.CheckPermission:input_type -> OpenIMChat.admin.CheckPermissionReq
+	18,  // 61: OpenIMChat.admin.admin.FindRole:input_type -> OpenIMChat.admin.FindRoleReq
+	21,  // 62: OpenIMChat.admin.admin.SearchAdmin:input_type -> OpenIMChat.admin.SearchAdminReq
+	23,  // 63: OpenIMChat.admin.admin.SetAdminLevel:input_type -> OpenIMChat.admin.SetAdminLevelReq
+	25,  // 64: OpenIMChat.admin.admin.AddAdminAccount:input_type -> OpenIMChat.admin.AddAdminAccountReq
+	27,  // 65: OpenIMChat.admin.admin.DelAdminAccount:input_type -> OpenIMChat.admin.DelAdminAccountReq
+	29,  // 66: OpenIMChat.admin.admin.SetAdminStatus:input_type -> OpenIMChat.admin.SetAdminStatusReq
+	31,  // 67: OpenIMChat.admin.admin.BindIMAdmin:input_type -> OpenIMChat.admin.BindIMAdminReq
+	33,  // 68: OpenIMChat.admin.admin.GetIMAdmin:input_type -> OpenIMChat.admin.GetIMAdminReq
+	36,  // 69: OpenIMChat.admin.admin.AddAuditLog:input_type -> OpenIMChat.admin.AddAuditLogReq
+	38,  // 70: OpenIMChat.admin.admin.SearchAuditLog:input_type -> OpenIMChat.admin.SearchAuditLogReq
+	41,  // 71: OpenIMChat.admin.admin.AddAdminIPAllow:input_type -> OpenIMChat.admin.AddAdminIPAllowReq
+	43,  // 72: OpenIMChat.admin.admin.DelAdminIPAllow:input_type -> OpenIMChat.admin.DelAdminIPAllowReq
+	45,  // 73: OpenIMChat.admin.admin.SearchAdminIPAllow:input_type -> OpenIMChat.admin.SearchAdminIPAllowReq
+	47,  // 74: OpenIMChat.admin.admin.CheckAdminIP:input_type -> OpenIMChat.admin.CheckAdminIPReq
+	49,  // 75: OpenIMChat.admin.admin.AddDefaultFriend:input_type -> OpenIMChat.admin.AddDefaultFriendReq
+	51,  // 76: OpenIMChat.admin.admin.DelDefaultFriend:input_type -> OpenIMChat.admin.DelDefaultFriendReq
+	53,  // 77: OpenIMChat.admin.admin.FindDefaultFriend:input_type -> OpenIMChat.admin.FindDefaultFriendReq
+	55,  // 78: OpenIMChat.admin.admin.SearchDefaultFriend:input_type -> OpenIMChat.admin.SearchDefaultFriendReq
+	58,  // 79: OpenIMChat.admin.admin.AddDefaultGroup:input_type -> OpenIMChat.admin.AddDefaultGroupReq
+	60,  // 80: OpenIMChat.admin.admin.DelDefaultGroup:input_type -> OpenIMChat.admin.DelDefaultGroupReq
+	62,  // 81: OpenIMChat.admin.admin.FindDefaultGroup:input_type -> OpenIMChat.admin.FindDefaultGroupReq
+	64,  // 82: OpenIMChat.admin.admin.SearchDefaultGroup:input_type -> OpenIMChat.admin.SearchDefaultGroupReq
+	67,  // 83: OpenIMChat.admin.admin.AddInvitationCode:input_type -> OpenIMChat.admin.AddInvitationCodeReq
+	69,  // 84: OpenIMChat.admin.admin.GenInvitationCode:input_type -> OpenIMChat.admin.GenInvitationCodeReq
+	71,  // 85: OpenIMChat.admin.admin.FindInvitationCode:input_type -> OpenIMChat.admin.FindInvitationCodeReq
+	73,  // 86: OpenIMChat.admin.admin.UseInvitationCode:input_type -> OpenIMChat.admin.UseInvitationCodeReq
+	75,  // 87: OpenIMChat.admin.admin.DelInvitationCode:input_type -> OpenIMChat.admin.DelInvitationCodeReq
+	78,  // 88: OpenIMChat.admin.admin.SearchInvitationCode:input_type -> OpenIMChat.admin.SearchInvitationCodeReq
+	80,  // 89: OpenIMChat.admin.admin.SearchUserIPLimitLogin:input_type -> OpenIMChat.admin.SearchUserIPLimitLoginReq
+	84,  // 90: OpenIMChat.admin.admin.AddUserIPLimitLogin:input_type -> OpenIMChat.admin.AddUserIPLimitLoginReq
+	86,  // 91: OpenIMChat.admin.admin.DelUserIPLimitLogin:input_type -> OpenIMChat.admin.DelUserIPLimitLoginReq
+	90,  // 92: OpenIMChat.admin.admin.SearchIPForbidden:input_type -> OpenIMChat.admin.SearchIPForbiddenReq
+	92,  // 93: OpenIMChat.admin.admin.AddIPForbidden:input_type -> OpenIMChat.admin.AddIPForbiddenReq
+	94,  // 94: OpenIMChat.admin.admin.DelIPForbidden:input_type -> OpenIMChat.admin.DelIPForbiddenReq
+	100, // 95: OpenIMChat.admin.admin.CancellationUser:input_type -> OpenIMChat.admin.CancellationUserReq
+	102, // 96: OpenIMChat.admin.admin.BlockUser:input_type -> OpenIMChat.admin.BlockUserReq
+	104, // 97: OpenIMChat.admin.admin.UnblockUser:input_type -> OpenIMChat.admin.UnblockUserReq
+	106, // 98: OpenIMChat.admin.admin.SearchBlockUser:input_type -> OpenIMChat.admin.SearchBlockUserReq
+	109, // 99: OpenIMChat.admin.admin.FindUserBlockInfo:input_type -> OpenIMChat.admin.FindUserBlockInfoReq
+	113, // 100: OpenIMChat.admin.admin.SearchBlockHistory:input_type -> OpenIMChat.admin.SearchBlockHistoryReq
+	96,  // 101: OpenIMChat.admin.admin.CheckRegisterForbidden:input_type -> OpenIMChat.admin.CheckRegisterForbiddenReq
+	98,  // 102: OpenIMChat.admin.admin.CheckLoginForbidden:input_type -> OpenIMChat.admin.CheckLoginForbiddenReq
+	116, // 103: OpenIMChat.admin.admin.CreateToken:input_type -> OpenIMChat.admin.CreateTokenReq
+	118, // 104: OpenIMChat.admin.admin.ParseToken:input_type -> OpenIMChat.admin.ParseTokenReq
+	120, // 105: OpenIMChat.admin.admin.AddApplet:input_type -> OpenIMChat.admin.AddAppletReq
+	122, // 106: OpenIMChat.admin.admin.DelApplet:input_type -> OpenIMChat.admin.DelAppletReq
+	124, // 107: OpenIMChat.admin.admin.UpdateApplet:input_type -> OpenIMChat.admin.UpdateAppletReq
+	126, // 108: OpenIMChat.admin.admin.FindApplet:input_type -> OpenIMChat.admin.FindAppletReq
+	128, // 109: OpenIMChat.admin.admin.SearchApplet:input_type -> OpenIMChat.admin.SearchAppletReq
+	134, // 110: OpenIMChat.admin.admin.GetClientConfig:input_type -> OpenIMChat.admin.GetClientConfigReq
+	130, // 111: OpenIMChat.admin.admin.SetClientConfig:input_type -> OpenIMChat.admin.SetClientConfigReq
+	132, // 112: OpenIMChat.admin.admin.DelClientConfig:input_type -> OpenIMChat.admin.DelClientConfigReq
+	136, // 113: OpenIMChat.admin.admin.GetUserToken:input_type -> OpenIMChat.admin.GetUserTokenReq
+	138, // 114: OpenIMChat.admin.admin.InvalidateToken:input_type -> OpenIMChat.admin.InvalidateTokenReq
+	141, // 115: OpenIMChat.admin.admin.GetUserSessions:input_type -> OpenIMChat.admin.GetUserSessionsReq
+	143, // 116: OpenIMChat.admin.admin.RevokeUserSession:input_type -> OpenIMChat.admin.RevokeUserSessionReq
+	145, // 117: OpenIMChat.admin.admin.RefreshToken:input_type -> OpenIMChat.admin.RefreshTokenReq
+	1,   // 118: OpenIMChat.admin.admin.Login:output_type -> OpenIMChat.admin.LoginResp
+	12,  // 119: OpenIMChat.admin.admin.ChangePassword:output_type -> OpenIMChat.admin.ChangePasswordResp
+	3,   // 120: OpenIMChat.admin.admin.EnrollTOTP:output_type -> OpenIMChat.admin.EnrollTOTPResp
+	5,   // 121: OpenIMChat.admin.admin.ConfirmTOTP:output_type -> OpenIMChat.admin.ConfirmTOTPResp
+	7,   // 122: OpenIMChat.admin.admin.DisableTOTP:output_type -> OpenIMChat.admin.DisableTOTPResp
+	1,   // 123: OpenIMChat.admin.admin.VerifyTOTPLogin:output_type -> OpenIMChat.admin.LoginResp
+	10,  // 124: OpenIMChat.admin.admin.AdminUpdateInfo:output_type -> OpenIMChat.admin.AdminUpdateInfoResp
+	14,  // 125: OpenIMChat.admin.admin.GetAdminInfo:output_type -> OpenIMChat.admin.GetAdminInfoResp
+	16,  // 126: OpenIMChat.admin.admin.CheckPermission:output_type -> OpenIMChat.admin.CheckPermissionResp
+	19,  // 127: OpenIMChat.admin.admin.FindRole:output_type -> OpenIMChat.admin.FindRoleResp
+	22,  // 128: OpenIMChat.admin.admin.SearchAdmin:output_type -> OpenIMChat.admin.SearchAdminResp
+	24,  // 129: OpenIMChat.admin.admin.SetAdminLevel:output_type -> OpenIMChat.admin.SetAdminLevelResp
+	26,  // 130: OpenIMChat.admin.admin.AddAdminAccount:output_type -> OpenIMChat.admin.AddAdminAccountResp
+	28,  // 131: OpenIMChat.admin.admin.DelAdminAccount:output_type -> OpenIMChat.admin.DelAdminAccountResp
+	30,  // 132: OpenIMChat.admin.admin.SetAdminStatus:output_type -> OpenIMChat.admin.SetAdminStatusResp
+	32,  // 133: OpenIMChat.admin.admin.BindIMAdmin:output_type -> OpenIMChat.admin.BindIMAdminResp
+	34,  // 134: OpenIMChat.admin.admin.GetIMAdmin:output_type -> OpenIMChat.admin.GetIMAdminResp
+	37,  // 135: OpenIMChat.admin.admin.AddAuditLog:output_type -> OpenIMChat.admin.AddAuditLogResp
+	39,  // 136: OpenIMChat.admin.admin.SearchAuditLog:output_type -> OpenIMChat.admin.SearchAuditLogResp
+	42,  // 137: OpenIMChat.admin.admin.AddAdminIPAllow:output_type -> OpenIMChat.admin.AddAdminIPAllowResp
+	44,  // 138: OpenIMChat.admin.admin.DelAdminIPAllow:output_type -> OpenIMChat.admin.DelAdminIPAllowResp
+	46,  // 139: OpenIMChat.admin.admin.SearchAdminIPAllow:output_type -> OpenIMChat.admin.SearchAdminIPAllowResp
+	48,  // 140: OpenIMChat.admin.admin.CheckAdminIP:output_type -> OpenIMChat.admin.CheckAdminIPResp
+	50,  // 141: OpenIMChat.admin.admin.AddDefaultFriend:output_type -> OpenIMChat.admin.AddDefaultFriendResp
+	52,  // 142: OpenIMChat.admin.admin.DelDefaultFriend:output_type -> OpenIMChat.admin.DelDefaultFriendResp
+	54,  // 143: OpenIMChat.admin.admin.FindDefaultFriend:output_type -> OpenIMChat.admin.FindDefaultFriendResp
+	57,  // 144: OpenIMChat.admin.admin.SearchDefaultFriend:output_type -> OpenIMChat.admin.SearchDefaultFriendResp
+	59,  // 145: OpenIMChat.admin.admin.AddDefaultGroup:output_type -> OpenIMChat.admin.AddDefaultGroupResp
+	61,  // 146: OpenIMChat.admin.admin.DelDefaultGroup:output_type -> OpenIMChat.admin.DelDefaultGroupResp
+	63,  // 147: OpenIMChat.admin.admin.FindDefaultGroup:output_type -> OpenIMChat.admin.FindDefaultGroupResp
+	66,  // 148: OpenIMChat.admin.admin.SearchDefaultGroup:output_type -> OpenIMChat.admin.SearchDefaultGroupResp
+	68,  // 149: OpenIMChat.admin.admin.AddInvitationCode:output_type -> OpenIMChat.admin.AddInvitationCodeResp
+	70,  // 150: OpenIMChat.admin.admin.GenInvitationCode:output_type -> OpenIMChat.admin.GenInvitationCodeResp
+	72,  // 151: OpenIMChat.admin.admin.FindInvitationCode:output_type -> OpenIMChat.admin.FindInvitationCodeResp
+	74,  // 152: OpenIMChat.admin.admin.UseInvitationCode:output_type -> OpenIMChat.admin.UseInvitationCodeResp
+	76,  // 153: OpenIMChat.admin.admin.DelInvitationCode:output_type -> OpenIMChat.admin.DelInvitationCodeResp
+	79,  // 154: OpenIMChat.admin.admin.SearchInvitationCode:output_type -> OpenIMChat.admin.SearchInvitationCodeResp
+	82,  // 155: OpenIMChat.admin.admin.SearchUserIPLimitLogin:output_type -> OpenIMChat.admin.SearchUserIPLimitLoginResp
+	85,  // 156: OpenIMChat.admin.admin.AddUserIPLimitLogin:output_type -> OpenIMChat.admin.AddUserIPLimitLoginResp
+	87,  // 157: OpenIMChat.admin.admin.DelUserIPLimitLogin:output_type -> OpenIMChat.admin.DelUserIPLimitLoginResp
+	91,  // 158: OpenIMChat.admin.admin.SearchIPForbidden:output_type -> OpenIMChat.admin.SearchIPForbiddenResp
+	93,  // 159: OpenIMChat.admin.admin.AddIPForbidden:output_type -> OpenIMChat.admin.AddIPForbiddenResp
+	95,  // 160: OpenIMChat.admin.admin.DelIPForbidden:output_type -> OpenIMChat.admin.DelIPForbiddenResp
+	101, // 161: OpenIMChat.admin.admin.CancellationUser:output_type -> OpenIMChat.admin.CancellationUserResp
+	103, // 162: OpenIMChat.admin.admin.BlockUser:output_type -> OpenIMChat.admin.BlockUserResp
+	105, // 163: OpenIMChat.admin.admin.UnblockUser:output_type -> OpenIMChat.admin.UnblockUserResp
+	108, // 164: OpenIMChat.admin.admin.SearchBlockUser:output_type -> OpenIMChat.admin.SearchBlockUserResp
+	111, // 165: OpenIMChat.admin.admin.FindUserBlockInfo:output_type -> OpenIMChat.admin.FindUserBlockInfoResp
+	114, // 166: OpenIMChat.admin.admin.SearchBlockHistory:output_type -> OpenIMChat.admin.SearchBlockHistoryResp
+	97,  // 167: OpenIMChat.admin.admin.CheckRegisterForbidden:output_type -> OpenIMChat.admin.CheckRegisterForbiddenResp
+	99,  // 168: OpenIMChat.admin.admin.CheckLoginForbidden:output_type -> OpenIMChat.admin.CheckLoginForbiddenResp
+	117, // 169: OpenIMChat.admin.admin.CreateToken:output_type -> OpenIMChat.admin.CreateTokenResp
+	119, // 170: OpenIMChat.admin.admin.ParseToken:output_type -> OpenIMChat.admin.ParseTokenResp
+	121, // 171: OpenIMChat.admin.admin.AddApplet:output_type -> OpenIMChat.admin.AddAppletResp
+	123, // 172: OpenIMChat.admin.admin.DelApplet:output_type -> OpenIMChat.admin.DelAppletResp
+	125, // 173: OpenIMChat.admin.admin.UpdateApplet:output_type -> OpenIMChat.admin.UpdateAppletResp
+	127, // 174: OpenIMChat.admin.admin.FindApplet:output_type -> OpenIMChat.admin.FindAppletResp
+	129, // 175: OpenIMChat.admin.admin.SearchApplet:output_type -> OpenIMChat.admin.SearchAppletResp
+	135, // 176: OpenIMChat.admin.admin.GetClientConfig:output_type -> OpenIMChat.admin.GetClientConfigResp
+	131, // 177: OpenIMChat.admin.admin.SetClientConfig:output_type -> OpenIMChat.admin.SetClientConfigResp
+	133, // 178: OpenIMChat.admin.admin.DelClientConfig:output_type -> OpenIMChat.admin.DelClientConfigResp
+	137, // 179: OpenIMChat.admin.admin.GetUserToken:output_type -> OpenIMChat.admin.GetUserTokenResp
+	139, // 180: OpenIMChat.admin.admin.InvalidateToken:output_type -> OpenIMChat.admin.InvalidateTokenResp
+	142, // 181: OpenIMChat.admin.admin.GetUserSessions:output_type -> OpenIMChat.admin.GetUserSessionsResp
+	144, // 182: OpenIMChat.admin.admin.RevokeUserSession:output_type -> OpenIMChat.admin.RevokeUserSessionResp
+	146, // 183: OpenIMChat.admin.admin.RefreshToken:output_type -> OpenIMChat.admin.RefreshTokenResp
+	118, // [118:184] is the sub-list for method output_type
+	52,  // [52:118] is the sub-list for method input_type
+	52,  // [52:52] is the sub-list for extension type_name
+	52,  // [52:52] is the sub-list for extension extendee
+	0,   // [0:52] is the sub-list for field type_name
+}
+
+func init() { file_admin_admin_proto_init() }
+func file_admin_admin_proto_init() {
+	if File_admin_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_admin_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTOTPLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUpdateInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminUpdateInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangePasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAdminInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckPermissionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAdminReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAdminResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAdminLevelReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAdminLevelResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAdminAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAdminAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAdminAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAdminAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAdminStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAdminStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindIMAdminReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindIMAdminResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIMAdminReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetIMAdminResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminIPAllowInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5941,8 +10195,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResp); i {
+		file_admin_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAdminIPAllowReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5953,8 +10207,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUpdateInfoReq); i {
+		file_admin_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAdminIPAllowResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5965,8 +10219,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AdminUpdateInfoResp); i {
+		file_admin_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAdminIPAllowReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5977,8 +10231,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePasswordReq); i {
+		file_admin_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAdminIPAllowResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5989,8 +10243,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangePasswordResp); i {
+		file_admin_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAdminIPAllowReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6001,8 +10255,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAdminInfoReq); i {
+		file_admin_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAdminIPAllowResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6013,8 +10267,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAdminInfoResp); i {
+		file_admin_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminIPReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6025,7 +10279,19 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAdminIPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDefaultFriendReq); i {
 			case 0:
 				return &v.state
@@ -6037,7 +10303,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDefaultFriendResp); i {
 			case 0:
 				return &v.state
@@ -6049,7 +10315,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelDefaultFriendReq); i {
 			case 0:
 				return &v.state
@@ -6061,7 +10327,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelDefaultFriendResp); i {
 			case 0:
 				return &v.state
@@ -6073,7 +10339,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindDefaultFriendReq); i {
 			case 0:
 				return &v.state
@@ -6085,7 +10351,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindDefaultFriendResp); i {
 			case 0:
 				return &v.state
@@ -6097,7 +10363,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchDefaultFriendReq); i {
 			case 0:
 				return &v.state
@@ -6109,7 +10375,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DefaultFriendAttribute); i {
 			case 0:
 				return &v.state
@@ -6121,7 +10387,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchDefaultFriendResp); i {
 			case 0:
 				return &v.state
@@ -6133,7 +10399,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDefaultGroupReq); i {
 			case 0:
 				return &v.state
@@ -6145,7 +10411,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddDefaultGroupResp); i {
 			case 0:
 				return &v.state
@@ -6157,7 +10423,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelDefaultGroupReq); i {
 			case 0:
 				return &v.state
@@ -6169,7 +10435,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelDefaultGroupResp); i {
 			case 0:
 				return &v.state
@@ -6181,7 +10447,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindDefaultGroupReq); i {
 			case 0:
 				return &v.state
@@ -6193,7 +10459,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindDefaultGroupResp); i {
 			case 0:
 				return &v.state
@@ -6205,7 +10471,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchDefaultGroupReq); i {
 			case 0:
 				return &v.state
@@ -6217,7 +10483,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GroupAttribute); i {
 			case 0:
 				return &v.state
@@ -6229,7 +10495,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchDefaultGroupResp); i {
 			case 0:
 				return &v.state
@@ -6241,7 +10507,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6253,7 +10519,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6265,7 +10531,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6277,7 +10543,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6289,7 +10555,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6301,7 +10567,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6313,7 +10579,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UseInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6325,7 +10591,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UseInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6337,7 +10603,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6349,7 +10615,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DelInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6361,7 +10627,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InvitationRegister); i {
 			case 0:
 				return &v.state
@@ -6373,7 +10639,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchInvitationCodeReq); i {
 			case 0:
 				return &v.state
@@ -6385,7 +10651,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchInvitationCodeResp); i {
 			case 0:
 				return &v.state
@@ -6397,7 +10663,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserIPLimitLoginReq); i {
 			case 0:
 				return &v.state
@@ -6409,7 +10675,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LimitUserLoginIP); i {
 			case 0:
 				return &v.state
@@ -6421,7 +10687,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserIPLimitLoginResp); i {
 			case 0:
 				return &v.state
@@ -6433,7 +10699,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserIPLimitLogin); i {
 			case 0:
 				return &v.state
@@ -6445,7 +10711,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_admin_admin_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddUserIPLimitLoginReq); i {
 			case 0:
 				return &v.state
@@ -6457,8 +10723,164 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddUserIPLimitLoginResp); i {
+		file_admin_admin_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddUserIPLimitLoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelUserIPLimitLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelUserIPLimitLoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPForbidden); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPForbiddenAdd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchIPForbiddenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchIPForbiddenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIPForbiddenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddIPForbiddenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelIPForbiddenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelIPForbiddenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRegisterForbiddenReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckRegisterForbiddenResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLoginForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6469,8 +10891,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelUserIPLimitLoginReq); i {
+		file_admin_admin_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckLoginForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6481,8 +10903,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelUserIPLimitLoginResp); i {
+		file_admin_admin_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancellationUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6493,8 +10915,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPForbidden); i {
+		file_admin_admin_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancellationUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6505,8 +10927,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPForbiddenAdd); i {
+		file_admin_admin_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6517,8 +10939,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6529,8 +10951,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6541,8 +10963,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6553,8 +10975,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBlockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6565,8 +10987,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockUserInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6577,8 +10999,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBlockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6589,8 +11011,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckRegisterForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserBlockInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6601,8 +11023,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckRegisterForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6613,8 +11035,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckLoginForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserBlockInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6625,8 +11047,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckLoginForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHistoryInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6637,8 +11059,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancellationUserReq); i {
+		file_admin_admin_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBlockHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6649,8 +11071,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancellationUserResp); i {
+		file_admin_admin_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBlockHistoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6661,8 +11083,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockUserReq); i {
+		file_admin_admin_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TokenSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6673,8 +11095,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockUserResp); i {
+		file_admin_admin_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6685,8 +11107,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockUserReq); i {
+		file_admin_admin_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6697,8 +11119,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnblockUserResp); i {
+		file_admin_admin_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6709,8 +11131,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBlockUserReq); i {
+		file_admin_admin_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6721,8 +11143,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockUserInfo); i {
+		file_admin_admin_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAppletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6733,8 +11155,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBlockUserResp); i {
+		file_admin_admin_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddAppletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6745,8 +11167,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserBlockInfoReq); i {
+		file_admin_admin_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAppletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6757,8 +11179,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockInfo); i {
+		file_admin_admin_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelAppletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6769,8 +11191,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserBlockInfoResp); i {
+		file_admin_admin_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6781,8 +11203,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenReq); i {
+		file_admin_admin_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAppletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6793,8 +11215,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTokenResp); i {
+		file_admin_admin_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAppletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6805,8 +11227,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParseTokenReq); i {
+		file_admin_admin_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindAppletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6817,8 +11239,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ParseTokenResp); i {
+		file_admin_admin_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAppletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6829,8 +11251,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddAppletReq); i {
+		file_admin_admin_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAppletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6841,8 +11263,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddAppletResp); i {
+		file_admin_admin_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClientConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6853,8 +11275,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelAppletReq); i {
+		file_admin_admin_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClientConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6865,8 +11287,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelAppletResp); i {
+		file_admin_admin_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelClientConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6877,8 +11299,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppletReq); i {
+		file_admin_admin_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelClientConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6889,8 +11311,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateAppletResp); i {
+		file_admin_admin_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientConfigReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6901,8 +11323,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAppletReq); i {
+		file_admin_admin_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientConfigResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6913,8 +11335,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindAppletResp); i {
+		file_admin_admin_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6925,8 +11347,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchAppletReq); i {
+		file_admin_admin_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6937,8 +11359,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchAppletResp); i {
+		file_admin_admin_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6949,8 +11371,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetClientConfigReq); i {
+		file_admin_admin_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvalidateTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6961,8 +11383,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetClientConfigResp); i {
+		file_admin_admin_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6973,8 +11395,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelClientConfigReq); i {
+		file_admin_admin_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserSessionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6985,8 +11407,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelClientConfigResp); i {
+		file_admin_admin_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserSessionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6997,8 +11419,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClientConfigReq); i {
+		file_admin_admin_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeUserSessionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7009,8 +11431,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClientConfigResp); i {
+		file_admin_admin_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeUserSessionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7021,8 +11443,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTokenReq); i {
+		file_admin_admin_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7033,8 +11455,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserTokenResp); i {
+		file_admin_admin_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefreshTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7052,7 +11474,7 @@ func file_admin_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   96,
+			NumMessages:   150,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7081,9 +11503,34 @@ type AdminClient interface {
 	// 登录
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	// 二次验证
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error)
+	VerifyTOTPLogin(ctx context.Context, in *VerifyTOTPLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	AdminUpdateInfo(ctx context.Context, in *AdminUpdateInfoReq, opts ...grpc.CallOption) (*AdminUpdateInfoResp, error)
 	// 获取管理员信息
 	GetAdminInfo(ctx context.Context, in *GetAdminInfoReq, opts ...grpc.CallOption) (*GetAdminInfoResp, error)
+	// 管理员角色
+	CheckPermission(ctx context.Context, in *CheckPermissionReq, opts ...grpc.CallOption) (*CheckPermissionResp, error)
+	FindRole(ctx context.Context, in *FindRoleReq, opts ...grpc.CallOption) (*FindRoleResp, error)
+	SearchAdmin(ctx context.Context, in *SearchAdminReq, opts ...grpc.CallOption) (*SearchAdminResp, error)
+	SetAdminLevel(ctx context.Context, in *SetAdminLevelReq, opts ...grpc.CallOption) (*SetAdminLevelResp, error)
+	// 管理员账号
+	AddAdminAccount(ctx context.Context, in *AddAdminAccountReq, opts ...grpc.CallOption) (*AddAdminAccountResp, error)
+	DelAdminAccount(ctx context.Context, in *DelAdminAccountReq, opts ...grpc.CallOption) (*DelAdminAccountResp, error)
+	SetAdminStatus(ctx context.Context, in *SetAdminStatusReq, opts ...grpc.CallOption) (*SetAdminStatusResp, error)
+	BindIMAdmin(ctx context.Context, in *BindIMAdminReq, opts ...grpc.CallOption) (*BindIMAdminResp, error)
+	// 获取管理员绑定的openIM管理员
+	GetIMAdmin(ctx context.Context, in *GetIMAdminReq, opts ...grpc.CallOption) (*GetIMAdminResp, error)
+	// 审计日志
+	AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error)
+	SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error)
+	// 管理后台ip白名单
+	AddAdminIPAllow(ctx context.Context, in *AddAdminIPAllowReq, opts ...grpc.CallOption) (*AddAdminIPAllowResp, error)
+	DelAdminIPAllow(ctx context.Context, in *DelAdminIPAllowReq, opts ...grpc.CallOption) (*DelAdminIPAllowResp, error)
+	SearchAdminIPAllow(ctx context.Context, in *SearchAdminIPAllowReq, opts ...grpc.CallOption) (*SearchAdminIPAllowResp, error)
+	CheckAdminIP(ctx context.Context, in *CheckAdminIPReq, opts ...grpc.CallOption) (*CheckAdminIPResp, error)
 	// 添加 移除 获取  注册时默认好友列表
 	AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(ctx context.Context, in *DelDefaultFriendReq, opts ...grpc.CallOption) (*DelDefaultFriendResp, error)
@@ -7114,6 +11561,7 @@ type AdminClient interface {
 	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error)
 	SearchBlockUser(ctx context.Context, in *SearchBlockUserReq, opts ...grpc.CallOption) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(ctx context.Context, in *FindUserBlockInfoReq, opts ...grpc.CallOption) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error)
 	CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(ctx context.Context, in *CheckLoginForbiddenReq, opts ...grpc.CallOption) (*CheckLoginForbiddenResp, error)
 	// 创建token
@@ -7131,46 +11579,223 @@ type AdminClient interface {
 	SetClientConfig(ctx context.Context, in *SetClientConfigReq, opts ...grpc.CallOption) (*SetClientConfigResp, error)
 	DelClientConfig(ctx context.Context, in *DelClientConfigReq, opts ...grpc.CallOption) (*DelClientConfigResp, error)
 	GetUserToken(ctx context.Context, in *GetUserTokenReq, opts ...grpc.CallOption) (*GetUserTokenResp, error)
+	// 使token失效(退出登录)
+	InvalidateToken(ctx context.Context, in *InvalidateTokenReq, opts ...grpc.CallOption) (*InvalidateTokenResp, error)
+	// 登录设备管理
+	GetUserSessions(ctx context.Context, in *GetUserSessionsReq, opts ...grpc.CallOption) (*GetUserSessionsResp, error)
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	out := new(ChangePasswordResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error) {
+	out := new(ConfirmTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error) {
+	out := new(DisableTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) VerifyTOTPLogin(ctx context.Context, in *VerifyTOTPLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/VerifyTOTPLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AdminUpdateInfo(ctx context.Context, in *AdminUpdateInfoReq, opts ...grpc.CallOption) (*AdminUpdateInfoResp, error) {
+	out := new(AdminUpdateInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/AdminUpdateInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetAdminInfo(ctx context.Context, in *GetAdminInfoReq, opts ...grpc.CallOption) (*GetAdminInfoResp, error) {
+	out := new(GetAdminInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/GetAdminInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckPermission(ctx context.Context, in *CheckPermissionReq, opts ...grpc.CallOption) (*CheckPermissionResp, error) {
+	out := new(CheckPermissionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/CheckPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) FindRole(ctx context.Context, in *FindRoleReq, opts ...grpc.CallOption) (*FindRoleResp, error) {
+	out := new(FindRoleResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/FindRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAdmin(ctx context.Context, in *SearchAdminReq, opts ...grpc.CallOption) (*SearchAdminResp, error) {
+	out := new(SearchAdminResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SearchAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetAdminLevel(ctx context.Context, in *SetAdminLevelReq, opts ...grpc.CallOption) (*SetAdminLevelResp, error) {
+	out := new(SetAdminLevelResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SetAdminLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddAdminAccount(ctx context.Context, in *AddAdminAccountReq, opts ...grpc.CallOption) (*AddAdminAccountResp, error) {
+	out := new(AddAdminAccountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/AddAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelAdminAccount(ctx context.Context, in *DelAdminAccountReq, opts ...grpc.CallOption) (*DelAdminAccountResp, error) {
+	out := new(DelAdminAccountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/DelAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetAdminStatus(ctx context.Context, in *SetAdminStatusReq, opts ...grpc.CallOption) (*SetAdminStatusResp, error) {
+	out := new(SetAdminStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SetAdminStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) BindIMAdmin(ctx context.Context, in *BindIMAdminReq, opts ...grpc.CallOption) (*BindIMAdminResp, error) {
+	out := new(BindIMAdminResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/BindIMAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetIMAdmin(ctx context.Context, in *GetIMAdminReq, opts ...grpc.CallOption) (*GetIMAdminResp, error) {
+	out := new(GetIMAdminResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/GetIMAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type adminClient struct {
-	cc grpc.ClientConnInterface
+func (c *adminClient) AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error) {
+	out := new(AddAuditLogResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/AddAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
-	return &adminClient{cc}
+func (c *adminClient) SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error) {
+	out := new(SearchAuditLogResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SearchAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *adminClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
-	out := new(LoginResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/Login", in, out, opts...)
+func (c *adminClient) AddAdminIPAllow(ctx context.Context, in *AddAdminIPAllowReq, opts ...grpc.CallOption) (*AddAdminIPAllowResp, error) {
+	out := new(AddAdminIPAllowResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/AddAdminIPAllow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
-	out := new(ChangePasswordResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/ChangePassword", in, out, opts...)
+func (c *adminClient) DelAdminIPAllow(ctx context.Context, in *DelAdminIPAllowReq, opts ...grpc.CallOption) (*DelAdminIPAllowResp, error) {
+	out := new(DelAdminIPAllowResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/DelAdminIPAllow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) AdminUpdateInfo(ctx context.Context, in *AdminUpdateInfoReq, opts ...grpc.CallOption) (*AdminUpdateInfoResp, error) {
-	out := new(AdminUpdateInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/AdminUpdateInfo", in, out, opts...)
+func (c *adminClient) SearchAdminIPAllow(ctx context.Context, in *SearchAdminIPAllowReq, opts ...grpc.CallOption) (*SearchAdminIPAllowResp, error) {
+	out := new(SearchAdminIPAllowResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SearchAdminIPAllow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *adminClient) GetAdminInfo(ctx context.Context, in *GetAdminInfoReq, opts ...grpc.CallOption) (*GetAdminInfoResp, error) {
-	out := new(GetAdminInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/GetAdminInfo", in, out, opts...)
+func (c *adminClient) CheckAdminIP(ctx context.Context, in *CheckAdminIPReq, opts ...grpc.CallOption) (*CheckAdminIPResp, error) {
+	out := new(CheckAdminIPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/CheckAdminIP", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -7402,6 +12027,15 @@ func (c *adminClient) FindUserBlockInfo(ctx context.Context, in *FindUserBlockIn
 	return out, nil
 }
 
+func (c *adminClient) SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error) {
+	out := new(SearchBlockHistoryResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/SearchBlockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error) {
 	out := new(CheckRegisterForbiddenResp)
 	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/CheckRegisterForbidden", in, out, opts...)
@@ -7519,14 +12153,75 @@ func (c *adminClient) GetUserToken(ctx context.Context, in *GetUserTokenReq, opt
 	return out, nil
 }
 
+func (c *adminClient) InvalidateToken(ctx context.Context, in *InvalidateTokenReq, opts ...grpc.CallOption) (*InvalidateTokenResp, error) {
+	out := new(InvalidateTokenResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/InvalidateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetUserSessions(ctx context.Context, in *GetUserSessionsReq, opts ...grpc.CallOption) (*GetUserSessionsResp, error) {
+	out := new(GetUserSessionsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/GetUserSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error) {
+	out := new(RevokeUserSessionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/RevokeUserSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.admin.admin/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	// 登录
 	Login(context.Context, *LoginReq) (*LoginResp, error)
 	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	// 二次验证
+	EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error)
+	DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error)
+	VerifyTOTPLogin(context.Context, *VerifyTOTPLoginReq) (*LoginResp, error)
 	AdminUpdateInfo(context.Context, *AdminUpdateInfoReq) (*AdminUpdateInfoResp, error)
 	// 获取管理员信息
 	GetAdminInfo(context.Context, *GetAdminInfoReq) (*GetAdminInfoResp, error)
+	// 管理员角色
+	CheckPermission(context.Context, *CheckPermissionReq) (*CheckPermissionResp, error)
+	FindRole(context.Context, *FindRoleReq) (*FindRoleResp, error)
+	SearchAdmin(context.Context, *SearchAdminReq) (*SearchAdminResp, error)
+	SetAdminLevel(context.Context, *SetAdminLevelReq) (*SetAdminLevelResp, error)
+	// 管理员账号
+	AddAdminAccount(context.Context, *AddAdminAccountReq) (*AddAdminAccountResp, error)
+	DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error)
+	SetAdminStatus(context.Context, *SetAdminStatusReq) (*SetAdminStatusResp, error)
+	BindIMAdmin(context.Context, *BindIMAdminReq) (*BindIMAdminResp, error)
+	// 获取管理员绑定的openIM管理员
+	GetIMAdmin(context.Context, *GetIMAdminReq) (*GetIMAdminResp, error)
+	// 审计日志
+	AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error)
+	SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error)
+	// 管理后台ip白名单
+	AddAdminIPAllow(context.Context, *AddAdminIPAllowReq) (*AddAdminIPAllowResp, error)
+	DelAdminIPAllow(context.Context, *DelAdminIPAllowReq) (*DelAdminIPAllowResp, error)
+	SearchAdminIPAllow(context.Context, *SearchAdminIPAllowReq) (*SearchAdminIPAllowResp, error)
+	CheckAdminIP(context.Context, *CheckAdminIPReq) (*CheckAdminIPResp, error)
 	// 添加 移除 获取  注册时默认好友列表
 	AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(context.Context, *DelDefaultFriendReq) (*DelDefaultFriendResp, error)
@@ -7557,6 +12252,7 @@ type AdminServer interface {
 	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error)
 	SearchBlockUser(context.Context, *SearchBlockUserReq) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error)
 	CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error)
 	// 创建token
@@ -7574,6 +12270,12 @@ type AdminServer interface {
 	SetClientConfig(context.Context, *SetClientConfigReq) (*SetClientConfigResp, error)
 	DelClientConfig(context.Context, *DelClientConfigReq) (*DelClientConfigResp, error)
 	GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error)
+	// 使token失效(退出登录)
+	InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error)
+	// 登录设备管理
+	GetUserSessions(context.Context, *GetUserSessionsReq) (*GetUserSessionsResp, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error)
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -7586,12 +12288,69 @@ func (*UnimplementedAdminServer) Login(context.Context, *LoginReq) (*LoginResp,
 func (*UnimplementedAdminServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (*UnimplementedAdminServer) EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedAdminServer) ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedAdminServer) DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedAdminServer) VerifyTOTPLogin(context.Context, *VerifyTOTPLoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTPLogin not implemented")
+}
 func (*UnimplementedAdminServer) AdminUpdateInfo(context.Context, *AdminUpdateInfoReq) (*AdminUpdateInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateInfo not implemented")
 }
 func (*UnimplementedAdminServer) GetAdminInfo(context.Context, *GetAdminInfoReq) (*GetAdminInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAdminInfo not implemented")
 }
+func (*UnimplementedAdminServer) CheckPermission(context.Context, *CheckPermissionReq) (*CheckPermissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (*UnimplementedAdminServer) FindRole(context.Context, *FindRoleReq) (*FindRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRole not implemented")
+}
+func (*UnimplementedAdminServer) SearchAdmin(context.Context, *SearchAdminReq) (*SearchAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAdmin not implemented")
+}
+func (*UnimplementedAdminServer) SetAdminLevel(context.Context, *SetAdminLevelReq) (*SetAdminLevelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminLevel not implemented")
+}
+func (*UnimplementedAdminServer) AddAdminAccount(context.Context, *AddAdminAccountReq) (*AddAdminAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAdminAccount not implemented")
+}
+func (*UnimplementedAdminServer) DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAdminAccount not implemented")
+}
+func (*UnimplementedAdminServer) SetAdminStatus(context.Context, *SetAdminStatusReq) (*SetAdminStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminStatus not implemented")
+}
+func (*UnimplementedAdminServer) BindIMAdmin(context.Context, *BindIMAdminReq) (*BindIMAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindIMAdmin not implemented")
+}
+func (*UnimplementedAdminServer) GetIMAdmin(context.Context, *GetIMAdminReq) (*GetIMAdminResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIMAdmin not implemented")
+}
+func (*UnimplementedAdminServer) AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditLog not implemented")
+}
+func (*UnimplementedAdminServer) SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLog not implemented")
+}
+func (*UnimplementedAdminServer) AddAdminIPAllow(context.Context, *AddAdminIPAllowReq) (*AddAdminIPAllowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAdminIPAllow not implemented")
+}
+func (*UnimplementedAdminServer) DelAdminIPAllow(context.Context, *DelAdminIPAllowReq) (*DelAdminIPAllowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAdminIPAllow not implemented")
+}
+func (*UnimplementedAdminServer) SearchAdminIPAllow(context.Context, *SearchAdminIPAllowReq) (*SearchAdminIPAllowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAdminIPAllow not implemented")
+}
+func (*UnimplementedAdminServer) CheckAdminIP(context.Context, *CheckAdminIPReq) (*CheckAdminIPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAdminIP not implemented")
+}
 func (*UnimplementedAdminServer) AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDefaultFriend not implemented")
 }
@@ -7667,6 +12426,9 @@ func (*UnimplementedAdminServer) SearchBlockUser(context.Context, *SearchBlockUs
 func (*UnimplementedAdminServer) FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserBlockInfo not implemented")
 }
+func (*UnimplementedAdminServer) SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlockHistory not implemented")
+}
 func (*UnimplementedAdminServer) CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegisterForbidden not implemented")
 }
@@ -7685,100 +12447,454 @@ func (*UnimplementedAdminServer) AddApplet(context.Context, *AddAppletReq) (*Add
 func (*UnimplementedAdminServer) DelApplet(context.Context, *DelAppletReq) (*DelAppletResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelApplet not implemented")
 }
-func (*UnimplementedAdminServer) UpdateApplet(context.Context, *UpdateAppletReq) (*UpdateAppletResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplet not implemented")
+func (*UnimplementedAdminServer) UpdateApplet(context.Context, *UpdateAppletReq) (*UpdateAppletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplet not implemented")
+}
+func (*UnimplementedAdminServer) FindApplet(context.Context, *FindAppletReq) (*FindAppletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindApplet not implemented")
+}
+func (*UnimplementedAdminServer) SearchApplet(context.Context, *SearchAppletReq) (*SearchAppletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchApplet not implemented")
+}
+func (*UnimplementedAdminServer) GetClientConfig(context.Context, *GetClientConfigReq) (*GetClientConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientConfig not implemented")
+}
+func (*UnimplementedAdminServer) SetClientConfig(context.Context, *SetClientConfigReq) (*SetClientConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClientConfig not implemented")
+}
+func (*UnimplementedAdminServer) DelClientConfig(context.Context, *DelClientConfigReq) (*DelClientConfigResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelClientConfig not implemented")
+}
+func (*UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserToken not implemented")
+}
+func (*UnimplementedAdminServer) InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateToken not implemented")
+}
+func (*UnimplementedAdminServer) GetUserSessions(context.Context, *GetUserSessionsReq) (*GetUserSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSessions not implemented")
+}
+func (*UnimplementedAdminServer) RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (*UnimplementedAdminServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).EnrollTOTP(ctx, req.(*EnrollTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DisableTOTP(ctx, req.(*DisableTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_VerifyTOTPLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTOTPLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).VerifyTOTPLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/VerifyTOTPLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).VerifyTOTPLogin(ctx, req.(*VerifyTOTPLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AdminUpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AdminUpdateInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/AdminUpdateInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AdminUpdateInfo(ctx, req.(*AdminUpdateInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetAdminInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetAdminInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/GetAdminInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetAdminInfo(ctx, req.(*GetAdminInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/CheckPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckPermission(ctx, req.(*CheckPermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FindRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FindRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/FindRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FindRole(ctx, req.(*FindRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/SearchAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchAdmin(ctx, req.(*SearchAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetAdminLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdminLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetAdminLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/SetAdminLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetAdminLevel(ctx, req.(*SetAdminLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) FindApplet(context.Context, *FindAppletReq) (*FindAppletResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindApplet not implemented")
+
+func _Admin_AddAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/AddAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddAdminAccount(ctx, req.(*AddAdminAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) SearchApplet(context.Context, *SearchAppletReq) (*SearchAppletResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchApplet not implemented")
+
+func _Admin_DelAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAdminAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/DelAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelAdminAccount(ctx, req.(*DelAdminAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) GetClientConfig(context.Context, *GetClientConfigReq) (*GetClientConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClientConfig not implemented")
+
+func _Admin_SetAdminStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdminStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetAdminStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/SetAdminStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetAdminStatus(ctx, req.(*SetAdminStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) SetClientConfig(context.Context, *SetClientConfigReq) (*SetClientConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetClientConfig not implemented")
+
+func _Admin_BindIMAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindIMAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).BindIMAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/BindIMAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).BindIMAdmin(ctx, req.(*BindIMAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) DelClientConfig(context.Context, *DelClientConfigReq) (*DelClientConfigResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DelClientConfig not implemented")
+
+func _Admin_GetIMAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIMAdminReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetIMAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/GetIMAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetIMAdmin(ctx, req.(*GetIMAdminReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserToken not implemented")
+
+func _Admin_AddAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/AddAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddAuditLog(ctx, req.(*AddAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
-	s.RegisterService(&_Admin_serviceDesc, srv)
+func _Admin_SearchAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/SearchAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchAuditLog(ctx, req.(*SearchAuditLogReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Admin_AddAdminIPAllow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminIPAllowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).Login(ctx, in)
+		return srv.(AdminServer).AddAdminIPAllow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.admin.admin/Login",
+		FullMethod: "/OpenIMChat.admin.admin/AddAdminIPAllow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).Login(ctx, req.(*LoginReq))
+		return srv.(AdminServer).AddAdminIPAllow(ctx, req.(*AddAdminIPAllowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _Admin_DelAdminIPAllow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAdminIPAllowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).ChangePassword(ctx, in)
+		return srv.(AdminServer).DelAdminIPAllow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.admin.admin/ChangePassword",
+		FullMethod: "/OpenIMChat.admin.admin/DelAdminIPAllow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(AdminServer).DelAdminIPAllow(ctx, req.(*DelAdminIPAllowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AdminUpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUpdateInfoReq)
+func _Admin_SearchAdminIPAllow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAdminIPAllowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AdminUpdateInfo(ctx, in)
+		return srv.(AdminServer).SearchAdminIPAllow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.admin.admin/AdminUpdateInfo",
+		FullMethod: "/OpenIMChat.admin.admin/SearchAdminIPAllow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AdminUpdateInfo(ctx, req.(*AdminUpdateInfoReq))
+		return srv.(AdminServer).SearchAdminIPAllow(ctx, req.(*SearchAdminIPAllowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GetAdminInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAdminInfoReq)
+func _Admin_CheckAdminIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminIPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GetAdminInfo(ctx, in)
+		return srv.(AdminServer).CheckAdminIP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.admin.admin/GetAdminInfo",
+		FullMethod: "/OpenIMChat.admin.admin/CheckAdminIP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GetAdminInfo(ctx, req.(*GetAdminInfoReq))
+		return srv.(AdminServer).CheckAdminIP(ctx, req.(*CheckAdminIPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -8233,6 +13349,24 @@ func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_SearchBlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlockHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchBlockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/SearchBlockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchBlockHistory(ctx, req.(*SearchBlockHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRegisterForbiddenReq)
 	if err := dec(in); err != nil {
@@ -8467,6 +13601,78 @@ func _Admin_GetUserToken_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_InvalidateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).InvalidateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/InvalidateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).InvalidateToken(ctx, req.(*InvalidateTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/GetUserSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetUserSessions(ctx, req.(*GetUserSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/RevokeUserSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RevokeUserSession(ctx, req.(*RevokeUserSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.admin.admin/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenIMChat.admin.admin",
 	HandlerType: (*AdminServer)(nil),
@@ -8479,6 +13685,22 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _Admin_ChangePassword_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Admin_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Admin_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Admin_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTOTPLogin",
+			Handler:    _Admin_VerifyTOTPLogin_Handler,
+		},
 		{
 			MethodName: "AdminUpdateInfo",
 			Handler:    _Admin_AdminUpdateInfo_Handler,
@@ -8487,6 +13709,66 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAdminInfo",
 			Handler:    _Admin_GetAdminInfo_Handler,
 		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Admin_CheckPermission_Handler,
+		},
+		{
+			MethodName: "FindRole",
+			Handler:    _Admin_FindRole_Handler,
+		},
+		{
+			MethodName: "SearchAdmin",
+			Handler:    _Admin_SearchAdmin_Handler,
+		},
+		{
+			MethodName: "SetAdminLevel",
+			Handler:    _Admin_SetAdminLevel_Handler,
+		},
+		{
+			MethodName: "AddAdminAccount",
+			Handler:    _Admin_AddAdminAccount_Handler,
+		},
+		{
+			MethodName: "DelAdminAccount",
+			Handler:    _Admin_DelAdminAccount_Handler,
+		},
+		{
+			MethodName: "SetAdminStatus",
+			Handler:    _Admin_SetAdminStatus_Handler,
+		},
+		{
+			MethodName: "BindIMAdmin",
+			Handler:    _Admin_BindIMAdmin_Handler,
+		},
+		{
+			MethodName: "GetIMAdmin",
+			Handler:    _Admin_GetIMAdmin_Handler,
+		},
+		{
+			MethodName: "AddAuditLog",
+			Handler:    _Admin_AddAuditLog_Handler,
+		},
+		{
+			MethodName: "SearchAuditLog",
+			Handler:    _Admin_SearchAuditLog_Handler,
+		},
+		{
+			MethodName: "AddAdminIPAllow",
+			Handler:    _Admin_AddAdminIPAllow_Handler,
+		},
+		{
+			MethodName: "DelAdminIPAllow",
+			Handler:    _Admin_DelAdminIPAllow_Handler,
+		},
+		{
+			MethodName: "SearchAdminIPAllow",
+			Handler:    _Admin_SearchAdminIPAllow_Handler,
+		},
+		{
+			MethodName: "CheckAdminIP",
+			Handler:    _Admin_CheckAdminIP_Handler,
+		},
 		{
 			MethodName: "AddDefaultFriend",
 			Handler:    _Admin_AddDefaultFriend_Handler,
@@ -8587,6 +13869,10 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserBlockInfo",
 			Handler:    _Admin_FindUserBlockInfo_Handler,
 		},
+		{
+			MethodName: "SearchBlockHistory",
+			Handler:    _Admin_SearchBlockHistory_Handler,
+		},
 		{
 			MethodName: "CheckRegisterForbidden",
 			Handler:    _Admin_CheckRegisterForbidden_Handler,
@@ -8639,6 +13925,22 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserToken",
 			Handler:    _Admin_GetUserToken_Handler,
 		},
+		{
+			MethodName: "InvalidateToken",
+			Handler:    _Admin_InvalidateToken_Handler,
+		},
+		{
+			MethodName: "GetUserSessions",
+			Handler:    _Admin_GetUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _Admin_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Admin_RefreshToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
@@ -18,6 +18,7 @@ import (
 	"github.com/OpenIMSDK/tools/utils"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/OpenIMSDK/chat/pkg/common/constant"
 	constant2 "github.com/OpenIMSDK/protocol/constant"
@@ -29,6 +30,7 @@ func (x *UpdateUserInfoReq) Check() error {
 		return errs.ErrArgs.Wrap("userID is empty")
 	}
 	if x.Email != nil && x.Email.Value != "" {
+		x.Email.Value = NormalizeEmail(x.Email.Value)
 		if err := EmailCheck(x.Email.Value); err != nil {
 			return err
 		}
@@ -64,31 +66,19 @@ func (x *FindUserFullInfoReq) Check() error {
 }
 
 func (x *SendVerifyCodeReq) Check() error {
-	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForLogin {
+	x.Email = NormalizeEmail(x.Email)
+	if x.UsedFor < constant.VerificationCodeForRegister || x.UsedFor > constant.VerificationCodeForVerifyOwner {
 		return errs.ErrArgs.Wrap("usedFor flied is empty")
 	}
-	if x.AreaCode == "" {
-		return errs.ErrArgs.Wrap("AreaCode is empty")
-	} else if err := AreaCodeCheck(x.AreaCode); err != nil {
-		return err
-	}
-	if x.PhoneNumber == "" {
-		return errs.ErrArgs.Wrap("PhoneNumber is empty")
-	} else if err := PhoneNumberCheck(x.PhoneNumber); err != nil {
+	if err := phoneOrEmailCheck(x.AreaCode, x.PhoneNumber, x.Email); err != nil {
 		return err
 	}
 	return nil
 }
 
 func (x *VerifyCodeReq) Check() error {
-	if x.AreaCode == "" {
-		return errs.ErrArgs.Wrap("AreaCode is empty")
-	} else if err := AreaCodeCheck(x.AreaCode); err != nil {
-		return err
-	}
-	if x.PhoneNumber == "" {
-		return errs.ErrArgs.Wrap("PhoneNumber is empty")
-	} else if err := PhoneNumberCheck(x.PhoneNumber); err != nil {
+	x.Email = NormalizeEmail(x.Email)
+	if err := phoneOrEmailCheck(x.AreaCode, x.PhoneNumber, x.Email); err != nil {
 		return err
 	}
 	if x.VerifyCode == "" {
@@ -107,14 +97,8 @@ func (x *RegisterUserReq) Check() error {
 	if x.User == nil {
 		return errs.ErrArgs.Wrap("user is empty")
 	}
-	if x.User.AreaCode == "" {
-		return errs.ErrArgs.Wrap("AreaCode is empty")
-	} else if err := AreaCodeCheck(x.User.AreaCode); err != nil {
-		return err
-	}
-	if x.User.PhoneNumber == "" {
-		return errs.ErrArgs.Wrap("PhoneNumber is empty")
-	} else if err := PhoneNumberCheck(x.User.PhoneNumber); err != nil {
+	x.User.Email = NormalizeEmail(x.User.Email)
+	if err := phoneOrEmailCheck(x.User.AreaCode, x.User.PhoneNumber, x.User.Email); err != nil {
 		return err
 	}
 	if x.User.Email != "" {
@@ -126,6 +110,7 @@ func (x *RegisterUserReq) Check() error {
 }
 
 func (x *LoginReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
 	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
 		return errs.ErrArgs.Wrap("platform is invalid")
 	}
@@ -139,21 +124,61 @@ func (x *LoginReq) Check() error {
 			return err
 		}
 	}
+	if x.Email != "" {
+		if err := EmailCheck(x.Email); err != nil {
+			return err
+		}
+	}
+	if x.Passkey != nil {
+		if err := x.Passkey.Check(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *WebAuthnAssertion) Check() error {
+	if x.CredentialID == "" {
+		return errs.ErrArgs.Wrap("credentialID is empty")
+	}
+	if x.ClientDataJSON == "" {
+		return errs.ErrArgs.Wrap("clientDataJSON is empty")
+	}
+	if x.AuthenticatorData == "" {
+		return errs.ErrArgs.Wrap("authenticatorData is empty")
+	}
+	if x.Signature == "" {
+		return errs.ErrArgs.Wrap("signature is empty")
+	}
+	return nil
+}
+
+func (x *FinishPasskeyRegistrationReq) Check() error {
+	if x.CredentialID == "" {
+		return errs.ErrArgs.Wrap("credentialID is empty")
+	}
+	if x.ClientDataJSON == "" {
+		return errs.ErrArgs.Wrap("clientDataJSON is empty")
+	}
+	if x.AttestationObject == "" {
+		return errs.ErrArgs.Wrap("attestationObject is empty")
+	}
+	return nil
+}
+
+func (x *DelPasskeyReq) Check() error {
+	if x.CredentialID == "" {
+		return errs.ErrArgs.Wrap("credentialID is empty")
+	}
 	return nil
 }
 
 func (x *ResetPasswordReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
 	if x.Password == "" {
 		return errs.ErrArgs.Wrap("password is empty")
 	}
-	if x.AreaCode == "" {
-		return errs.ErrArgs.Wrap("AreaCode is empty")
-	} else if err := AreaCodeCheck(x.AreaCode); err != nil {
-		return err
-	}
-	if x.PhoneNumber == "" {
-		return errs.ErrArgs.Wrap("PhoneNumber is empty")
-	} else if err := PhoneNumberCheck(x.PhoneNumber); err != nil {
+	if err := phoneOrEmailCheck(x.AreaCode, x.PhoneNumber, x.Email); err != nil {
 		return err
 	}
 	if x.VerifyCode == "" {
@@ -162,6 +187,26 @@ func (x *ResetPasswordReq) Check() error {
 	return nil
 }
 
+func (x *ConfirmTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	return nil
+}
+
+func (x *VerifyTOTPLoginReq) Check() error {
+	if x.ChallengeToken == "" {
+		return errs.ErrArgs.Wrap("challengeToken is empty")
+	}
+	if x.Code == "" {
+		return errs.ErrArgs.Wrap("code is empty")
+	}
+	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
+		return errs.ErrArgs.Wrap("platform is invalid")
+	}
+	return nil
+}
+
 func (x *ChangePasswordReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.Wrap("userID is empty")
@@ -234,6 +279,11 @@ func (x *SearchLogsReq) Check() error {
 	return nil
 }
 
+// NormalizeEmail 去除首尾空白并转为小写, 邮箱在校验和存储前都先规范化
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
 func EmailCheck(email string) error {
 	pattern := `^[0-9a-z][_.0-9a-z-]{0,31}@([0-9a-z][0-9a-z-]{0,30}[0-9a-z]\.){1,4}[a-z]{2,4}$`
 	if err := regexMatch(pattern, email); err != nil {
@@ -242,6 +292,24 @@ func EmailCheck(email string) error {
 	return nil
 }
 
+// phoneOrEmailCheck 手机号优先, 手机号为空时校验邮箱
+func phoneOrEmailCheck(areaCode, phoneNumber, email string) error {
+	if phoneNumber == "" && email != "" {
+		return EmailCheck(email)
+	}
+	if areaCode == "" {
+		return errs.ErrArgs.Wrap("AreaCode is empty")
+	} else if err := AreaCodeCheck(areaCode); err != nil {
+		return err
+	}
+	if phoneNumber == "" {
+		return errs.ErrArgs.Wrap("PhoneNumber is empty")
+	} else if err := PhoneNumberCheck(phoneNumber); err != nil {
+		return err
+	}
+	return nil
+}
+
 func AreaCodeCheck(areaCode string) error {
 	pattern := `\+[1-9][0-9]{1,2}`
 	if err := regexMatch(pattern, areaCode); err != nil {
@@ -282,3 +350,156 @@ func (x *SearchUserInfoReq) Check() error {
 	}
 	return nil
 }
+
+func (x *UnlockUserLoginReq) Check() error {
+	if x.UserID == "" && x.Ip == "" {
+		return errs.ErrArgs.Wrap("userID and ip is empty")
+	}
+	return nil
+}
+
+func (x *CreateQRLoginReq) Check() error {
+	if x.Platform < constant2.IOSPlatformID || x.Platform > constant2.AdminPlatformID {
+		return errs.ErrArgs.Wrap("platform is invalid")
+	}
+	return nil
+}
+
+func (x *GetQRLoginStatusReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.Wrap("ticket is empty")
+	}
+	if x.Secret == "" {
+		return errs.ErrArgs.Wrap("secret is empty")
+	}
+	return nil
+}
+
+func (x *ScanQRLoginReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.Wrap("ticket is empty")
+	}
+	return nil
+}
+
+func (x *ConfirmQRLoginReq) Check() error {
+	if x.Ticket == "" {
+		return errs.ErrArgs.Wrap("ticket is empty")
+	}
+	return nil
+}
+
+func (x *DeleteAccountReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
+	if x.Password == "" && x.VerifyCode == "" {
+		return errs.ErrArgs.Wrap("password or verify code must be set")
+	}
+	if x.VerifyCode != "" && x.PhoneNumber == "" && x.Email == "" {
+		return errs.ErrArgs.Wrap("phone number or email must be set")
+	}
+	return nil
+}
+
+func (x *CheckUserActiveReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.Wrap("userID is empty")
+	}
+	return nil
+}
+
+func (x *ChangeContactReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
+	if x.PhoneNumber == "" && x.Email == "" {
+		return errs.ErrArgs.Wrap("phone number or email must be set")
+	}
+	if x.PhoneNumber != "" && x.Email != "" {
+		return errs.ErrArgs.Wrap("phone number and email can not be changed at the same time")
+	}
+	if x.VerifyCode == "" {
+		return errs.ErrArgs.Wrap("verify code is empty")
+	}
+	return nil
+}
+
+func (x *BindIdentifierReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
+	switch x.Type {
+	case constant.Phone:
+		if x.AreaCode == "" || x.PhoneNumber == "" {
+			return errs.ErrArgs.Wrap("area code or phone number is empty")
+		}
+	case constant.Email:
+		if err := EmailCheck(x.Email); err != nil {
+			return err
+		}
+	case constant.Account:
+		if x.Account == "" {
+			return errs.ErrArgs.Wrap("account is empty")
+		}
+		if x.Password == "" {
+			return errs.ErrArgs.Wrap("password is empty")
+		}
+		return nil
+	default:
+		return errs.ErrArgs.Wrap("type must be phone, email or account")
+	}
+	if x.VerifyCode == "" {
+		return errs.ErrArgs.Wrap("verify code is empty")
+	}
+	return nil
+}
+
+func (x *UnbindIdentifierReq) Check() error {
+	x.Email = NormalizeEmail(x.Email)
+	if !utils.Contain(x.Type, constant.Phone, constant.Email, constant.Account) {
+		return errs.ErrArgs.Wrap("type must be phone, email or account")
+	}
+	if x.Password == "" && x.VerifyCode == "" {
+		return errs.ErrArgs.Wrap("password or verify code must be set")
+	}
+	if x.VerifyCode != "" && x.PhoneNumber == "" && x.Email == "" {
+		return errs.ErrArgs.Wrap("phone number or email must be set")
+	}
+	return nil
+}
+
+func (x *VerifyCaptchaReq) Check() error {
+	if x.CaptchaID == "" {
+		return errs.ErrArgs.Wrap("captchaID is empty")
+	}
+	if x.Answer == "" {
+		return errs.ErrArgs.Wrap("answer is empty")
+	}
+	return nil
+}
+
+func (x *UpdateVerifyCodeStatusReq) Check() error {
+	if len(x.Receipts) == 0 {
+		return errs.ErrArgs.Wrap("receipts is empty")
+	}
+	for _, receipt := range x.Receipts {
+		if receipt.Provider == "" || receipt.MessageID == "" {
+			return errs.ErrArgs.Wrap("provider or messageID is empty")
+		}
+		if receipt.Status != constant.VerifyCodeStatusSent && receipt.Status != constant.VerifyCodeStatusDelivered && receipt.Status != constant.VerifyCodeStatusFailed {
+			return errs.ErrArgs.Wrap("status is invalid")
+		}
+	}
+	return nil
+}
+
+func (x *SearchVerifyCodeReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.Wrap("Pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.Wrap("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.Wrap("showNumber is invalid")
+	}
+	if x.EndTime != 0 && x.StartTime > x.EndTime {
+		return errs.ErrArgs.Wrap("startTime>endTime")
+	}
+	return nil
+}
@@ -147,6 +147,7 @@ type UpdateUserInfoReq struct {
 	AllowBeep        *wrapperspb.Int32Value  `protobuf:"bytes,12,opt,name=allowBeep,proto3" json:"allowBeep"`
 	AllowVibration   *wrapperspb.Int32Value  `protobuf:"bytes,13,opt,name=allowVibration,proto3" json:"allowVibration"`
 	GlobalRecvMsgOpt *wrapperspb.Int32Value  `protobuf:"bytes,14,opt,name=globalRecvMsgOpt,proto3" json:"globalRecvMsgOpt"`
+	Language         *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=language,proto3" json:"language"` // 验证码短信和邮件使用的语言
 }
 
 func (x *UpdateUserInfoReq) Reset() {
@@ -279,6 +280,13 @@ func (x *UpdateUserInfoReq) GetGlobalRecvMsgOpt() *wrapperspb.Int32Value {
 	return nil
 }
 
+func (x *UpdateUserInfoReq) GetLanguage() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Language
+	}
+	return nil
+}
+
 type UpdateUserInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -652,6 +660,9 @@ type SendVerifyCodeReq struct {
 	Platform       int32  `protobuf:"varint,5,opt,name=platform,proto3" json:"platform"`
 	AreaCode       string `protobuf:"bytes,6,opt,name=areaCode,proto3" json:"areaCode"`
 	PhoneNumber    string `protobuf:"bytes,7,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Email          string `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
+	CaptchaToken   string `protobuf:"bytes,9,opt,name=captchaToken,proto3" json:"captchaToken"` // 需要人机验证时, 验证通过后获得的凭证
+	Language       string `protobuf:"bytes,10,opt,name=language,proto3" json:"language"`        // 为空时api使用Accept-Language
 }
 
 func (x *SendVerifyCodeReq) Reset() {
@@ -735,6 +746,27 @@ func (x *SendVerifyCodeReq) GetPhoneNumber() string {
 	return ""
 }
 
+func (x *SendVerifyCodeReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SendVerifyCodeReq) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *SendVerifyCodeReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type SendVerifyCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -773,6 +805,217 @@ func (*SendVerifyCodeResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{10}
 }
 
+type GetCaptchaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCaptchaReq) Reset() {
+	*x = GetCaptchaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCaptchaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaReq) ProtoMessage() {}
+
+func (x *GetCaptchaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaReq.ProtoReflect.Descriptor instead.
+func (*GetCaptchaReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{11}
+}
+
+type GetCaptchaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaptchaID  string `protobuf:"bytes,1,opt,name=captchaID,proto3" json:"captchaID"`
+	Image      string `protobuf:"bytes,2,opt,name=image,proto3" json:"image"`            // data:image/png;base64,...
+	ExpireTime int64  `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime"` // 毫秒时间戳
+}
+
+func (x *GetCaptchaResp) Reset() {
+	*x = GetCaptchaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCaptchaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaResp) ProtoMessage() {}
+
+func (x *GetCaptchaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaResp.ProtoReflect.Descriptor instead.
+func (*GetCaptchaResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetCaptchaResp) GetCaptchaID() string {
+	if x != nil {
+		return x.CaptchaID
+	}
+	return ""
+}
+
+func (x *GetCaptchaResp) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *GetCaptchaResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type VerifyCaptchaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaptchaID string `protobuf:"bytes,1,opt,name=captchaID,proto3" json:"captchaID"`
+	Answer    string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer"`
+}
+
+func (x *VerifyCaptchaReq) Reset() {
+	*x = VerifyCaptchaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCaptchaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaReq) ProtoMessage() {}
+
+func (x *VerifyCaptchaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaReq.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyCaptchaReq) GetCaptchaID() string {
+	if x != nil {
+		return x.CaptchaID
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaReq) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type VerifyCaptchaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaptchaToken string `protobuf:"bytes,1,opt,name=captchaToken,proto3" json:"captchaToken"` // 在发送验证码或登录时提交, 只能使用一次
+	ExpireTime   int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *VerifyCaptchaResp) Reset() {
+	*x = VerifyCaptchaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCaptchaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaResp) ProtoMessage() {}
+
+func (x *VerifyCaptchaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaResp.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VerifyCaptchaResp) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
 type VerifyCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -781,12 +1024,13 @@ type VerifyCodeReq struct {
 	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
 	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
 	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
+	Email       string `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
 }
 
 func (x *VerifyCodeReq) Reset() {
 	*x = VerifyCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[11]
+		mi := &file_chat_chat_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -799,7 +1043,7 @@ func (x *VerifyCodeReq) String() string {
 func (*VerifyCodeReq) ProtoMessage() {}
 
 func (x *VerifyCodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[11]
+	mi := &file_chat_chat_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,7 +1056,7 @@ func (x *VerifyCodeReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCodeReq.ProtoReflect.Descriptor instead.
 func (*VerifyCodeReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{11}
+	return file_chat_chat_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *VerifyCodeReq) GetAreaCode() string {
@@ -836,6 +1080,13 @@ func (x *VerifyCodeReq) GetVerifyCode() string {
 	return ""
 }
 
+func (x *VerifyCodeReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 type VerifyCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -845,7 +1096,7 @@ type VerifyCodeResp struct {
 func (x *VerifyCodeResp) Reset() {
 	*x = VerifyCodeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[12]
+		mi := &file_chat_chat_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -858,7 +1109,7 @@ func (x *VerifyCodeResp) String() string {
 func (*VerifyCodeResp) ProtoMessage() {}
 
 func (x *VerifyCodeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[12]
+	mi := &file_chat_chat_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,7 +1122,7 @@ func (x *VerifyCodeResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyCodeResp.ProtoReflect.Descriptor instead.
 func (*VerifyCodeResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{12}
+	return file_chat_chat_proto_rawDescGZIP(), []int{16}
 }
 
 type RegisterUserInfo struct {
@@ -894,7 +1145,7 @@ type RegisterUserInfo struct {
 func (x *RegisterUserInfo) Reset() {
 	*x = RegisterUserInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[13]
+		mi := &file_chat_chat_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -907,7 +1158,7 @@ func (x *RegisterUserInfo) String() string {
 func (*RegisterUserInfo) ProtoMessage() {}
 
 func (x *RegisterUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[13]
+	mi := &file_chat_chat_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1171,7 @@ func (x *RegisterUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserInfo.ProtoReflect.Descriptor instead.
 func (*RegisterUserInfo) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{13}
+	return file_chat_chat_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RegisterUserInfo) GetUserID() string {
@@ -1010,7 +1261,7 @@ type RegisterUserReq struct {
 func (x *RegisterUserReq) Reset() {
 	*x = RegisterUserReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[14]
+		mi := &file_chat_chat_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1274,7 @@ func (x *RegisterUserReq) String() string {
 func (*RegisterUserReq) ProtoMessage() {}
 
 func (x *RegisterUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[14]
+	mi := &file_chat_chat_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1287,7 @@ func (x *RegisterUserReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserReq.ProtoReflect.Descriptor instead.
 func (*RegisterUserReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{14}
+	return file_chat_chat_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RegisterUserReq) GetInvitationCode() string {
@@ -1093,14 +1344,15 @@ type RegisterUserResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	ChatToken string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ChatToken    string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
 func (x *RegisterUserResp) Reset() {
 	*x = RegisterUserResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[15]
+		mi := &file_chat_chat_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1113,7 +1365,7 @@ func (x *RegisterUserResp) String() string {
 func (*RegisterUserResp) ProtoMessage() {}
 
 func (x *RegisterUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[15]
+	mi := &file_chat_chat_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1378,7 @@ func (x *RegisterUserResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserResp.ProtoReflect.Descriptor instead.
 func (*RegisterUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{15}
+	return file_chat_chat_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RegisterUserResp) GetUserID() string {
@@ -1143,25 +1395,35 @@ func (x *RegisterUserResp) GetChatToken() string {
 	return ""
 }
 
+func (x *RegisterUserResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type LoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
-	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
-	Account     string `protobuf:"bytes,4,opt,name=account,proto3" json:"account"`
-	Password    string `protobuf:"bytes,5,opt,name=password,proto3" json:"password"`
-	Platform    int32  `protobuf:"varint,6,opt,name=platform,proto3" json:"platform"`
-	DeviceID    string `protobuf:"bytes,7,opt,name=deviceID,proto3" json:"deviceID"`
-	Ip          string `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip"`
+	AreaCode     string             `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber  string             `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	VerifyCode   string             `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
+	Account      string             `protobuf:"bytes,4,opt,name=account,proto3" json:"account"`
+	Password     string             `protobuf:"bytes,5,opt,name=password,proto3" json:"password"`
+	Platform     int32              `protobuf:"varint,6,opt,name=platform,proto3" json:"platform"`
+	DeviceID     string             `protobuf:"bytes,7,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip           string             `protobuf:"bytes,8,opt,name=ip,proto3" json:"ip"`
+	Email        string             `protobuf:"bytes,9,opt,name=email,proto3" json:"email"`
+	Passkey      *WebAuthnAssertion `protobuf:"bytes,10,opt,name=passkey,proto3" json:"passkey"`           // 通行密钥登录, 无需账号和密码
+	CaptchaToken string             `protobuf:"bytes,11,opt,name=captchaToken,proto3" json:"captchaToken"` // 需要人机验证时, 验证通过后获得的凭证
 }
 
 func (x *LoginReq) Reset() {
 	*x = LoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[16]
+		mi := &file_chat_chat_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1174,7 +1436,7 @@ func (x *LoginReq) String() string {
 func (*LoginReq) ProtoMessage() {}
 
 func (x *LoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[16]
+	mi := &file_chat_chat_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1187,7 +1449,7 @@ func (x *LoginReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginReq.ProtoReflect.Descriptor instead.
 func (*LoginReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{16}
+	return file_chat_chat_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *LoginReq) GetAreaCode() string {
@@ -1246,32 +1508,56 @@ func (x *LoginReq) GetIp() string {
 	return ""
 }
 
-type LoginResp struct {
+func (x *LoginReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginReq) GetPasskey() *WebAuthnAssertion {
+	if x != nil {
+		return x.Passkey
+	}
+	return nil
+}
+
+func (x *LoginReq) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
+type WebAuthnAssertion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChatToken string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
-	UserID    string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	CredentialID      string `protobuf:"bytes,1,opt,name=credentialID,proto3" json:"credentialID"`
+	ClientDataJSON    string `protobuf:"bytes,2,opt,name=clientDataJSON,proto3" json:"clientDataJSON"`       // base64url
+	AuthenticatorData string `protobuf:"bytes,3,opt,name=authenticatorData,proto3" json:"authenticatorData"` // base64url
+	Signature         string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature"`                 // base64url
+	UserHandle        string `protobuf:"bytes,5,opt,name=userHandle,proto3" json:"userHandle"`               // base64url
 }
 
-func (x *LoginResp) Reset() {
-	*x = LoginResp{}
+func (x *WebAuthnAssertion) Reset() {
+	*x = WebAuthnAssertion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[17]
+		mi := &file_chat_chat_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LoginResp) String() string {
+func (x *WebAuthnAssertion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginResp) ProtoMessage() {}
+func (*WebAuthnAssertion) ProtoMessage() {}
 
-func (x *LoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[17]
+func (x *WebAuthnAssertion) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1282,53 +1568,74 @@ func (x *LoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
-func (*LoginResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use WebAuthnAssertion.ProtoReflect.Descriptor instead.
+func (*WebAuthnAssertion) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *LoginResp) GetChatToken() string {
+func (x *WebAuthnAssertion) GetCredentialID() string {
 	if x != nil {
-		return x.ChatToken
+		return x.CredentialID
 	}
 	return ""
 }
 
-func (x *LoginResp) GetUserID() string {
+func (x *WebAuthnAssertion) GetClientDataJSON() string {
 	if x != nil {
-		return x.UserID
+		return x.ClientDataJSON
 	}
 	return ""
 }
 
-type ResetPasswordReq struct {
+func (x *WebAuthnAssertion) GetAuthenticatorData() string {
+	if x != nil {
+		return x.AuthenticatorData
+	}
+	return ""
+}
+
+func (x *WebAuthnAssertion) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *WebAuthnAssertion) GetUserHandle() string {
+	if x != nil {
+		return x.UserHandle
+	}
+	return ""
+}
+
+type LoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
-	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
-	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password"`
+	ChatToken      string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	ChallengeToken string `protobuf:"bytes,4,opt,name=challengeToken,proto3" json:"challengeToken"` // 开启二次验证时返回, 需调用VerifyTOTPLogin换取chatToken
+	RefreshToken   string `protobuf:"bytes,5,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
-func (x *ResetPasswordReq) Reset() {
-	*x = ResetPasswordReq{}
+func (x *LoginResp) Reset() {
+	*x = LoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[18]
+		mi := &file_chat_chat_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordReq) String() string {
+func (x *LoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordReq) ProtoMessage() {}
+func (*LoginResp) ProtoMessage() {}
 
-func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[18]
+func (x *LoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,62 +1646,62 @@ func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
-func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LoginResp.ProtoReflect.Descriptor instead.
+func (*LoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ResetPasswordReq) GetAreaCode() string {
+func (x *LoginResp) GetChatToken() string {
 	if x != nil {
-		return x.AreaCode
+		return x.ChatToken
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetPhoneNumber() string {
+func (x *LoginResp) GetUserID() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetVerifyCode() string {
+func (x *LoginResp) GetChallengeToken() string {
 	if x != nil {
-		return x.VerifyCode
+		return x.ChallengeToken
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetPassword() string {
+func (x *LoginResp) GetRefreshToken() string {
 	if x != nil {
-		return x.Password
+		return x.RefreshToken
 	}
 	return ""
 }
 
-type ResetPasswordResp struct {
+type EnrollTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ResetPasswordResp) Reset() {
-	*x = ResetPasswordResp{}
+func (x *EnrollTOTPReq) Reset() {
+	*x = EnrollTOTPReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[19]
+		mi := &file_chat_chat_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordResp) String() string {
+func (x *EnrollTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResp) ProtoMessage() {}
+func (*EnrollTOTPReq) ProtoMessage() {}
 
-func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[19]
+func (x *EnrollTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1405,38 +1712,37 @@ func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use EnrollTOTPReq.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{23}
 }
 
-type ChangePasswordReq struct {
+type EnrollTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
-	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret"`
+	Url    string `protobuf:"bytes,2,opt,name=url,proto3" json:"url"`
 }
 
-func (x *ChangePasswordReq) Reset() {
-	*x = ChangePasswordReq{}
+func (x *EnrollTOTPResp) Reset() {
+	*x = EnrollTOTPResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[20]
+		mi := &file_chat_chat_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangePasswordReq) String() string {
+func (x *EnrollTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordReq) ProtoMessage() {}
+func (*EnrollTOTPResp) ProtoMessage() {}
 
-func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[20]
+func (x *EnrollTOTPResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1447,55 +1753,50 @@ func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ChangePasswordReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+// Deprecated: Use EnrollTOTPResp.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ChangePasswordReq) GetCurrentPassword() string {
+func (x *EnrollTOTPResp) GetSecret() string {
 	if x != nil {
-		return x.CurrentPassword
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *ChangePasswordReq) GetNewPassword() string {
+func (x *EnrollTOTPResp) GetUrl() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Url
 	}
 	return ""
 }
 
-type ChangePasswordResp struct {
+type ConfirmTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
 }
 
-func (x *ChangePasswordResp) Reset() {
-	*x = ChangePasswordResp{}
+func (x *ConfirmTOTPReq) Reset() {
+	*x = ConfirmTOTPReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[21]
+		mi := &file_chat_chat_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangePasswordResp) String() string {
+func (x *ConfirmTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResp) ProtoMessage() {}
+func (*ConfirmTOTPReq) ProtoMessage() {}
 
-func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[21]
+func (x *ConfirmTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1506,36 +1807,43 @@ func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ConfirmTOTPReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{25}
 }
 
-type FindUserAccountReq struct {
+func (x *ConfirmTOTPReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes"`
 }
 
-func (x *FindUserAccountReq) Reset() {
-	*x = FindUserAccountReq{}
+func (x *ConfirmTOTPResp) Reset() {
+	*x = ConfirmTOTPResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[22]
+		mi := &file_chat_chat_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindUserAccountReq) String() string {
+func (x *ConfirmTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserAccountReq) ProtoMessage() {}
+func (*ConfirmTOTPResp) ProtoMessage() {}
 
-func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[22]
+func (x *ConfirmTOTPResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,43 +1854,44 @@ func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserAccountReq.ProtoReflect.Descriptor instead.
-func (*FindUserAccountReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ConfirmTOTPResp.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *FindUserAccountReq) GetUserIDs() []string {
+func (x *ConfirmTOTPResp) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.UserIDs
+		return x.RecoveryCodes
 	}
 	return nil
 }
 
-type FindUserAccountResp struct {
+type DisableTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserAccountMap map[string]string `protobuf:"bytes,1,rep,name=userAccountMap,proto3" json:"userAccountMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // userID  account
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
 }
 
-func (x *FindUserAccountResp) Reset() {
-	*x = FindUserAccountResp{}
+func (x *DisableTOTPReq) Reset() {
+	*x = DisableTOTPReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[23]
+		mi := &file_chat_chat_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindUserAccountResp) String() string {
+func (x *DisableTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserAccountResp) ProtoMessage() {}
+func (*DisableTOTPReq) ProtoMessage() {}
 
-func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[23]
+func (x *DisableTOTPReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1593,43 +1902,48 @@ func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserAccountResp.ProtoReflect.Descriptor instead.
-func (*FindUserAccountResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use DisableTOTPReq.ProtoReflect.Descriptor instead.
+func (*DisableTOTPReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *FindUserAccountResp) GetUserAccountMap() map[string]string {
+func (x *DisableTOTPReq) GetUserID() string {
 	if x != nil {
-		return x.UserAccountMap
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type FindAccountUserReq struct {
+func (x *DisableTOTPReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 }
 
-func (x *FindAccountUserReq) Reset() {
-	*x = FindAccountUserReq{}
+func (x *DisableTOTPResp) Reset() {
+	*x = DisableTOTPResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[24]
+		mi := &file_chat_chat_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAccountUserReq) String() string {
+func (x *DisableTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAccountUserReq) ProtoMessage() {}
+func (*DisableTOTPResp) ProtoMessage() {}
 
-func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[24]
+func (x *DisableTOTPResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,43 +1954,40 @@ func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAccountUserReq.ProtoReflect.Descriptor instead.
-func (*FindAccountUserReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{24}
-}
-
-func (x *FindAccountUserReq) GetAccounts() []string {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// Deprecated: Use DisableTOTPResp.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{28}
 }
 
-type FindAccountUserResp struct {
+type VerifyTOTPLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountUserMap map[string]string `protobuf:"bytes,1,rep,name=accountUserMap,proto3" json:"accountUserMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // account  userID
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	Platform       int32  `protobuf:"varint,3,opt,name=platform,proto3" json:"platform"`
+	DeviceID       string `protobuf:"bytes,4,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip             string `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *FindAccountUserResp) Reset() {
-	*x = FindAccountUserResp{}
+func (x *VerifyTOTPLoginReq) Reset() {
+	*x = VerifyTOTPLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[25]
+		mi := &file_chat_chat_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAccountUserResp) String() string {
+func (x *VerifyTOTPLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAccountUserResp) ProtoMessage() {}
+func (*VerifyTOTPLoginReq) ProtoMessage() {}
 
-func (x *FindAccountUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[25]
+func (x *VerifyTOTPLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1687,56 +1998,73 @@ func (x *FindAccountUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAccountUserResp.ProtoReflect.Descriptor instead.
-func (*FindAccountUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use VerifyTOTPLoginReq.ProtoReflect.Descriptor instead.
+func (*VerifyTOTPLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *FindAccountUserResp) GetAccountUserMap() map[string]string {
+func (x *VerifyTOTPLoginReq) GetChallengeToken() string {
 	if x != nil {
-		return x.AccountUserMap
+		return x.ChallengeToken
 	}
-	return nil
+	return ""
 }
 
-type SignalRecord struct {
+func (x *VerifyTOTPLoginReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyTOTPLoginReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *VerifyTOTPLoginReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *VerifyTOTPLoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type VerifyTOTPLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileName        string                   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName"`
-	MediaType       string                   `protobuf:"bytes,2,opt,name=mediaType,proto3" json:"mediaType"`
-	RoomType        string                   `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType"`
-	SenderID        string                   `protobuf:"bytes,4,opt,name=senderID,proto3" json:"senderID"`
-	SenderNickname  string                   `protobuf:"bytes,5,opt,name=senderNickname,proto3" json:"senderNickname"`
-	RecvID          string                   `protobuf:"bytes,6,opt,name=recvID,proto3" json:"recvID"`
-	RecvNickname    string                   `protobuf:"bytes,7,opt,name=recvNickname,proto3" json:"recvNickname"`
-	GroupID         string                   `protobuf:"bytes,8,opt,name=groupID,proto3" json:"groupID"`
-	GroupName       string                   `protobuf:"bytes,9,opt,name=groupName,proto3" json:"groupName"`
-	InviterUserList []*common.UserPublicInfo `protobuf:"bytes,10,rep,name=inviterUserList,proto3" json:"inviterUserList"`
-	Duration        int32                    `protobuf:"varint,11,opt,name=duration,proto3" json:"duration"`
-	CreateTime      int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
-	Size            string                   `protobuf:"bytes,13,opt,name=size,proto3" json:"size"`
-	DownloadURL     string                   `protobuf:"bytes,14,opt,name=downloadURL,proto3" json:"downloadURL"`
+	ChatToken    string `protobuf:"bytes,1,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
-func (x *SignalRecord) Reset() {
-	*x = SignalRecord{}
+func (x *VerifyTOTPLoginResp) Reset() {
+	*x = VerifyTOTPLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[26]
+		mi := &file_chat_chat_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignalRecord) String() string {
+func (x *VerifyTOTPLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignalRecord) ProtoMessage() {}
+func (*VerifyTOTPLoginResp) ProtoMessage() {}
 
-func (x *SignalRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[26]
+func (x *VerifyTOTPLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,135 +2075,152 @@ func (x *SignalRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignalRecord.ProtoReflect.Descriptor instead.
-func (*SignalRecord) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use VerifyTOTPLoginResp.ProtoReflect.Descriptor instead.
+func (*VerifyTOTPLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SignalRecord) GetFileName() string {
+func (x *VerifyTOTPLoginResp) GetChatToken() string {
 	if x != nil {
-		return x.FileName
+		return x.ChatToken
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetMediaType() string {
+func (x *VerifyTOTPLoginResp) GetUserID() string {
 	if x != nil {
-		return x.MediaType
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetRoomType() string {
+func (x *VerifyTOTPLoginResp) GetRefreshToken() string {
 	if x != nil {
-		return x.RoomType
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetSenderID() string {
-	if x != nil {
-		return x.SenderID
-	}
-	return ""
+type BeginPasskeyRegistrationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password    string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"` // 密码或本人手机号/邮箱的验证码二选一
+	AreaCode    string `protobuf:"bytes,2,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Email       string `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
+	VerifyCode  string `protobuf:"bytes,5,opt,name=verifyCode,proto3" json:"verifyCode"` // 用途为验证本人的验证码
+	TotpCode    string `protobuf:"bytes,6,opt,name=totpCode,proto3" json:"totpCode"`     // 开启二次验证时必填, 动态码或恢复码
 }
 
-func (x *SignalRecord) GetSenderNickname() string {
-	if x != nil {
-		return x.SenderNickname
+func (x *BeginPasskeyRegistrationReq) Reset() {
+	*x = BeginPasskeyRegistrationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SignalRecord) GetRecvID() string {
-	if x != nil {
-		return x.RecvID
-	}
-	return ""
+func (x *BeginPasskeyRegistrationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SignalRecord) GetRecvNickname() string {
-	if x != nil {
-		return x.RecvNickname
+func (*BeginPasskeyRegistrationReq) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SignalRecord) GetGroupID() string {
-	if x != nil {
-		return x.GroupID
-	}
-	return ""
+// Deprecated: Use BeginPasskeyRegistrationReq.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SignalRecord) GetGroupName() string {
+func (x *BeginPasskeyRegistrationReq) GetPassword() string {
 	if x != nil {
-		return x.GroupName
+		return x.Password
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetInviterUserList() []*common.UserPublicInfo {
+func (x *BeginPasskeyRegistrationReq) GetAreaCode() string {
 	if x != nil {
-		return x.InviterUserList
+		return x.AreaCode
 	}
-	return nil
+	return ""
 }
 
-func (x *SignalRecord) GetDuration() int32 {
+func (x *BeginPasskeyRegistrationReq) GetPhoneNumber() string {
 	if x != nil {
-		return x.Duration
+		return x.PhoneNumber
 	}
-	return 0
+	return ""
 }
 
-func (x *SignalRecord) GetCreateTime() int64 {
+func (x *BeginPasskeyRegistrationReq) GetEmail() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *SignalRecord) GetSize() string {
+func (x *BeginPasskeyRegistrationReq) GetVerifyCode() string {
 	if x != nil {
-		return x.Size
+		return x.VerifyCode
 	}
 	return ""
 }
 
-func (x *SignalRecord) GetDownloadURL() string {
+func (x *BeginPasskeyRegistrationReq) GetTotpCode() string {
 	if x != nil {
-		return x.DownloadURL
+		return x.TotpCode
 	}
 	return ""
 }
 
-type AddSignalRecordReq struct {
+type BeginPasskeyRegistrationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignalRecord *SignalRecord `protobuf:"bytes,1,opt,name=signalRecord,proto3" json:"signalRecord"`
-	OperationID  string        `protobuf:"bytes,2,opt,name=operationID,proto3" json:"operationID"`
+	Challenge            string   `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+	RpID                 string   `protobuf:"bytes,2,opt,name=rpID,proto3" json:"rpID"`
+	RpName               string   `protobuf:"bytes,3,opt,name=rpName,proto3" json:"rpName"`
+	UserHandle           string   `protobuf:"bytes,4,opt,name=userHandle,proto3" json:"userHandle"` // base64url(userID)
+	UserName             string   `protobuf:"bytes,5,opt,name=userName,proto3" json:"userName"`
+	UserDisplayName      string   `protobuf:"bytes,6,opt,name=userDisplayName,proto3" json:"userDisplayName"`
+	Algorithms           []int64  `protobuf:"varint,7,rep,packed,name=algorithms,proto3" json:"algorithms"` // COSE算法, 按优先级排序
+	ExcludeCredentialIDs []string `protobuf:"bytes,8,rep,name=excludeCredentialIDs,proto3" json:"excludeCredentialIDs"`
+	Timeout              int64    `protobuf:"varint,9,opt,name=timeout,proto3" json:"timeout"` // 毫秒
 }
 
-func (x *AddSignalRecordReq) Reset() {
-	*x = AddSignalRecordReq{}
+func (x *BeginPasskeyRegistrationResp) Reset() {
+	*x = BeginPasskeyRegistrationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[27]
+		mi := &file_chat_chat_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddSignalRecordReq) String() string {
+func (x *BeginPasskeyRegistrationResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddSignalRecordReq) ProtoMessage() {}
+func (*BeginPasskeyRegistrationResp) ProtoMessage() {}
 
-func (x *AddSignalRecordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[27]
+func (x *BeginPasskeyRegistrationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1886,93 +2231,102 @@ func (x *AddSignalRecordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddSignalRecordReq.ProtoReflect.Descriptor instead.
-func (*AddSignalRecordReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use BeginPasskeyRegistrationResp.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AddSignalRecordReq) GetSignalRecord() *SignalRecord {
+func (x *BeginPasskeyRegistrationResp) GetChallenge() string {
 	if x != nil {
-		return x.SignalRecord
+		return x.Challenge
 	}
-	return nil
+	return ""
 }
 
-func (x *AddSignalRecordReq) GetOperationID() string {
+func (x *BeginPasskeyRegistrationResp) GetRpID() string {
 	if x != nil {
-		return x.OperationID
+		return x.RpID
 	}
 	return ""
 }
 
-type AddSignalRecordResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *BeginPasskeyRegistrationResp) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
 }
 
-func (x *AddSignalRecordResp) Reset() {
-	*x = AddSignalRecordResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BeginPasskeyRegistrationResp) GetUserHandle() string {
+	if x != nil {
+		return x.UserHandle
 	}
+	return ""
 }
 
-func (x *AddSignalRecordResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BeginPasskeyRegistrationResp) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
 }
 
-func (*AddSignalRecordResp) ProtoMessage() {}
+func (x *BeginPasskeyRegistrationResp) GetUserDisplayName() string {
+	if x != nil {
+		return x.UserDisplayName
+	}
+	return ""
+}
 
-func (x *AddSignalRecordResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BeginPasskeyRegistrationResp) GetAlgorithms() []int64 {
+	if x != nil {
+		return x.Algorithms
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use AddSignalRecordResp.ProtoReflect.Descriptor instead.
-func (*AddSignalRecordResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{28}
+func (x *BeginPasskeyRegistrationResp) GetExcludeCredentialIDs() []string {
+	if x != nil {
+		return x.ExcludeCredentialIDs
+	}
+	return nil
 }
 
-type GetSignalRecordsReq struct {
+func (x *BeginPasskeyRegistrationResp) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type FinishPasskeyRegistrationReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination  *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
-	SenderID    string                   `protobuf:"bytes,2,opt,name=senderID,proto3" json:"senderID"`
-	RecvID      string                   `protobuf:"bytes,3,opt,name=recvID,proto3" json:"recvID"`
-	MediaType   string                   `protobuf:"bytes,4,opt,name=mediaType,proto3" json:"mediaType"`
-	CreateTime  int64                    `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
-	OperationID string                   `protobuf:"bytes,6,opt,name=operationID,proto3" json:"operationID"`
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	CredentialID      string `protobuf:"bytes,2,opt,name=credentialID,proto3" json:"credentialID"`
+	ClientDataJSON    string `protobuf:"bytes,3,opt,name=clientDataJSON,proto3" json:"clientDataJSON"`       // base64url
+	AttestationObject string `protobuf:"bytes,4,opt,name=attestationObject,proto3" json:"attestationObject"` // base64url
 }
 
-func (x *GetSignalRecordsReq) Reset() {
-	*x = GetSignalRecordsReq{}
+func (x *FinishPasskeyRegistrationReq) Reset() {
+	*x = FinishPasskeyRegistrationReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[29]
+		mi := &file_chat_chat_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSignalRecordsReq) String() string {
+func (x *FinishPasskeyRegistrationReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignalRecordsReq) ProtoMessage() {}
+func (*FinishPasskeyRegistrationReq) ProtoMessage() {}
 
-func (x *GetSignalRecordsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[29]
+func (x *FinishPasskeyRegistrationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,79 +2337,62 @@ func (x *GetSignalRecordsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignalRecordsReq.ProtoReflect.Descriptor instead.
-func (*GetSignalRecordsReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *GetSignalRecordsReq) GetPagination() *sdkws.RequestPagination {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
+// Deprecated: Use FinishPasskeyRegistrationReq.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetSignalRecordsReq) GetSenderID() string {
+func (x *FinishPasskeyRegistrationReq) GetName() string {
 	if x != nil {
-		return x.SenderID
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetSignalRecordsReq) GetRecvID() string {
+func (x *FinishPasskeyRegistrationReq) GetCredentialID() string {
 	if x != nil {
-		return x.RecvID
+		return x.CredentialID
 	}
 	return ""
 }
 
-func (x *GetSignalRecordsReq) GetMediaType() string {
+func (x *FinishPasskeyRegistrationReq) GetClientDataJSON() string {
 	if x != nil {
-		return x.MediaType
+		return x.ClientDataJSON
 	}
 	return ""
 }
 
-func (x *GetSignalRecordsReq) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-func (x *GetSignalRecordsReq) GetOperationID() string {
+func (x *FinishPasskeyRegistrationReq) GetAttestationObject() string {
 	if x != nil {
-		return x.OperationID
+		return x.AttestationObject
 	}
 	return ""
 }
 
-type GetSignalRecordsResp struct {
+type FinishPasskeyRegistrationResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	TotalNumber   uint32          `protobuf:"varint,1,opt,name=totalNumber,proto3" json:"totalNumber"`
-	SignalRecords []*SignalRecord `protobuf:"bytes,2,rep,name=signalRecords,proto3" json:"signalRecords"`
 }
 
-func (x *GetSignalRecordsResp) Reset() {
-	*x = GetSignalRecordsResp{}
+func (x *FinishPasskeyRegistrationResp) Reset() {
+	*x = FinishPasskeyRegistrationResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[30]
+		mi := &file_chat_chat_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSignalRecordsResp) String() string {
+func (x *FinishPasskeyRegistrationResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignalRecordsResp) ProtoMessage() {}
+func (*FinishPasskeyRegistrationResp) ProtoMessage() {}
 
-func (x *GetSignalRecordsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[30]
+func (x *FinishPasskeyRegistrationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2066,51 +2403,76 @@ func (x *GetSignalRecordsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignalRecordsResp.ProtoReflect.Descriptor instead.
-func (*GetSignalRecordsResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use FinishPasskeyRegistrationResp.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetSignalRecordsResp) GetTotalNumber() uint32 {
-	if x != nil {
-		return x.TotalNumber
+type BeginPasskeyLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BeginPasskeyLoginReq) Reset() {
+	*x = BeginPasskeyLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GetSignalRecordsResp) GetSignalRecords() []*SignalRecord {
-	if x != nil {
-		return x.SignalRecords
+func (x *BeginPasskeyLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginReq) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type OpenIMCallbackReq struct {
+// Deprecated: Use BeginPasskeyLoginReq.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{35}
+}
+
+type BeginPasskeyLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command"`
-	Body    string `protobuf:"bytes,2,opt,name=body,proto3" json:"body"`
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+	RpID      string `protobuf:"bytes,2,opt,name=rpID,proto3" json:"rpID"`
+	Timeout   int64  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout"` // 毫秒
 }
 
-func (x *OpenIMCallbackReq) Reset() {
-	*x = OpenIMCallbackReq{}
+func (x *BeginPasskeyLoginResp) Reset() {
+	*x = BeginPasskeyLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[31]
+		mi := &file_chat_chat_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenIMCallbackReq) String() string {
+func (x *BeginPasskeyLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenIMCallbackReq) ProtoMessage() {}
+func (*BeginPasskeyLoginResp) ProtoMessage() {}
 
-func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[31]
+func (x *BeginPasskeyLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2121,48 +2483,60 @@ func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenIMCallbackReq.ProtoReflect.Descriptor instead.
-func (*OpenIMCallbackReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use BeginPasskeyLoginResp.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *OpenIMCallbackReq) GetCommand() string {
+func (x *BeginPasskeyLoginResp) GetChallenge() string {
 	if x != nil {
-		return x.Command
+		return x.Challenge
 	}
 	return ""
 }
 
-func (x *OpenIMCallbackReq) GetBody() string {
+func (x *BeginPasskeyLoginResp) GetRpID() string {
 	if x != nil {
-		return x.Body
+		return x.RpID
 	}
 	return ""
 }
 
-type OpenIMCallbackResp struct {
+func (x *BeginPasskeyLoginResp) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type PasskeyInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	CredentialID string `protobuf:"bytes,1,opt,name=credentialID,proto3" json:"credentialID"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	CreateTime   int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
+	LastUsedTime int64  `protobuf:"varint,4,opt,name=lastUsedTime,proto3" json:"lastUsedTime"`
 }
 
-func (x *OpenIMCallbackResp) Reset() {
-	*x = OpenIMCallbackResp{}
+func (x *PasskeyInfo) Reset() {
+	*x = PasskeyInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[32]
+		mi := &file_chat_chat_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenIMCallbackResp) String() string {
+func (x *PasskeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenIMCallbackResp) ProtoMessage() {}
+func (*PasskeyInfo) ProtoMessage() {}
 
-func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[32]
+func (x *PasskeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2173,39 +2547,62 @@ func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenIMCallbackResp.ProtoReflect.Descriptor instead.
-func (*OpenIMCallbackResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PasskeyInfo.ProtoReflect.Descriptor instead.
+func (*PasskeyInfo) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{37}
 }
 
-type SearchUserFullInfoReq struct {
+func (x *PasskeyInfo) GetCredentialID() string {
+	if x != nil {
+		return x.CredentialID
+	}
+	return ""
+}
+
+func (x *PasskeyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PasskeyInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PasskeyInfo) GetLastUsedTime() int64 {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return 0
+}
+
+type FindPasskeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
-	Genders    int32                    `protobuf:"varint,3,opt,name=genders,proto3" json:"genders"`
-	Normal     int32                    `protobuf:"varint,4,opt,name=normal,proto3" json:"normal"`
 }
 
-func (x *SearchUserFullInfoReq) Reset() {
-	*x = SearchUserFullInfoReq{}
+func (x *FindPasskeyReq) Reset() {
+	*x = FindPasskeyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[33]
+		mi := &file_chat_chat_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserFullInfoReq) String() string {
+func (x *FindPasskeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserFullInfoReq) ProtoMessage() {}
+func (*FindPasskeyReq) ProtoMessage() {}
 
-func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[33]
+func (x *FindPasskeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2216,65 +2613,83 @@ func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserFullInfoReq.ProtoReflect.Descriptor instead.
-func (*SearchUserFullInfoReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use FindPasskeyReq.ProtoReflect.Descriptor instead.
+func (*FindPasskeyReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *SearchUserFullInfoReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
+type FindPasskeyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passkeys []*PasskeyInfo `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys"`
 }
 
-func (x *SearchUserFullInfoReq) GetPagination() *sdkws.RequestPagination {
-	if x != nil {
-		return x.Pagination
+func (x *FindPasskeyResp) Reset() {
+	*x = FindPasskeyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *SearchUserFullInfoReq) GetGenders() int32 {
-	if x != nil {
-		return x.Genders
+func (x *FindPasskeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindPasskeyResp) ProtoMessage() {}
+
+func (x *FindPasskeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SearchUserFullInfoReq) GetNormal() int32 {
+// Deprecated: Use FindPasskeyResp.ProtoReflect.Descriptor instead.
+func (*FindPasskeyResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FindPasskeyResp) GetPasskeys() []*PasskeyInfo {
 	if x != nil {
-		return x.Normal
+		return x.Passkeys
 	}
-	return 0
+	return nil
 }
 
-type SearchUserFullInfoResp struct {
+type DelPasskeyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	CredentialID string `protobuf:"bytes,1,opt,name=credentialID,proto3" json:"credentialID"`
 }
 
-func (x *SearchUserFullInfoResp) Reset() {
-	*x = SearchUserFullInfoResp{}
+func (x *DelPasskeyReq) Reset() {
+	*x = DelPasskeyReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[34]
+		mi := &file_chat_chat_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserFullInfoResp) String() string {
+func (x *DelPasskeyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserFullInfoResp) ProtoMessage() {}
+func (*DelPasskeyReq) ProtoMessage() {}
 
-func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[34]
+func (x *DelPasskeyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2285,51 +2700,83 @@ func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserFullInfoResp.ProtoReflect.Descriptor instead.
-func (*SearchUserFullInfoResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use DelPasskeyReq.ProtoReflect.Descriptor instead.
+func (*DelPasskeyReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *SearchUserFullInfoResp) GetTotal() uint32 {
+func (x *DelPasskeyReq) GetCredentialID() string {
 	if x != nil {
-		return x.Total
+		return x.CredentialID
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchUserFullInfoResp) GetUsers() []*common.UserFullInfo {
-	if x != nil {
-		return x.Users
+type DelPasskeyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelPasskeyResp) Reset() {
+	*x = DelPasskeyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type UserLoginCountReq struct {
+func (x *DelPasskeyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelPasskeyResp) ProtoMessage() {}
+
+func (x *DelPasskeyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelPasskeyResp.ProtoReflect.Descriptor instead.
+func (*DelPasskeyResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{41}
+}
+
+type CreateQRLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
-	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
+	Platform int32  `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
+	DeviceID string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip       string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *UserLoginCountReq) Reset() {
-	*x = UserLoginCountReq{}
+func (x *CreateQRLoginReq) Reset() {
+	*x = CreateQRLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[35]
+		mi := &file_chat_chat_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserLoginCountReq) String() string {
+func (x *CreateQRLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLoginCountReq) ProtoMessage() {}
+func (*CreateQRLoginReq) ProtoMessage() {}
 
-func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[35]
+func (x *CreateQRLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2340,52 +2787,59 @@ func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLoginCountReq.ProtoReflect.Descriptor instead.
-func (*UserLoginCountReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateQRLoginReq.ProtoReflect.Descriptor instead.
+func (*CreateQRLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UserLoginCountReq) GetStart() int64 {
+func (x *CreateQRLoginReq) GetPlatform() int32 {
 	if x != nil {
-		return x.Start
+		return x.Platform
 	}
 	return 0
 }
 
-func (x *UserLoginCountReq) GetEnd() int64 {
+func (x *CreateQRLoginReq) GetDeviceID() string {
 	if x != nil {
-		return x.End
+		return x.DeviceID
 	}
-	return 0
+	return ""
 }
 
-type UserLoginCountResp struct {
+func (x *CreateQRLoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type CreateQRLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LoginCount   int64            `protobuf:"varint,1,opt,name=loginCount,proto3" json:"loginCount"`
-	UnloginCount int64            `protobuf:"varint,2,opt,name=unloginCount,proto3" json:"unloginCount"`
-	Count        map[string]int64 `protobuf:"bytes,3,rep,name=Count,proto3" json:"Count" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ticket     string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket"` // 二维码内容
+	Secret     string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret"` // 仅发起端持有, 查询状态时使用
+	ExpireTime int64  `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime"`
 }
 
-func (x *UserLoginCountResp) Reset() {
-	*x = UserLoginCountResp{}
+func (x *CreateQRLoginResp) Reset() {
+	*x = CreateQRLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[36]
+		mi := &file_chat_chat_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserLoginCountResp) String() string {
+func (x *CreateQRLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLoginCountResp) ProtoMessage() {}
+func (*CreateQRLoginResp) ProtoMessage() {}
 
-func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[36]
+func (x *CreateQRLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2396,58 +2850,58 @@ func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLoginCountResp.ProtoReflect.Descriptor instead.
-func (*UserLoginCountResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use CreateQRLoginResp.ProtoReflect.Descriptor instead.
+func (*CreateQRLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UserLoginCountResp) GetLoginCount() int64 {
+func (x *CreateQRLoginResp) GetTicket() string {
 	if x != nil {
-		return x.LoginCount
+		return x.Ticket
 	}
-	return 0
+	return ""
 }
 
-func (x *UserLoginCountResp) GetUnloginCount() int64 {
+func (x *CreateQRLoginResp) GetSecret() string {
 	if x != nil {
-		return x.UnloginCount
+		return x.Secret
 	}
-	return 0
+	return ""
 }
 
-func (x *UserLoginCountResp) GetCount() map[string]int64 {
+func (x *CreateQRLoginResp) GetExpireTime() int64 {
 	if x != nil {
-		return x.Count
+		return x.ExpireTime
 	}
-	return nil
+	return 0
 }
 
-type FileURL struct {
+type GetQRLoginStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename"`
-	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL"`
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket"`
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret"`
 }
 
-func (x *FileURL) Reset() {
-	*x = FileURL{}
+func (x *GetQRLoginStatusReq) Reset() {
+	*x = GetQRLoginStatusReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[37]
+		mi := &file_chat_chat_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FileURL) String() string {
+func (x *GetQRLoginStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileURL) ProtoMessage() {}
+func (*GetQRLoginStatusReq) ProtoMessage() {}
 
-func (x *FileURL) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[37]
+func (x *GetQRLoginStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2458,54 +2912,54 @@ func (x *FileURL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileURL.ProtoReflect.Descriptor instead.
-func (*FileURL) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetQRLoginStatusReq.ProtoReflect.Descriptor instead.
+func (*GetQRLoginStatusReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *FileURL) GetFilename() string {
+func (x *GetQRLoginStatusReq) GetTicket() string {
 	if x != nil {
-		return x.Filename
+		return x.Ticket
 	}
 	return ""
 }
 
-func (x *FileURL) GetURL() string {
+func (x *GetQRLoginStatusReq) GetSecret() string {
 	if x != nil {
-		return x.URL
+		return x.Secret
 	}
 	return ""
 }
 
-type UploadLogsReq struct {
+type GetQRLoginStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Platform   int32      `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
-	FileURLs   []*FileURL `protobuf:"bytes,2,rep,name=fileURLs,proto3" json:"fileURLs"`
-	SystemType string     `protobuf:"bytes,3,opt,name=systemType,proto3" json:"systemType"`
-	Version    string     `protobuf:"bytes,5,opt,name=version,proto3" json:"version"`
-	Ex         string     `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+	Status       int32  `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	UserID       string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	ChatToken    string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"` // 状态为已确认时返回
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
+	Platform     int32  `protobuf:"varint,5,opt,name=platform,proto3" json:"platform"`
 }
 
-func (x *UploadLogsReq) Reset() {
-	*x = UploadLogsReq{}
+func (x *GetQRLoginStatusResp) Reset() {
+	*x = GetQRLoginStatusResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[38]
+		mi := &file_chat_chat_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadLogsReq) String() string {
+func (x *GetQRLoginStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadLogsReq) ProtoMessage() {}
+func (*GetQRLoginStatusResp) ProtoMessage() {}
 
-func (x *UploadLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[38]
+func (x *GetQRLoginStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,69 +2970,71 @@ func (x *UploadLogsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadLogsReq.ProtoReflect.Descriptor instead.
-func (*UploadLogsReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetQRLoginStatusResp.ProtoReflect.Descriptor instead.
+func (*GetQRLoginStatusResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UploadLogsReq) GetPlatform() int32 {
+func (x *GetQRLoginStatusResp) GetStatus() int32 {
 	if x != nil {
-		return x.Platform
+		return x.Status
 	}
 	return 0
 }
 
-func (x *UploadLogsReq) GetFileURLs() []*FileURL {
+func (x *GetQRLoginStatusResp) GetUserID() string {
 	if x != nil {
-		return x.FileURLs
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadLogsReq) GetSystemType() string {
+func (x *GetQRLoginStatusResp) GetChatToken() string {
 	if x != nil {
-		return x.SystemType
+		return x.ChatToken
 	}
 	return ""
 }
 
-func (x *UploadLogsReq) GetVersion() string {
+func (x *GetQRLoginStatusResp) GetRefreshToken() string {
 	if x != nil {
-		return x.Version
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *UploadLogsReq) GetEx() string {
+func (x *GetQRLoginStatusResp) GetPlatform() int32 {
 	if x != nil {
-		return x.Ex
+		return x.Platform
 	}
-	return ""
+	return 0
 }
 
-type UploadLogsResp struct {
+type ScanQRLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket"`
 }
 
-func (x *UploadLogsResp) Reset() {
-	*x = UploadLogsResp{}
+func (x *ScanQRLoginReq) Reset() {
+	*x = ScanQRLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[39]
+		mi := &file_chat_chat_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadLogsResp) String() string {
+func (x *ScanQRLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadLogsResp) ProtoMessage() {}
+func (*ScanQRLoginReq) ProtoMessage() {}
 
-func (x *UploadLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[39]
+func (x *ScanQRLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2589,36 +3045,45 @@ func (x *UploadLogsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadLogsResp.ProtoReflect.Descriptor instead.
-func (*UploadLogsResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ScanQRLoginReq.ProtoReflect.Descriptor instead.
+func (*ScanQRLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{46}
 }
 
-type DeleteLogsReq struct {
+func (x *ScanQRLoginReq) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type ScanQRLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LogIDs []string `protobuf:"bytes,1,rep,name=logIDs,proto3" json:"logIDs"`
+	Platform int32  `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
+	DeviceID string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	Ip       string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *DeleteLogsReq) Reset() {
-	*x = DeleteLogsReq{}
+func (x *ScanQRLoginResp) Reset() {
+	*x = ScanQRLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[40]
+		mi := &file_chat_chat_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLogsReq) String() string {
+func (x *ScanQRLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLogsReq) ProtoMessage() {}
+func (*ScanQRLoginResp) ProtoMessage() {}
 
-func (x *DeleteLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[40]
+func (x *ScanQRLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2629,41 +3094,57 @@ func (x *DeleteLogsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLogsReq.ProtoReflect.Descriptor instead.
-func (*DeleteLogsReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ScanQRLoginResp.ProtoReflect.Descriptor instead.
+func (*ScanQRLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DeleteLogsReq) GetLogIDs() []string {
+func (x *ScanQRLoginResp) GetPlatform() int32 {
 	if x != nil {
-		return x.LogIDs
+		return x.Platform
 	}
-	return nil
+	return 0
 }
 
-type DeleteLogsResp struct {
+func (x *ScanQRLoginResp) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *ScanQRLoginResp) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type ConfirmQRLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ticket string `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket"`
 }
 
-func (x *DeleteLogsResp) Reset() {
-	*x = DeleteLogsResp{}
+func (x *ConfirmQRLoginReq) Reset() {
+	*x = ConfirmQRLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[41]
+		mi := &file_chat_chat_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteLogsResp) String() string {
+func (x *ConfirmQRLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLogsResp) ProtoMessage() {}
+func (*ConfirmQRLoginReq) ProtoMessage() {}
 
-func (x *DeleteLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[41]
+func (x *ConfirmQRLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,39 +3155,41 @@ func (x *DeleteLogsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLogsResp.ProtoReflect.Descriptor instead.
-func (*DeleteLogsResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ConfirmQRLoginReq.ProtoReflect.Descriptor instead.
+func (*ConfirmQRLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{48}
 }
 
-type SearchLogsReq struct {
+func (x *ConfirmQRLoginReq) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type ConfirmQRLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	StartTime  int64                    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime"`
-	EndTime    int64                    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *SearchLogsReq) Reset() {
-	*x = SearchLogsReq{}
+func (x *ConfirmQRLoginResp) Reset() {
+	*x = ConfirmQRLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[42]
+		mi := &file_chat_chat_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchLogsReq) String() string {
+func (x *ConfirmQRLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLogsReq) ProtoMessage() {}
+func (*ConfirmQRLoginResp) ProtoMessage() {}
 
-func (x *SearchLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[42]
+func (x *ConfirmQRLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2717,65 +3200,89 @@ func (x *SearchLogsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLogsReq.ProtoReflect.Descriptor instead.
-func (*SearchLogsReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ConfirmQRLoginResp.ProtoReflect.Descriptor instead.
+func (*ConfirmQRLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SearchLogsReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
+type UnlockUserLoginReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Ip     string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"` // 同时解除该IP的锁定, 可为空
+}
+
+func (x *UnlockUserLoginReq) Reset() {
+	*x = UnlockUserLoginReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SearchLogsReq) GetStartTime() int64 {
-	if x != nil {
-		return x.StartTime
+func (x *UnlockUserLoginReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserLoginReq) ProtoMessage() {}
+
+func (x *UnlockUserLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SearchLogsReq) GetEndTime() int64 {
+// Deprecated: Use UnlockUserLoginReq.ProtoReflect.Descriptor instead.
+func (*UnlockUserLoginReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UnlockUserLoginReq) GetUserID() string {
 	if x != nil {
-		return x.EndTime
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchLogsReq) GetPagination() *sdkws.RequestPagination {
+func (x *UnlockUserLoginReq) GetIp() string {
 	if x != nil {
-		return x.Pagination
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type SearchLogsResp struct {
+type UnlockUserLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	LogsInfos []*common.LogInfo `protobuf:"bytes,1,rep,name=LogsInfos,proto3" json:"LogsInfos"`
-	Total     uint32            `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
 }
 
-func (x *SearchLogsResp) Reset() {
-	*x = SearchLogsResp{}
+func (x *UnlockUserLoginResp) Reset() {
+	*x = UnlockUserLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[43]
+		mi := &file_chat_chat_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchLogsResp) String() string {
+func (x *UnlockUserLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchLogsResp) ProtoMessage() {}
+func (*UnlockUserLoginResp) ProtoMessage() {}
 
-func (x *SearchLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[43]
+func (x *UnlockUserLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2786,53 +3293,41 @@ func (x *SearchLogsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchLogsResp.ProtoReflect.Descriptor instead.
-func (*SearchLogsResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *SearchLogsResp) GetLogsInfos() []*common.LogInfo {
-	if x != nil {
-		return x.LogsInfos
-	}
-	return nil
-}
-
-func (x *SearchLogsResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use UnlockUserLoginResp.ProtoReflect.Descriptor instead.
+func (*UnlockUserLoginResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{51}
 }
 
-type SearchUserInfoReq struct {
+type DeleteAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
-	Genders    []int32                  `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
-	UserIDs    []string                 `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
+	Password    string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"`
+	AreaCode    string `protobuf:"bytes,2,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Email       string `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
+	VerifyCode  string `protobuf:"bytes,5,opt,name=verifyCode,proto3" json:"verifyCode"` // 用途为注销账号的验证码
+	TotpCode    string `protobuf:"bytes,6,opt,name=totpCode,proto3" json:"totpCode"`     // 开启二次验证时必填, 动态码或恢复码
 }
 
-func (x *SearchUserInfoReq) Reset() {
-	*x = SearchUserInfoReq{}
+func (x *DeleteAccountReq) Reset() {
+	*x = DeleteAccountReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[44]
+		mi := &file_chat_chat_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserInfoReq) String() string {
+func (x *DeleteAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoReq) ProtoMessage() {}
+func (*DeleteAccountReq) ProtoMessage() {}
 
-func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[44]
+func (x *DeleteAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2843,65 +3338,78 @@ func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use DeleteAccountReq.ProtoReflect.Descriptor instead.
+func (*DeleteAccountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *SearchUserInfoReq) GetKeyword() string {
+func (x *DeleteAccountReq) GetPassword() string {
 	if x != nil {
-		return x.Keyword
+		return x.Password
 	}
 	return ""
 }
 
-func (x *SearchUserInfoReq) GetPagination() *sdkws.RequestPagination {
+func (x *DeleteAccountReq) GetAreaCode() string {
 	if x != nil {
-		return x.Pagination
+		return x.AreaCode
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchUserInfoReq) GetGenders() []int32 {
+func (x *DeleteAccountReq) GetPhoneNumber() string {
 	if x != nil {
-		return x.Genders
+		return x.PhoneNumber
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchUserInfoReq) GetUserIDs() []string {
+func (x *DeleteAccountReq) GetEmail() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-type SearchUserInfoResp struct {
+func (x *DeleteAccountReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *DeleteAccountReq) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type DeleteAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	ExecuteTime int64 `protobuf:"varint,1,opt,name=executeTime,proto3" json:"executeTime"` // 到期后执行注销, 毫秒时间戳
 }
 
-func (x *SearchUserInfoResp) Reset() {
-	*x = SearchUserInfoResp{}
+func (x *DeleteAccountResp) Reset() {
+	*x = DeleteAccountResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[45]
+		mi := &file_chat_chat_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserInfoResp) String() string {
+func (x *DeleteAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoResp) ProtoMessage() {}
+func (*DeleteAccountResp) ProtoMessage() {}
 
-func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[45]
+func (x *DeleteAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2912,708 +3420,4395 @@ func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use DeleteAccountResp.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *SearchUserInfoResp) GetTotal() uint32 {
+func (x *DeleteAccountResp) GetExecuteTime() int64 {
 	if x != nil {
-		return x.Total
+		return x.ExecuteTime
 	}
 	return 0
 }
 
-func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
-	if x != nil {
-		return x.Users
+type CancelDeleteAccountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelDeleteAccountReq) Reset() {
+	*x = CancelDeleteAccountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-var File_chat_chat_proto protoreflect.FileDescriptor
+func (x *CancelDeleteAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-var file_chat_chat_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x1a, 0x14, 0x70, 0x75, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x75, 0x62, 0x2f, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4,
-	0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfa, 0x06, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x44, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x3e, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
-	0x39, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0e,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64,
-	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x42, 0x65, 0x65, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65, 0x70, 0x12, 0x49, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63,
-	0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f,
-	0x70, 0x74, 0x22, 0x4a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31,
-	0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x22, 0x51, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x18, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x35, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6d, 0x0a, 0x0d, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x98, 0x02, 0x0a, 0x10,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14,
-	0x0a, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62,
-	0x69, 0x72, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
-	0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xf6, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
-	0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22,
-	0x48, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63,
-	0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe6, 0x01, 0x0a, 0x08, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x70, 0x22, 0x41, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x8c, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72,
-	0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72,
-	0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18,
-	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x60, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x60, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61,
-	0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61,
-	0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
-	0x52, 0x4c, 0x22, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0c, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x15, 0x0a,
-	0x13, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x45, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x7d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0xaa, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75,
-	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x22, 0x65, 0x0a, 0x16,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64,
-	0x22, 0xd8, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67,
-	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75,
-	0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x05, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a, 0x07, 0x66,
-	0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x55, 0x52, 0x4c, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c,
-	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x12, 0x34, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x08,
-	0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x65, 0x78, 0x22, 0x10, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x49, 0x44, 0x73, 0x22, 0x10, 0x0a,
-	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0xa8, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x09,
-	0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x4c, 0x6f, 0x67,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xa8, 0x01, 0x0a,
-	0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x61, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x32, 0xe8, 0x0e, 0x0a, 0x04, 0x63,
-	0x68, 0x61, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
-	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b,
-	0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x46,
-	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
-	0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75,
-	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x53, 0x65,
-	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
-	0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x12, 0x19, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f,
-	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x46, 0x69,
-	0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x23, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67,
-	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
-	0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
-	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a,
-	0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70,
-	0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x63, 0x68,
-	0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x61,
-	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (*CancelDeleteAccountReq) ProtoMessage() {}
+
+func (x *CancelDeleteAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var (
-	file_chat_chat_proto_rawDescOnce sync.Once
-	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
-)
+// Deprecated: Use CancelDeleteAccountReq.ProtoReflect.Descriptor instead.
+func (*CancelDeleteAccountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{54}
+}
 
-func file_chat_chat_proto_rawDescGZIP() []byte {
-	file_chat_chat_proto_rawDescOnce.Do(func() {
-		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
-	})
-	return file_chat_chat_proto_rawDescData
+type CancelDeleteAccountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
-var file_chat_chat_proto_goTypes = []interface{}{
-	(*UserIdentity)(nil),             // 0: OpenIMChat.chat.UserIdentity
-	(*UpdateUserInfoReq)(nil),        // 1: OpenIMChat.chat.UpdateUserInfoReq
-	(*UpdateUserInfoResp)(nil),       // 2: OpenIMChat.chat.UpdateUserInfoResp
-	(*FindUserPublicInfoReq)(nil),    // 3: OpenIMChat.chat.FindUserPublicInfoReq
-	(*FindUserPublicInfoResp)(nil),   // 4: OpenIMChat.chat.FindUserPublicInfoResp
-	(*SearchUserPublicInfoReq)(nil),  // 5: OpenIMChat.chat.SearchUserPublicInfoReq
-	(*SearchUserPublicInfoResp)(nil), // 6: OpenIMChat.chat.SearchUserPublicInfoResp
-	(*FindUserFullInfoReq)(nil),      // 7: OpenIMChat.chat.FindUserFullInfoReq
-	(*FindUserFullInfoResp)(nil),     // 8: OpenIMChat.chat.FindUserFullInfoResp
-	(*SendVerifyCodeReq)(nil),        // 9: OpenIMChat.chat.SendVerifyCodeReq
-	(*SendVerifyCodeResp)(nil),       // 10: OpenIMChat.chat.SendVerifyCodeResp
-	(*VerifyCodeReq)(nil),            // 11: OpenIMChat.chat.VerifyCodeReq
-	(*VerifyCodeResp)(nil),           // 12: OpenIMChat.chat.VerifyCodeResp
-	(*RegisterUserInfo)(nil),         // 13: OpenIMChat.chat.RegisterUserInfo
-	(*RegisterUserReq)(nil),          // 14: OpenIMChat.chat.RegisterUserReq
-	(*RegisterUserResp)(nil),         // 15: OpenIMChat.chat.RegisterUserResp
-	(*LoginReq)(nil),                 // 16: OpenIMChat.chat.LoginReq
-	(*LoginResp)(nil),                // 17: OpenIMChat.chat.LoginResp
-	(*ResetPasswordReq)(nil),         // 18: OpenIMChat.chat.ResetPasswordReq
-	(*ResetPasswordResp)(nil),        // 19: OpenIMChat.chat.ResetPasswordResp
-	(*ChangePasswordReq)(nil),        // 20: OpenIMChat.chat.ChangePasswordReq
-	(*ChangePasswordResp)(nil),       // 21: OpenIMChat.chat.ChangePasswordResp
-	(*FindUserAccountReq)(nil),       // 22: OpenIMChat.chat.FindUserAccountReq
-	(*FindUserAccountResp)(nil),      // 23: OpenIMChat.chat.FindUserAccountResp
-	(*FindAccountUserReq)(nil),       // 24: OpenIMChat.chat.FindAccountUserReq
-	(*FindAccountUserResp)(nil),      // 25: OpenIMChat.chat.FindAccountUserResp
-	(*SignalRecord)(nil),             // 26: OpenIMChat.chat.SignalRecord
-	(*AddSignalRecordReq)(nil),       // 27: OpenIMChat.chat.AddSignalRecordReq
-	(*AddSignalRecordResp)(nil),      // 28: OpenIMChat.chat.AddSignalRecordResp
-	(*GetSignalRecordsReq)(nil),      // 29: OpenIMChat.chat.GetSignalRecordsReq
-	(*GetSignalRecordsResp)(nil),     // 30: OpenIMChat.chat.GetSignalRecordsResp
-	(*OpenIMCallbackReq)(nil),        // 31: OpenIMChat.chat.OpenIMCallbackReq
-	(*OpenIMCallbackResp)(nil),       // 32: OpenIMChat.chat.OpenIMCallbackResp
-	(*SearchUserFullInfoReq)(nil),    // 33: OpenIMChat.chat.SearchUserFullInfoReq
-	(*SearchUserFullInfoResp)(nil),   // 34: OpenIMChat.chat.SearchUserFullInfoResp
-	(*UserLoginCountReq)(nil),        // 35: OpenIMChat.chat.UserLoginCountReq
-	(*UserLoginCountResp)(nil),       // 36: OpenIMChat.chat.UserLoginCountResp
-	(*FileURL)(nil),                  // 37: OpenIMChat.chat.fileURL
-	(*UploadLogsReq)(nil),            // 38: OpenIMChat.chat.UploadLogsReq
-	(*UploadLogsResp)(nil),           // 39: OpenIMChat.chat.UploadLogsResp
-	(*DeleteLogsReq)(nil),            // 40: OpenIMChat.chat.DeleteLogsReq
-	(*DeleteLogsResp)(nil),           // 41: OpenIMChat.chat.DeleteLogsResp
-	(*SearchLogsReq)(nil),            // 42: OpenIMChat.chat.SearchLogsReq
-	(*SearchLogsResp)(nil),           // 43: OpenIMChat.chat.SearchLogsResp
-	(*SearchUserInfoReq)(nil),        // 44: OpenIMChat.chat.SearchUserInfoReq
-	(*SearchUserInfoResp)(nil),       // 45: OpenIMChat.chat.SearchUserInfoResp
-	nil,                              // 46: OpenIMChat.chat.FindUserAccountResp.UserAccountMapEntry
-	nil,                              // 47: OpenIMChat.chat.FindAccountUserResp.AccountUserMapEntry
-	nil,                              // 48: OpenIMChat.chat.UserLoginCountResp.CountEntry
-	(*wrapperspb.StringValue)(nil),   // 49: OpenIMServer.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),    // 50: OpenIMServer.protobuf.Int32Value
-	(*wrapperspb.Int64Value)(nil),    // 51: OpenIMServer.protobuf.Int64Value
-	(*common.UserPublicInfo)(nil),    // 52: OpenIMChat.common.UserPublicInfo
-	(*sdkws.RequestPagination)(nil),  // 53: OpenIMServer.sdkws.RequestPagination
-	(*common.UserFullInfo)(nil),      // 54: OpenIMChat.common.UserFullInfo
-	(*common.LogInfo)(nil),           // 55: OpenIMChat.common.LogInfo
+func (x *CancelDeleteAccountResp) Reset() {
+	*x = CancelDeleteAccountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_chat_chat_proto_depIdxs = []int32{
-	49, // 0: OpenIMChat.chat.UpdateUserInfoReq.account:type_name -> OpenIMServer.protobuf.StringValue
-	49, // 1: OpenIMChat.chat.UpdateUserInfoReq.phoneNumber:type_name -> OpenIMServer.protobuf.StringValue
-	49, // 2: OpenIMChat.chat.UpdateUserInfoReq.areaCode:type_name -> OpenIMServer.protobuf.StringValue
-	49, // 3: OpenIMChat.chat.UpdateUserInfoReq.email:type_name -> OpenIMServer.protobuf.StringValue
-	49, // 4: OpenIMChat.chat.UpdateUserInfoReq.nickname:type_name -> OpenIMServer.protobuf.StringValue
-	49, // 5: OpenIMChat.chat.UpdateUserInfoReq.faceURL:type_name -> OpenIMServer.protobuf.StringValue
-	50, // 6: OpenIMChat.chat.UpdateUserInfoReq.gender:type_name -> OpenIMServer.protobuf.Int32Value
-	50, // 7: OpenIMChat.chat.UpdateUserInfoReq.level:type_name -> OpenIMServer.protobuf.Int32Value
-	51, // 8: OpenIMChat.chat.UpdateUserInfoReq.birth:type_name -> OpenIMServer.protobuf.Int64Value
-	50, // 9: OpenIMChat.chat.UpdateUserInfoReq.allowAddFriend:type_name -> OpenIMServer.protobuf.Int32Value
-	50, // 10: OpenIMChat.chat.UpdateUserInfoReq.allowBeep:type_name -> OpenIMServer.protobuf.Int32Value
-	50, // 11: OpenIMChat.chat.UpdateUserInfoReq.allowVibration:type_name -> OpenIMServer.protobuf.Int32Value
-	50, // 12: OpenIMChat.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> OpenIMServer.protobuf.Int32Value
-	52, // 13: OpenIMChat.chat.FindUserPublicInfoResp.users:type_name -> OpenIMChat.common.UserPublicInfo
-	53, // 14: OpenIMChat.chat.SearchUserPublicInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	52, // 15: OpenIMChat.chat.SearchUserPublicInfoResp.users:type_name -> OpenIMChat.common.UserPublicInfo
-	54, // 16: OpenIMChat.chat.FindUserFullInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
-	13, // 17: OpenIMChat.chat.RegisterUserReq.user:type_name -> OpenIMChat.chat.RegisterUserInfo
-	46, // 18: OpenIMChat.chat.FindUserAccountResp.userAccountMap:type_name -> OpenIMChat.chat.FindUserAccountResp.UserAccountMapEntry
-	47, // 19: OpenIMChat.chat.FindAccountUserResp.accountUserMap:type_name -> OpenIMChat.chat.FindAccountUserResp.AccountUserMapEntry
-	52, // 20: OpenIMChat.chat.SignalRecord.inviterUserList:type_name -> OpenIMChat.common.UserPublicInfo
-	26, // 21: OpenIMChat.chat.AddSignalRecordReq.signalRecord:type_name -> OpenIMChat.chat.SignalRecord
-	53, // 22: OpenIMChat.chat.GetSignalRecordsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	26, // 23: OpenIMChat.chat.GetSignalRecordsResp.signalRecords:type_name -> OpenIMChat.chat.SignalRecord
-	53, // 24: OpenIMChat.chat.SearchUserFullInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	54, // 25: OpenIMChat.chat.SearchUserFullInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
-	48, // 26: OpenIMChat.chat.UserLoginCountResp.Count:type_name -> OpenIMChat.chat.UserLoginCountResp.CountEntry
-	37, // 27: OpenIMChat.chat.UploadLogsReq.fileURLs:type_name -> OpenIMChat.chat.fileURL
-	53, // 28: OpenIMChat.chat.SearchLogsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	55, // 29: OpenIMChat.chat.SearchLogsResp.LogsInfos:type_name -> OpenIMChat.common.LogInfo
-	53, // 30: OpenIMChat.chat.SearchUserInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	54, // 31: OpenIMChat.chat.SearchUserInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
-	1,  // 32: OpenIMChat.chat.chat.UpdateUserInfo:input_type -> OpenIMChat.chat.UpdateUserInfoReq
-	5,  // 33: OpenIMChat.chat.chat.SearchUserPublicInfo:input_type -> OpenIMChat.chat.SearchUserPublicInfoReq
-	3,  // 34: OpenIMChat.chat.chat.FindUserPublicInfo:input_type -> OpenIMChat.chat.FindUserPublicInfoReq
-	33, // 35: OpenIMChat.chat.chat.SearchUserFullInfo:input_type -> OpenIMChat.chat.SearchUserFullInfoReq
-	7,  // 36: OpenIMChat.chat.chat.FindUserFullInfo:input_type -> OpenIMChat.chat.FindUserFullInfoReq
-	9,  // 37: OpenIMChat.chat.chat.SendVerifyCode:input_type -> OpenIMChat.chat.SendVerifyCodeReq
-	11, // 38: OpenIMChat.chat.chat.VerifyCode:input_type -> OpenIMChat.chat.VerifyCodeReq
-	14, // 39: OpenIMChat.chat.chat.RegisterUser:input_type -> OpenIMChat.chat.RegisterUserReq
-	16, // 40: OpenIMChat.chat.chat.Login:input_type -> OpenIMChat.chat.LoginReq
-	18, // 41: OpenIMChat.chat.chat.ResetPassword:input_type -> OpenIMChat.chat.ResetPasswordReq
-	20, // 42: OpenIMChat.chat.chat.ChangePassword:input_type -> OpenIMChat.chat.ChangePasswordReq
-	22, // 43: OpenIMChat.chat.chat.FindUserAccount:input_type -> OpenIMChat.chat.FindUserAccountReq
-	24, // 44: OpenIMChat.chat.chat.FindAccountUser:input_type -> OpenIMChat.chat.FindAccountUserReq
-	27, // 45: OpenIMChat.chat.chat.AddSignalRecord:input_type -> OpenIMChat.chat.AddSignalRecordReq
-	29, // 46: OpenIMChat.chat.chat.GetSignalRecords:input_type -> OpenIMChat.chat.GetSignalRecordsReq
-	31, // 47: OpenIMChat.chat.chat.OpenIMCallback:input_type -> OpenIMChat.chat.OpenIMCallbackReq
-	35, // 48: OpenIMChat.chat.chat.UserLoginCount:input_type -> OpenIMChat.chat.UserLoginCountReq
-	38, // 49: OpenIMChat.chat.chat.UploadLogs:input_type -> OpenIMChat.chat.UploadLogsReq
-	40, // 50: OpenIMChat.chat.chat.DeleteLogs:input_type -> OpenIMChat.chat.DeleteLogsReq
-	42, // 51: OpenIMChat.chat.chat.SearchLogs:input_type -> OpenIMChat.chat.SearchLogsReq
-	44, // 52: OpenIMChat.chat.chat.SearchUserInfo:input_type -> OpenIMChat.chat.SearchUserInfoReq
-	2,  // 53: OpenIMChat.chat.chat.UpdateUserInfo:output_type -> OpenIMChat.chat.UpdateUserInfoResp
-	6,  // 54: OpenIMChat.chat.chat.SearchUserPublicInfo:output_type -> OpenIMChat.chat.SearchUserPublicInfoResp
-	4,  // 55: OpenIMChat.chat.chat.FindUserPublicInfo:output_type -> OpenIMChat.chat.FindUserPublicInfoResp
-	34, // 56: OpenIMChat.chat.chat.SearchUserFullInfo:output_type -> OpenIMChat.chat.SearchUserFullInfoResp
-	8,  // 57: OpenIMChat.chat.chat.FindUserFullInfo:output_type -> OpenIMChat.chat.FindUserFullInfoResp
-	10, // 58: OpenIMChat.chat.chat.SendVerifyCode:output_type -> OpenIMChat.chat.SendVerifyCodeResp
-	12, // 59: OpenIMChat.chat.chat.VerifyCode:output_type -> OpenIMChat.chat.VerifyCodeResp
-	15, // 60: OpenIMChat.chat.chat.RegisterUser:output_type -> OpenIMChat.chat.RegisterUserResp
-	17, // 61: OpenIMChat.chat.chat.Login:output_type -> OpenIMChat.chat.LoginResp
-	19, // 62: OpenIMChat.chat.chat.ResetPassword:output_type -> OpenIMChat.chat.ResetPasswordResp
-	21, // 63: OpenIMChat.chat.chat.ChangePassword:output_type -> OpenIMChat.chat.ChangePasswordResp
-	23, // 64: OpenIMChat.chat.chat.FindUserAccount:output_type -> OpenIMChat.chat.FindUserAccountResp
-	25, // 65: OpenIMChat.chat.chat.FindAccountUser:output_type -> OpenIMChat.chat.FindAccountUserResp
-	28, // 66: OpenIMChat.chat.chat.AddSignalRecord:output_type -> OpenIMChat.chat.AddSignalRecordResp
-	30, // 67: OpenIMChat.chat.chat.GetSignalRecords:output_type -> OpenIMChat.chat.GetSignalRecordsResp
-	32, // 68: OpenIMChat.chat.chat.OpenIMCallback:output_type -> OpenIMChat.chat.OpenIMCallbackResp
-	36, // 69: OpenIMChat.chat.chat.UserLoginCount:output_type -> OpenIMChat.chat.UserLoginCountResp
-	39, // 70: OpenIMChat.chat.chat.UploadLogs:output_type -> OpenIMChat.chat.UploadLogsResp
-	41, // 71: OpenIMChat.chat.chat.DeleteLogs:output_type -> OpenIMChat.chat.DeleteLogsResp
-	43, // 72: OpenIMChat.chat.chat.SearchLogs:output_type -> OpenIMChat.chat.SearchLogsResp
-	45, // 73: OpenIMChat.chat.chat.SearchUserInfo:output_type -> OpenIMChat.chat.SearchUserInfoResp
-	53, // [53:74] is the sub-list for method output_type
-	32, // [32:53] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+
+func (x *CancelDeleteAccountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_chat_chat_proto_init() }
-func file_chat_chat_proto_init() {
-	if File_chat_chat_proto != nil {
-		return
+func (*CancelDeleteAccountResp) ProtoMessage() {}
+
+func (x *CancelDeleteAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_chat_chat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIdentity); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelDeleteAccountResp.ProtoReflect.Descriptor instead.
+func (*CancelDeleteAccountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{55}
+}
+
+type GetDeleteAccountStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetDeleteAccountStatusReq) Reset() {
+	*x = GetDeleteAccountStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeleteAccountStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeleteAccountStatusReq) ProtoMessage() {}
+
+func (x *GetDeleteAccountStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeleteAccountStatusReq.ProtoReflect.Descriptor instead.
+func (*GetDeleteAccountStatusReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{56}
+}
+
+type GetDeleteAccountStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreateTime  int64 `protobuf:"varint,1,opt,name=createTime,proto3" json:"createTime"`
+	ExecuteTime int64 `protobuf:"varint,2,opt,name=executeTime,proto3" json:"executeTime"` // 为0表示未申请注销
+}
+
+func (x *GetDeleteAccountStatusResp) Reset() {
+	*x = GetDeleteAccountStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeleteAccountStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeleteAccountStatusResp) ProtoMessage() {}
+
+func (x *GetDeleteAccountStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeleteAccountStatusResp.ProtoReflect.Descriptor instead.
+func (*GetDeleteAccountStatusResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDeleteAccountStatusResp) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GetDeleteAccountStatusResp) GetExecuteTime() int64 {
+	if x != nil {
+		return x.ExecuteTime
+	}
+	return 0
+}
+
+type CheckUserActiveReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *CheckUserActiveReq) Reset() {
+	*x = CheckUserActiveReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserActiveReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserActiveReq) ProtoMessage() {}
+
+func (x *CheckUserActiveReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserActiveReq.ProtoReflect.Descriptor instead.
+func (*CheckUserActiveReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CheckUserActiveReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type CheckUserActiveResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckUserActiveResp) Reset() {
+	*x = CheckUserActiveResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckUserActiveResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserActiveResp) ProtoMessage() {}
+
+func (x *CheckUserActiveResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserActiveResp.ProtoReflect.Descriptor instead.
+func (*CheckUserActiveResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{59}
+}
+
+type ResetPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AreaCode    string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	VerifyCode  string `protobuf:"bytes,3,opt,name=verifyCode,proto3" json:"verifyCode"`
+	Password    string `protobuf:"bytes,4,opt,name=password,proto3" json:"password"`
+	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+}
+
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordReq) ProtoMessage() {}
+
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResetPasswordReq) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ResetPasswordReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type ResetPasswordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetPasswordResp) Reset() {
+	*x = ResetPasswordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResp) ProtoMessage() {}
+
+func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{61}
+}
+
+type ChangePasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
+	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
+}
+
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordReq) ProtoMessage() {}
+
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ChangePasswordReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangePasswordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResp) ProtoMessage() {}
+
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{63}
+}
+
+type ChangeContactReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AreaCode      string `protobuf:"bytes,1,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber   string `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber"`     // 更换手机号时设置
+	Email         string `protobuf:"bytes,3,opt,name=email,proto3" json:"email"`                 // 更换邮箱时设置
+	VerifyCode    string `protobuf:"bytes,4,opt,name=verifyCode,proto3" json:"verifyCode"`       // 新手机号或邮箱收到的验证码
+	OldVerifyCode string `protobuf:"bytes,5,opt,name=oldVerifyCode,proto3" json:"oldVerifyCode"` // 原手机号或邮箱收到的验证码, 开启changeContact.verifyOld时需要
+}
+
+func (x *ChangeContactReq) Reset() {
+	*x = ChangeContactReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeContactReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeContactReq) ProtoMessage() {}
+
+func (x *ChangeContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeContactReq.ProtoReflect.Descriptor instead.
+func (*ChangeContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ChangeContactReq) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *ChangeContactReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *ChangeContactReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ChangeContactReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *ChangeContactReq) GetOldVerifyCode() string {
+	if x != nil {
+		return x.OldVerifyCode
+	}
+	return ""
+}
+
+type ChangeContactResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ChangeContactResp) Reset() {
+	*x = ChangeContactResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeContactResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeContactResp) ProtoMessage() {}
+
+func (x *ChangeContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeContactResp.ProtoReflect.Descriptor instead.
+func (*ChangeContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{65}
+}
+
+type BindIdentifierReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type"` // phone email account
+	AreaCode    string `protobuf:"bytes,2,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Email       string `protobuf:"bytes,4,opt,name=email,proto3" json:"email"`
+	Account     string `protobuf:"bytes,5,opt,name=account,proto3" json:"account"`
+	VerifyCode  string `protobuf:"bytes,6,opt,name=verifyCode,proto3" json:"verifyCode"` // phone/email: 新号码收到的验证码; account: 未设置密码时本人手机号或邮箱收到的验证码
+	Password    string `protobuf:"bytes,7,opt,name=password,proto3" json:"password"`     // account: 已设置密码时为当前密码, 未设置时为新密码
+}
+
+func (x *BindIdentifierReq) Reset() {
+	*x = BindIdentifierReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindIdentifierReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindIdentifierReq) ProtoMessage() {}
+
+func (x *BindIdentifierReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindIdentifierReq.ProtoReflect.Descriptor instead.
+func (*BindIdentifierReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BindIdentifierReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+func (x *BindIdentifierReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BindIdentifierResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BindIdentifierResp) Reset() {
+	*x = BindIdentifierResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BindIdentifierResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BindIdentifierResp) ProtoMessage() {}
+
+func (x *BindIdentifierResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BindIdentifierResp.ProtoReflect.Descriptor instead.
+func (*BindIdentifierResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{67}
+}
+
+type UnbindIdentifierReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type"` // phone email account
+	Password    string `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	AreaCode    string `protobuf:"bytes,3,opt,name=areaCode,proto3" json:"areaCode"`
+	PhoneNumber string `protobuf:"bytes,4,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	VerifyCode  string `protobuf:"bytes,6,opt,name=verifyCode,proto3" json:"verifyCode"` // 未填写密码时, 本人手机号或邮箱收到的验证码
+}
+
+func (x *UnbindIdentifierReq) Reset() {
+	*x = UnbindIdentifierReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbindIdentifierReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbindIdentifierReq) ProtoMessage() {}
+
+func (x *UnbindIdentifierReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbindIdentifierReq.ProtoReflect.Descriptor instead.
+func (*UnbindIdentifierReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UnbindIdentifierReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UnbindIdentifierReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *UnbindIdentifierReq) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *UnbindIdentifierReq) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *UnbindIdentifierReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UnbindIdentifierReq) GetVerifyCode() string {
+	if x != nil {
+		return x.VerifyCode
+	}
+	return ""
+}
+
+type UnbindIdentifierResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnbindIdentifierResp) Reset() {
+	*x = UnbindIdentifierResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnbindIdentifierResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnbindIdentifierResp) ProtoMessage() {}
+
+func (x *UnbindIdentifierResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnbindIdentifierResp.ProtoReflect.Descriptor instead.
+func (*UnbindIdentifierResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{69}
+}
+
+type FindUserAccountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *FindUserAccountReq) Reset() {
+	*x = FindUserAccountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserAccountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserAccountReq) ProtoMessage() {}
+
+func (x *FindUserAccountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserAccountReq.ProtoReflect.Descriptor instead.
+func (*FindUserAccountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FindUserAccountReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type FindUserAccountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAccountMap map[string]string `protobuf:"bytes,1,rep,name=userAccountMap,proto3" json:"userAccountMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // userID  account
+}
+
+func (x *FindUserAccountResp) Reset() {
+	*x = FindUserAccountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserAccountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserAccountResp) ProtoMessage() {}
+
+func (x *FindUserAccountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserAccountResp.ProtoReflect.Descriptor instead.
+func (*FindUserAccountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FindUserAccountResp) GetUserAccountMap() map[string]string {
+	if x != nil {
+		return x.UserAccountMap
+	}
+	return nil
+}
+
+type FindAccountUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []string `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+}
+
+func (x *FindAccountUserReq) Reset() {
+	*x = FindAccountUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccountUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccountUserReq) ProtoMessage() {}
+
+func (x *FindAccountUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccountUserReq.ProtoReflect.Descriptor instead.
+func (*FindAccountUserReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *FindAccountUserReq) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type FindAccountUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountUserMap map[string]string `protobuf:"bytes,1,rep,name=accountUserMap,proto3" json:"accountUserMap" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // account  userID
+}
+
+func (x *FindAccountUserResp) Reset() {
+	*x = FindAccountUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAccountUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAccountUserResp) ProtoMessage() {}
+
+func (x *FindAccountUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAccountUserResp.ProtoReflect.Descriptor instead.
+func (*FindAccountUserResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *FindAccountUserResp) GetAccountUserMap() map[string]string {
+	if x != nil {
+		return x.AccountUserMap
+	}
+	return nil
+}
+
+type SignalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName        string                   `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName"`
+	MediaType       string                   `protobuf:"bytes,2,opt,name=mediaType,proto3" json:"mediaType"`
+	RoomType        string                   `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType"`
+	SenderID        string                   `protobuf:"bytes,4,opt,name=senderID,proto3" json:"senderID"`
+	SenderNickname  string                   `protobuf:"bytes,5,opt,name=senderNickname,proto3" json:"senderNickname"`
+	RecvID          string                   `protobuf:"bytes,6,opt,name=recvID,proto3" json:"recvID"`
+	RecvNickname    string                   `protobuf:"bytes,7,opt,name=recvNickname,proto3" json:"recvNickname"`
+	GroupID         string                   `protobuf:"bytes,8,opt,name=groupID,proto3" json:"groupID"`
+	GroupName       string                   `protobuf:"bytes,9,opt,name=groupName,proto3" json:"groupName"`
+	InviterUserList []*common.UserPublicInfo `protobuf:"bytes,10,rep,name=inviterUserList,proto3" json:"inviterUserList"`
+	Duration        int32                    `protobuf:"varint,11,opt,name=duration,proto3" json:"duration"`
+	CreateTime      int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
+	Size            string                   `protobuf:"bytes,13,opt,name=size,proto3" json:"size"`
+	DownloadURL     string                   `protobuf:"bytes,14,opt,name=downloadURL,proto3" json:"downloadURL"`
+}
+
+func (x *SignalRecord) Reset() {
+	*x = SignalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalRecord) ProtoMessage() {}
+
+func (x *SignalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalRecord.ProtoReflect.Descriptor instead.
+func (*SignalRecord) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SignalRecord) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetRoomType() string {
+	if x != nil {
+		return x.RoomType
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetSenderID() string {
+	if x != nil {
+		return x.SenderID
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetSenderNickname() string {
+	if x != nil {
+		return x.SenderNickname
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetRecvNickname() string {
+	if x != nil {
+		return x.RecvNickname
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetInviterUserList() []*common.UserPublicInfo {
+	if x != nil {
+		return x.InviterUserList
+	}
+	return nil
+}
+
+func (x *SignalRecord) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *SignalRecord) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *SignalRecord) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+func (x *SignalRecord) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+type AddSignalRecordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignalRecord *SignalRecord `protobuf:"bytes,1,opt,name=signalRecord,proto3" json:"signalRecord"`
+	OperationID  string        `protobuf:"bytes,2,opt,name=operationID,proto3" json:"operationID"`
+}
+
+func (x *AddSignalRecordReq) Reset() {
+	*x = AddSignalRecordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSignalRecordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSignalRecordReq) ProtoMessage() {}
+
+func (x *AddSignalRecordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSignalRecordReq.ProtoReflect.Descriptor instead.
+func (*AddSignalRecordReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AddSignalRecordReq) GetSignalRecord() *SignalRecord {
+	if x != nil {
+		return x.SignalRecord
+	}
+	return nil
+}
+
+func (x *AddSignalRecordReq) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+type AddSignalRecordResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddSignalRecordResp) Reset() {
+	*x = AddSignalRecordResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddSignalRecordResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddSignalRecordResp) ProtoMessage() {}
+
+func (x *AddSignalRecordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddSignalRecordResp.ProtoReflect.Descriptor instead.
+func (*AddSignalRecordResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{76}
+}
+
+type GetSignalRecordsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination  *sdkws.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+	SenderID    string                   `protobuf:"bytes,2,opt,name=senderID,proto3" json:"senderID"`
+	RecvID      string                   `protobuf:"bytes,3,opt,name=recvID,proto3" json:"recvID"`
+	MediaType   string                   `protobuf:"bytes,4,opt,name=mediaType,proto3" json:"mediaType"`
+	CreateTime  int64                    `protobuf:"varint,5,opt,name=createTime,proto3" json:"createTime"`
+	OperationID string                   `protobuf:"bytes,6,opt,name=operationID,proto3" json:"operationID"`
+}
+
+func (x *GetSignalRecordsReq) Reset() {
+	*x = GetSignalRecordsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSignalRecordsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignalRecordsReq) ProtoMessage() {}
+
+func (x *GetSignalRecordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignalRecordsReq.ProtoReflect.Descriptor instead.
+func (*GetSignalRecordsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetSignalRecordsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetSignalRecordsReq) GetSenderID() string {
+	if x != nil {
+		return x.SenderID
+	}
+	return ""
+}
+
+func (x *GetSignalRecordsReq) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *GetSignalRecordsReq) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *GetSignalRecordsReq) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *GetSignalRecordsReq) GetOperationID() string {
+	if x != nil {
+		return x.OperationID
+	}
+	return ""
+}
+
+type GetSignalRecordsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalNumber   uint32          `protobuf:"varint,1,opt,name=totalNumber,proto3" json:"totalNumber"`
+	SignalRecords []*SignalRecord `protobuf:"bytes,2,rep,name=signalRecords,proto3" json:"signalRecords"`
+}
+
+func (x *GetSignalRecordsResp) Reset() {
+	*x = GetSignalRecordsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSignalRecordsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignalRecordsResp) ProtoMessage() {}
+
+func (x *GetSignalRecordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignalRecordsResp.ProtoReflect.Descriptor instead.
+func (*GetSignalRecordsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetSignalRecordsResp) GetTotalNumber() uint32 {
+	if x != nil {
+		return x.TotalNumber
+	}
+	return 0
+}
+
+func (x *GetSignalRecordsResp) GetSignalRecords() []*SignalRecord {
+	if x != nil {
+		return x.SignalRecords
+	}
+	return nil
+}
+
+type OpenIMCallbackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command"`
+	Body    string `protobuf:"bytes,2,opt,name=body,proto3" json:"body"`
+}
+
+func (x *OpenIMCallbackReq) Reset() {
+	*x = OpenIMCallbackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenIMCallbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenIMCallbackReq) ProtoMessage() {}
+
+func (x *OpenIMCallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenIMCallbackReq.ProtoReflect.Descriptor instead.
+func (*OpenIMCallbackReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *OpenIMCallbackReq) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *OpenIMCallbackReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type OpenIMCallbackResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OpenIMCallbackResp) Reset() {
+	*x = OpenIMCallbackResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenIMCallbackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenIMCallbackResp) ProtoMessage() {}
+
+func (x *OpenIMCallbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenIMCallbackResp.ProtoReflect.Descriptor instead.
+func (*OpenIMCallbackResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{80}
+}
+
+type VerifyCodeReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider"`
+	MessageID string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID"`
+	Status    int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status"` // 2已送达 3发送失败
+	Desc      string `protobuf:"bytes,4,opt,name=desc,proto3" json:"desc"`
+}
+
+func (x *VerifyCodeReceipt) Reset() {
+	*x = VerifyCodeReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCodeReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCodeReceipt) ProtoMessage() {}
+
+func (x *VerifyCodeReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCodeReceipt.ProtoReflect.Descriptor instead.
+func (*VerifyCodeReceipt) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *VerifyCodeReceipt) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *VerifyCodeReceipt) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *VerifyCodeReceipt) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VerifyCodeReceipt) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type UpdateVerifyCodeStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipts []*VerifyCodeReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+}
+
+func (x *UpdateVerifyCodeStatusReq) Reset() {
+	*x = UpdateVerifyCodeStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVerifyCodeStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVerifyCodeStatusReq) ProtoMessage() {}
+
+func (x *UpdateVerifyCodeStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVerifyCodeStatusReq.ProtoReflect.Descriptor instead.
+func (*UpdateVerifyCodeStatusReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateVerifyCodeStatusReq) GetReceipts() []*VerifyCodeReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type UpdateVerifyCodeStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateVerifyCodeStatusResp) Reset() {
+	*x = UpdateVerifyCodeStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVerifyCodeStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVerifyCodeStatusResp) ProtoMessage() {}
+
+func (x *UpdateVerifyCodeStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVerifyCodeStatusResp.ProtoReflect.Descriptor instead.
+func (*UpdateVerifyCodeStatusResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{83}
+}
+
+type VerifyCodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	Account    string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	UsedFor    int32  `protobuf:"varint,3,opt,name=usedFor,proto3" json:"usedFor"`
+	Provider   string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider"`
+	MessageID  string `protobuf:"bytes,5,opt,name=messageID,proto3" json:"messageID"`
+	Status     int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status"` // 0未知 1已提交服务商 2已送达 3发送失败
+	StatusDesc string `protobuf:"bytes,7,opt,name=statusDesc,proto3" json:"statusDesc"`
+	Used       bool   `protobuf:"varint,8,opt,name=used,proto3" json:"used"`
+	Count      int32  `protobuf:"varint,9,opt,name=count,proto3" json:"count"` // 校验失败次数
+	CreateTime int64  `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
+	StatusTime int64  `protobuf:"varint,11,opt,name=statusTime,proto3" json:"statusTime"`
+}
+
+func (x *VerifyCodeInfo) Reset() {
+	*x = VerifyCodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCodeInfo) ProtoMessage() {}
+
+func (x *VerifyCodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCodeInfo.ProtoReflect.Descriptor instead.
+func (*VerifyCodeInfo) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *VerifyCodeInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VerifyCodeInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *VerifyCodeInfo) GetUsedFor() int32 {
+	if x != nil {
+		return x.UsedFor
+	}
+	return 0
+}
+
+func (x *VerifyCodeInfo) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *VerifyCodeInfo) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *VerifyCodeInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *VerifyCodeInfo) GetStatusDesc() string {
+	if x != nil {
+		return x.StatusDesc
+	}
+	return ""
+}
+
+func (x *VerifyCodeInfo) GetUsed() bool {
+	if x != nil {
+		return x.Used
+	}
+	return false
+}
+
+func (x *VerifyCodeInfo) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VerifyCodeInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *VerifyCodeInfo) GetStatusTime() int64 {
+	if x != nil {
+		return x.StatusTime
+	}
+	return 0
+}
+
+type SearchVerifyCodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"` // 手机号或邮箱
+	Status     []int32                  `protobuf:"varint,2,rep,packed,name=status,proto3" json:"status"`
+	StartTime  int64                    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime"`
+	EndTime    int64                    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchVerifyCodeReq) Reset() {
+	*x = SearchVerifyCodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchVerifyCodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVerifyCodeReq) ProtoMessage() {}
+
+func (x *SearchVerifyCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVerifyCodeReq.ProtoReflect.Descriptor instead.
+func (*SearchVerifyCodeReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SearchVerifyCodeReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchVerifyCodeReq) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SearchVerifyCodeReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchVerifyCodeReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchVerifyCodeReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchVerifyCodeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint32            `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	VerifyCodes []*VerifyCodeInfo `protobuf:"bytes,2,rep,name=verifyCodes,proto3" json:"verifyCodes"`
+}
+
+func (x *SearchVerifyCodeResp) Reset() {
+	*x = SearchVerifyCodeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchVerifyCodeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVerifyCodeResp) ProtoMessage() {}
+
+func (x *SearchVerifyCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVerifyCodeResp.ProtoReflect.Descriptor instead.
+func (*SearchVerifyCodeResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SearchVerifyCodeResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchVerifyCodeResp) GetVerifyCodes() []*VerifyCodeInfo {
+	if x != nil {
+		return x.VerifyCodes
+	}
+	return nil
+}
+
+type SearchUserFullInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Genders    int32                    `protobuf:"varint,3,opt,name=genders,proto3" json:"genders"`
+	Normal     int32                    `protobuf:"varint,4,opt,name=normal,proto3" json:"normal"`
+}
+
+func (x *SearchUserFullInfoReq) Reset() {
+	*x = SearchUserFullInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUserFullInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserFullInfoReq) ProtoMessage() {}
+
+func (x *SearchUserFullInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserFullInfoReq.ProtoReflect.Descriptor instead.
+func (*SearchUserFullInfoReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SearchUserFullInfoReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUserFullInfoReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchUserFullInfoReq) GetGenders() int32 {
+	if x != nil {
+		return x.Genders
+	}
+	return 0
+}
+
+func (x *SearchUserFullInfoReq) GetNormal() int32 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+type SearchUserFullInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *SearchUserFullInfoResp) Reset() {
+	*x = SearchUserFullInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUserFullInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserFullInfoResp) ProtoMessage() {}
+
+func (x *SearchUserFullInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserFullInfoResp.ProtoReflect.Descriptor instead.
+func (*SearchUserFullInfoResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SearchUserFullInfoResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUserFullInfoResp) GetUsers() []*common.UserFullInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UserLoginCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start"`
+	End   int64 `protobuf:"varint,2,opt,name=end,proto3" json:"end"`
+}
+
+func (x *UserLoginCountReq) Reset() {
+	*x = UserLoginCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserLoginCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLoginCountReq) ProtoMessage() {}
+
+func (x *UserLoginCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLoginCountReq.ProtoReflect.Descriptor instead.
+func (*UserLoginCountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *UserLoginCountReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *UserLoginCountReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type UserLoginCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoginCount   int64            `protobuf:"varint,1,opt,name=loginCount,proto3" json:"loginCount"`
+	UnloginCount int64            `protobuf:"varint,2,opt,name=unloginCount,proto3" json:"unloginCount"`
+	Count        map[string]int64 `protobuf:"bytes,3,rep,name=Count,proto3" json:"Count" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *UserLoginCountResp) Reset() {
+	*x = UserLoginCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserLoginCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLoginCountResp) ProtoMessage() {}
+
+func (x *UserLoginCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLoginCountResp.ProtoReflect.Descriptor instead.
+func (*UserLoginCountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *UserLoginCountResp) GetLoginCount() int64 {
+	if x != nil {
+		return x.LoginCount
+	}
+	return 0
+}
+
+func (x *UserLoginCountResp) GetUnloginCount() int64 {
+	if x != nil {
+		return x.UnloginCount
+	}
+	return 0
+}
+
+func (x *UserLoginCountResp) GetCount() map[string]int64 {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+type FileURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filename string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename"`
+	URL      string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL"`
+}
+
+func (x *FileURL) Reset() {
+	*x = FileURL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileURL) ProtoMessage() {}
+
+func (x *FileURL) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileURL.ProtoReflect.Descriptor instead.
+func (*FileURL) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *FileURL) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *FileURL) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+type UploadLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Platform   int32      `protobuf:"varint,1,opt,name=platform,proto3" json:"platform"`
+	FileURLs   []*FileURL `protobuf:"bytes,2,rep,name=fileURLs,proto3" json:"fileURLs"`
+	SystemType string     `protobuf:"bytes,3,opt,name=systemType,proto3" json:"systemType"`
+	Version    string     `protobuf:"bytes,5,opt,name=version,proto3" json:"version"`
+	Ex         string     `protobuf:"bytes,4,opt,name=ex,proto3" json:"ex"`
+}
+
+func (x *UploadLogsReq) Reset() {
+	*x = UploadLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadLogsReq) ProtoMessage() {}
+
+func (x *UploadLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadLogsReq.ProtoReflect.Descriptor instead.
+func (*UploadLogsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *UploadLogsReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *UploadLogsReq) GetFileURLs() []*FileURL {
+	if x != nil {
+		return x.FileURLs
+	}
+	return nil
+}
+
+func (x *UploadLogsReq) GetSystemType() string {
+	if x != nil {
+		return x.SystemType
+	}
+	return ""
+}
+
+func (x *UploadLogsReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UploadLogsReq) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+type UploadLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UploadLogsResp) Reset() {
+	*x = UploadLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadLogsResp) ProtoMessage() {}
+
+func (x *UploadLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadLogsResp.ProtoReflect.Descriptor instead.
+func (*UploadLogsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{93}
+}
+
+type DeleteLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogIDs []string `protobuf:"bytes,1,rep,name=logIDs,proto3" json:"logIDs"`
+}
+
+func (x *DeleteLogsReq) Reset() {
+	*x = DeleteLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogsReq) ProtoMessage() {}
+
+func (x *DeleteLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogsReq.ProtoReflect.Descriptor instead.
+func (*DeleteLogsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteLogsReq) GetLogIDs() []string {
+	if x != nil {
+		return x.LogIDs
+	}
+	return nil
+}
+
+type DeleteLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteLogsResp) Reset() {
+	*x = DeleteLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLogsResp) ProtoMessage() {}
+
+func (x *DeleteLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLogsResp.ProtoReflect.Descriptor instead.
+func (*DeleteLogsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{95}
+}
+
+type SearchLogsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	StartTime  int64                    `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime"`
+	EndTime    int64                    `protobuf:"varint,3,opt,name=endTime,proto3" json:"endTime"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchLogsReq) Reset() {
+	*x = SearchLogsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLogsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsReq) ProtoMessage() {}
+
+func (x *SearchLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsReq.ProtoReflect.Descriptor instead.
+func (*SearchLogsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *SearchLogsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchLogsReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *SearchLogsReq) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *SearchLogsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchLogsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogsInfos []*common.LogInfo `protobuf:"bytes,1,rep,name=LogsInfos,proto3" json:"LogsInfos"`
+	Total     uint32            `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+}
+
+func (x *SearchLogsResp) Reset() {
+	*x = SearchLogsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLogsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLogsResp) ProtoMessage() {}
+
+func (x *SearchLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLogsResp.ProtoReflect.Descriptor instead.
+func (*SearchLogsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SearchLogsResp) GetLogsInfos() []*common.LogInfo {
+	if x != nil {
+		return x.LogsInfos
+	}
+	return nil
+}
+
+func (x *SearchLogsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchUserInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Genders    []int32                  `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
+	UserIDs    []string                 `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *SearchUserInfoReq) Reset() {
+	*x = SearchUserInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUserInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserInfoReq) ProtoMessage() {}
+
+func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *SearchUserInfoReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUserInfoReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchUserInfoReq) GetGenders() []int32 {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *SearchUserInfoReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SearchUserInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *SearchUserInfoResp) Reset() {
+	*x = SearchUserInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUserInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUserInfoResp) ProtoMessage() {}
+
+func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SearchUserInfoResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_chat_chat_proto protoreflect.FileDescriptor
+
+var file_chat_chat_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x1a, 0x14, 0x70, 0x75, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x70, 0x75, 0x62, 0x2f, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4,
+	0x01, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xba, 0x07, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x3c, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x44, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x3e, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x39, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x37, 0x0a, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64,
+	0x64, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x3f, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x42, 0x65, 0x65, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65, 0x70, 0x12, 0x49, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63,
+	0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f,
+	0x70, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61,
+	0x67, 0x65, 0x22, 0x4a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x31,
+	0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x51, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x69, 0x0a, 0x18, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x37, 0x0a,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x4d, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x35, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61,
+	0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65,
+	0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x0f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65,
+	0x71, 0x22, 0x64, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x22, 0x57, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x98, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61,
+	0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x69, 0x72, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xf6, 0x01,
+	0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x35, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6c, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xde, 0x02, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x41,
+	0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74,
+	0x68, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f,
+	0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x22, 0x3a, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x22, 0x24, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52,
+	0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0x3c, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x11, 0x0a,
+	0x0f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x98, 0x01, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x6f, 0x0a, 0x13, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xc9, 0x01, 0x0a,
+	0x1b, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x1c, 0x42, 0x65, 0x67,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x70, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75, 0x73, 0x65, 0x72, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x61,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x1c, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x4a, 0x53,
+	0x4f, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x74, 0x74, 0x65,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0x0a, 0x14, 0x42, 0x65, 0x67, 0x69, 0x6e,
+	0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x22,
+	0x63, 0x0a, 0x15, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6c,
+	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x70, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x70, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x22, 0x10, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x22, 0x4b, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x73, 0x22,
+	0x33, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x49, 0x44, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x50, 0x61, 0x73, 0x73, 0x6b,
+	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5a, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x70, 0x22, 0x63, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x52, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x52,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xa4,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x51, 0x52, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x59, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6e, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3c, 0x0a,
+	0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x55,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0xbe, 0x01, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43,
+	0x6f, 0x64, 0x65, 0x22, 0x35, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x22, 0x5e, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x12,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x77, 0x0a, 0x11, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xac, 0x01, 0x0a, 0x10, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x6c, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xd1,
+	0x01, 0x0a, 0x11, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x62,
+	0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12,
+	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xba, 0x01, 0x0a,
+	0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x69, 0x6e,
+	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x13,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdb, 0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x22, 0x79, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x41, 0x0a, 0x0c,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x0c, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0xf0, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x7d, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x0d, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x14, 0x0a,
+	0x12, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x79, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x65,
+	0x73, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x65, 0x73, 0x63, 0x22, 0x5b,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x3e, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb0, 0x02, 0x0a, 0x0e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65, 0x73, 0x63,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x44, 0x65,
+	0x73, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xc6, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6f, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x22, 0x65, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x44, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x37, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x22, 0xab, 0x01, 0x0a, 0x0d,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x34, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x66, 0x69,
+	0x6c, 0x65, 0x55, 0x52, 0x4c, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x52, 0x4c, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x10, 0x0a, 0x0e, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x27, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x67, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x67, 0x49, 0x44, 0x73, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x60, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x09, 0x4c, 0x6f, 0x67, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x61,
+	0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x35, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x32, 0x99, 0x21, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x28, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x12, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5f, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x59, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x21, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x53, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x19, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x59, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x42, 0x69, 0x6e, 0x64, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a,
+	0x10, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x6e, 0x62, 0x69, 0x6e, 0x64,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
+	0x0a, 0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1e, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45,
+	0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a,
+	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1f, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x50, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1f,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x4f, 0x54,
+	0x50, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x54, 0x4f, 0x54, 0x50, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x77, 0x0a, 0x18, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x42, 0x65,
+	0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x7a, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x50, 0x61, 0x73,
+	0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x11, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73,
+	0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x42, 0x65, 0x67, 0x69,
+	0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x44, 0x65,
+	0x6c, 0x50, 0x61, 0x73, 0x73, 0x6b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x50, 0x61,
+	0x73, 0x73, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x50, 0x0a, 0x0b, 0x53, 0x63, 0x61, 0x6e, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x51,
+	0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x51, 0x52, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5c, 0x0a, 0x0f, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x71, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68,
+	0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5c, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c,
+	0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43,
+	0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x24,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x71, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x10, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f,
+	0x67, 0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67,
+	0x73, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x59, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x68, 0x61,
+	0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x43, 0x68, 0x61, 0x74, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2a, 0x5a,
+	0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_chat_chat_proto_rawDescOnce sync.Once
+	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
+)
+
+func file_chat_chat_proto_rawDescGZIP() []byte {
+	file_chat_chat_proto_rawDescOnce.Do(func() {
+		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
+	})
+	return file_chat_chat_proto_rawDescData
+}
+
+var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
+var file_chat_chat_proto_goTypes = []interface{}{
+	(*UserIdentity)(nil),                  // 0: OpenIMChat.chat.UserIdentity
+	(*UpdateUserInfoReq)(nil),             // 1: OpenIMChat.chat.UpdateUserInfoReq
+	(*UpdateUserInfoResp)(nil),            // 2: OpenIMChat.chat.UpdateUserInfoResp
+	(*FindUserPublicInfoReq)(nil),         // 3: OpenIMChat.chat.FindUserPublicInfoReq
+	(*FindUserPublicInfoResp)(nil),        // 4: OpenIMChat.chat.FindUserPublicInfoResp
+	(*SearchUserPublicInfoReq)(nil),       // 5: OpenIMChat.chat.SearchUserPublicInfoReq
+	(*SearchUserPublicInfoResp)(nil),      // 6: OpenIMChat.chat.SearchUserPublicInfoResp
+	(*FindUserFullInfoReq)(nil),           // 7: OpenIMChat.chat.FindUserFullInfoReq
+	(*FindUserFullInfoResp)(nil),          // 8: OpenIMChat.chat.FindUserFullInfoResp
+	(*SendVerifyCodeReq)(nil),             // 9: OpenIMChat.chat.SendVerifyCodeReq
+	(*SendVerifyCodeResp)(nil),            // 10: OpenIMChat.chat.SendVerifyCodeResp
+	(*GetCaptchaReq)(nil),                 // 11: OpenIMChat.chat.GetCaptchaReq
+	(*GetCaptchaResp)(nil),                // 12: OpenIMChat.chat.GetCaptchaResp
+	(*VerifyCaptchaReq)(nil),              // 13: OpenIMChat.chat.VerifyCaptchaReq
+	(*VerifyCaptchaResp)(nil),             // 14: OpenIMChat.chat.VerifyCaptchaResp
+	(*VerifyCodeReq)(nil),                 // 15: OpenIMChat.chat.VerifyCodeReq
+	(*VerifyCodeResp)(nil),                // 16: OpenIMChat.chat.VerifyCodeResp
+	(*RegisterUserInfo)(nil),              // 17: OpenIMChat.chat.RegisterUserInfo
+	(*RegisterUserReq)(nil),               // 18: OpenIMChat.chat.RegisterUserReq
+	(*RegisterUserResp)(nil),              // 19: OpenIMChat.chat.RegisterUserResp
+	(*LoginReq)(nil),                      // 20: OpenIMChat.chat.LoginReq
+	(*WebAuthnAssertion)(nil),             // 21: OpenIMChat.chat.WebAuthnAssertion
+	(*LoginResp)(nil),                     // 22: OpenIMChat.chat.LoginResp
+	(*EnrollTOTPReq)(nil),                 // 23: OpenIMChat.chat.EnrollTOTPReq
+	(*EnrollTOTPResp)(nil),                // 24: OpenIMChat.chat.EnrollTOTPResp
+	(*ConfirmTOTPReq)(nil),                // 25: OpenIMChat.chat.ConfirmTOTPReq
+	(*ConfirmTOTPResp)(nil),               // 26: OpenIMChat.chat.ConfirmTOTPResp
+	(*DisableTOTPReq)(nil),                // 27: OpenIMChat.chat.DisableTOTPReq
+	(*DisableTOTPResp)(nil),               // 28: OpenIMChat.chat.DisableTOTPResp
+	(*VerifyTOTPLoginReq)(nil),            // 29: OpenIMChat.chat.VerifyTOTPLoginReq
+	(*VerifyTOTPLoginResp)(nil),           // 30: OpenIMChat.chat.VerifyTOTPLoginResp
+	(*BeginPasskeyRegistrationReq)(nil),   // 31: OpenIMChat.chat.BeginPasskeyRegistrationReq
+	(*BeginPasskeyRegistrationResp)(nil),  // 32: OpenIMChat.chat.BeginPasskeyRegistrationResp
+	(*FinishPasskeyRegistrationReq)(nil),  // 33: OpenIMChat.chat.FinishPasskeyRegistrationReq
+	(*FinishPasskeyRegistrationResp)(nil), // 34: OpenIMChat.chat.FinishPasskeyRegistrationResp
+	(*BeginPasskeyLoginReq)(nil),          // 35: OpenIMChat.chat.BeginPasskeyLoginReq
+	(*BeginPasskeyLoginResp)(nil),         // 36: OpenIMChat.chat.BeginPasskeyLoginResp
+	(*PasskeyInfo)(nil),                   // 37: OpenIMChat.chat.PasskeyInfo
+	(*FindPasskeyReq)(nil),                // 38: OpenIMChat.chat.FindPasskeyReq
+	(*FindPasskeyResp)(nil),               // 39: OpenIMChat.chat.FindPasskeyResp
+	(*DelPasskeyReq)(nil),                 // 40: OpenIMChat.chat.DelPasskeyReq
+	(*DelPasskeyResp)(nil),                // 41: OpenIMChat.chat.DelPasskeyResp
+	(*CreateQRLoginReq)(nil),              // 42: OpenIMChat.chat.CreateQRLoginReq
+	(*CreateQRLoginResp)(nil),             // 43: OpenIMChat.chat.CreateQRLoginResp
+	(*GetQRLoginStatusReq)(nil),           // 44: OpenIMChat.chat.GetQRLoginStatusReq
+	(*GetQRLoginStatusResp)(nil),          // 45: OpenIMChat.chat.GetQRLoginStatusResp
+	(*ScanQRLoginReq)(nil),                // 46: OpenIMChat.chat.ScanQRLoginReq
+	(*ScanQRLoginResp)(nil),               // 47: OpenIMChat.chat.ScanQRLoginResp
+	(*ConfirmQRLoginReq)(nil),             // 48: OpenIMChat.chat.ConfirmQRLoginReq
+	(*ConfirmQRLoginResp)(nil),            // 49: OpenIMChat.chat.ConfirmQRLoginResp
+	(*UnlockUserLoginReq)(nil),            // 50: OpenIMChat.chat.UnlockUserLoginReq
+	(*UnlockUserLoginResp)(nil),           // 51: OpenIMChat.chat.UnlockUserLoginResp
+	(*DeleteAccountReq)(nil),              // 52: OpenIMChat.chat.DeleteAccountReq
+	(*DeleteAccountResp)(nil),             // 53: OpenIMChat.chat.DeleteAccountResp
+	(*CancelDeleteAccountReq)(nil),        // 54: OpenIMChat.chat.CancelDeleteAccountReq
+	(*CancelDeleteAccountResp)(nil),       // 55: OpenIMChat.chat.CancelDeleteAccountResp
+	(*GetDeleteAccountStatusReq)(nil),     // 56: OpenIMChat.chat.GetDeleteAccountStatusReq
+	(*GetDeleteAccountStatusResp)(nil),    // 57: OpenIMChat.chat.GetDeleteAccountStatusResp
+	(*CheckUserActiveReq)(nil),            // 58: OpenIMChat.chat.CheckUserActiveReq
+	(*CheckUserActiveResp)(nil),           // 59: OpenIMChat.chat.CheckUserActiveResp
+	(*ResetPasswordReq)(nil),              // 60: OpenIMChat.chat.ResetPasswordReq
+	(*ResetPasswordResp)(nil),             // 61: OpenIMChat.chat.ResetPasswordResp
+	(*ChangePasswordReq)(nil),             // 62: OpenIMChat.chat.ChangePasswordReq
+	(*ChangePasswordResp)(nil),            // 63: OpenIMChat.chat.ChangePasswordResp
+	(*ChangeContactReq)(nil),              // 64: OpenIMChat.chat.ChangeContactReq
+	(*ChangeContactResp)(nil),             // 65: OpenIMChat.chat.ChangeContactResp
+	(*BindIdentifierReq)(nil),             // 66: OpenIMChat.chat.BindIdentifierReq
+	(*BindIdentifierResp)(nil),            // 67: OpenIMChat.chat.BindIdentifierResp
+	(*UnbindIdentifierReq)(nil),           // 68: OpenIMChat.chat.UnbindIdentifierReq
+	(*UnbindIdentifierResp)(nil),          // 69: OpenIMChat.chat.UnbindIdentifierResp
+	(*FindUserAccountReq)(nil),            // 70: OpenIMChat.chat.FindUserAccountReq
+	(*FindUserAccountResp)(nil),           // 71: OpenIMChat.chat.FindUserAccountResp
+	(*FindAccountUserReq)(nil),            // 72: OpenIMChat.chat.FindAccountUserReq
+	(*FindAccountUserResp)(nil),           // 73: OpenIMChat.chat.FindAccountUserResp
+	(*SignalRecord)(nil),                  // 74: OpenIMChat.chat.SignalRecord
+	(*AddSignalRecordReq)(nil),            // 75: OpenIMChat.chat.AddSignalRecordReq
+	(*AddSignalRecordResp)(nil),           // 76: OpenIMChat.chat.AddSignalRecordResp
+	(*GetSignalRecordsReq)(nil),           // 77: OpenIMChat.chat.GetSignalRecordsReq
+	(*GetSignalRecordsResp)(nil),          // 78: OpenIMChat.chat.GetSignalRecordsResp
+	(*OpenIMCallbackReq)(nil),             // 79: OpenIMChat.chat.OpenIMCallbackReq
+	(*OpenIMCallbackResp)(nil),            // 80: OpenIMChat.chat.OpenIMCallbackResp
+	(*VerifyCodeReceipt)(nil),             // 81: OpenIMChat.chat.VerifyCodeReceipt
+	(*UpdateVerifyCodeStatusReq)(nil),     // 82: OpenIMChat.chat.UpdateVerifyCodeStatusReq
+	(*UpdateVerifyCodeStatusResp)(nil),    // 83: OpenIMChat.chat.UpdateVerifyCodeStatusResp
+	(*VerifyCodeInfo)(nil),                // 84: OpenIMChat.chat.VerifyCodeInfo
+	(*SearchVerifyCodeReq)(nil),           // 85: OpenIMChat.chat.SearchVerifyCodeReq
+	(*SearchVerifyCodeResp)(nil),          // 86: OpenIMChat.chat.SearchVerifyCodeResp
+	(*SearchUserFullInfoReq)(nil),         // 87: OpenIMChat.chat.SearchUserFullInfoReq
+	(*SearchUserFullInfoResp)(nil),        // 88: OpenIMChat.chat.SearchUserFullInfoResp
+	(*UserLoginCountReq)(nil),             // 89: OpenIMChat.chat.UserLoginCountReq
+	(*UserLoginCountResp)(nil),            // 90: OpenIMChat.chat.UserLoginCountResp
+	(*FileURL)(nil),                       // 91: OpenIMChat.chat.fileURL
+	(*UploadLogsReq)(nil),                 // 92: OpenIMChat.chat.UploadLogsReq
+	(*UploadLogsResp)(nil),                // 93: OpenIMChat.chat.UploadLogsResp
+	(*DeleteLogsReq)(nil),                 // 94: OpenIMChat.chat.DeleteLogsReq
+	(*DeleteLogsResp)(nil),                // 95: OpenIMChat.chat.DeleteLogsResp
+	(*SearchLogsReq)(nil),                 // 96: OpenIMChat.chat.SearchLogsReq
+	(*SearchLogsResp)(nil),                // 97: OpenIMChat.chat.SearchLogsResp
+	(*SearchUserInfoReq)(nil),             // 98: OpenIMChat.chat.SearchUserInfoReq
+	(*SearchUserInfoResp)(nil),            // 99: OpenIMChat.chat.SearchUserInfoResp
+	nil,                                   // 100: OpenIMChat.chat.FindUserAccountResp.UserAccountMapEntry
+	nil,                                   // 101: OpenIMChat.chat.FindAccountUserResp.AccountUserMapEntry
+	nil,                                   // 102: OpenIMChat.chat.UserLoginCountResp.CountEntry
+	(*wrapperspb.StringValue)(nil),        // 103: OpenIMServer.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),         // 104: OpenIMServer.protobuf.Int32Value
+	(*wrapperspb.Int64Value)(nil),         // 105: OpenIMServer.protobuf.Int64Value
+	(*common.UserPublicInfo)(nil),         // 106: OpenIMChat.common.UserPublicInfo
+	(*sdkws.RequestPagination)(nil),       // 107: OpenIMServer.sdkws.RequestPagination
+	(*common.UserFullInfo)(nil),           // 108: OpenIMChat.common.UserFullInfo
+	(*common.LogInfo)(nil),                // 109: OpenIMChat.common.LogInfo
+}
+var file_chat_chat_proto_depIdxs = []int32{
+	103, // 0: OpenIMChat.chat.UpdateUserInfoReq.account:type_name -> OpenIMServer.protobuf.StringValue
+	103, // 1: OpenIMChat.chat.UpdateUserInfoReq.phoneNumber:type_name -> OpenIMServer.protobuf.StringValue
+	103, // 2: OpenIMChat.chat.UpdateUserInfoReq.areaCode:type_name -> OpenIMServer.protobuf.StringValue
+	103, // 3: OpenIMChat.chat.UpdateUserInfoReq.email:type_name -> OpenIMServer.protobuf.StringValue
+	103, // 4: OpenIMChat.chat.UpdateUserInfoReq.nickname:type_name -> OpenIMServer.protobuf.StringValue
+	103, // 5: OpenIMChat.chat.UpdateUserInfoReq.faceURL:type_name -> OpenIMServer.protobuf.StringValue
+	104, // 6: OpenIMChat.chat.UpdateUserInfoReq.gender:type_name -> OpenIMServer.protobuf.Int32Value
+	104, // 7: OpenIMChat.chat.UpdateUserInfoReq.level:type_name -> OpenIMServer.protobuf.Int32Value
+	105, // 8: OpenIMChat.chat.UpdateUserInfoReq.birth:type_name -> OpenIMServer.protobuf.Int64Value
+	104, // 9: OpenIMChat.chat.UpdateUserInfoReq.allowAddFriend:type_name -> OpenIMServer.protobuf.Int32Value
+	104, // 10: OpenIMChat.chat.UpdateUserInfoReq.allowBeep:type_name -> OpenIMServer.protobuf.Int32Value
+	104, // 11: OpenIMChat.chat.UpdateUserInfoReq.allowVibration:type_name -> OpenIMServer.protobuf.Int32Value
+	104, // 12: OpenIMChat.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> OpenIMServer.protobuf.Int32Value
+	103, // 13: OpenIMChat.chat.UpdateUserInfoReq.language:type_name -> OpenIMServer.protobuf.StringValue
+	106, // 14: OpenIMChat.chat.FindUserPublicInfoResp.users:type_name -> OpenIMChat.common.UserPublicInfo
+	107, // 15: OpenIMChat.chat.SearchUserPublicInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	106, // 16: OpenIMChat.chat.SearchUserPublicInfoResp.users:type_name -> OpenIMChat.common.UserPublicInfo
+	108, // 17: OpenIMChat.chat.FindUserFullInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
+	17,  // 18: OpenIMChat.chat.RegisterUserReq.user:type_name -> OpenIMChat.chat.RegisterUserInfo
+	21,  // 19: OpenIMChat.chat.LoginReq.passkey:type_name -> OpenIMChat.chat.WebAuthnAssertion
+	37,  // 20: OpenIMChat.chat.FindPasskeyResp.passkeys:type_name -> OpenIMChat.chat.PasskeyInfo
+	100, // 21: OpenIMChat.chat.FindUserAccountResp.userAccountMap:type_name -> OpenIMChat.chat.FindUserAccountResp.UserAccountMapEntry
+	101, // 22: OpenIMChat.chat.FindAccountUserResp.accountUserMap:type_name -> OpenIMChat.chat.FindAccountUserResp.AccountUserMapEntry
+	106, // 23: OpenIMChat.chat.SignalRecord.inviterUserList:type_name -> OpenIMChat.common.UserPublicInfo
+	74,  // 24: OpenIMChat.chat.AddSignalRecordReq.signalRecord:type_name -> OpenIMChat.chat.SignalRecord
+	107, // 25: OpenIMChat.chat.GetSignalRecordsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	74,  // 26: OpenIMChat.chat.GetSignalRecordsResp.signalRecords:type_name -> OpenIMChat.chat.SignalRecord
+	81,  // 27: OpenIMChat.chat.UpdateVerifyCodeStatusReq.receipts:type_name -> OpenIMChat.chat.VerifyCodeReceipt
+	107, // 28: OpenIMChat.chat.SearchVerifyCodeReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	84,  // 29: OpenIMChat.chat.SearchVerifyCodeResp.verifyCodes:type_name -> OpenIMChat.chat.VerifyCodeInfo
+	107, // 30: OpenIMChat.chat.SearchUserFullInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	108, // 31: OpenIMChat.chat.SearchUserFullInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
+	102, // 32: OpenIMChat.chat.UserLoginCountResp.Count:type_name -> OpenIMChat.chat.UserLoginCountResp.CountEntry
+	91,  // 33: OpenIMChat.chat.UploadLogsReq.fileURLs:type_name -> OpenIMChat.chat.fileURL
+	107, // 34: OpenIMChat.chat.SearchLogsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	109, // 35: OpenIMChat.chat.SearchLogsResp.LogsInfos:type_name -> OpenIMChat.common.LogInfo
+	107, // 36: OpenIMChat.chat.SearchUserInfoReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	108, // 37: OpenIMChat.chat.SearchUserInfoResp.users:type_name -> OpenIMChat.common.UserFullInfo
+	1,   // 38: OpenIMChat.chat.chat.UpdateUserInfo:input_type -> OpenIMChat.chat.UpdateUserInfoReq
+	5,   // 39: OpenIMChat.chat.chat.SearchUserPublicInfo:input_type -> OpenIMChat.chat.SearchUserPublicInfoReq
+	3,   // 40: OpenIMChat.chat.chat.FindUserPublicInfo:input_type -> OpenIMChat.chat.FindUserPublicInfoReq
+	87,  // 41: OpenIMChat.chat.chat.SearchUserFullInfo:input_type -> OpenIMChat.chat.SearchUserFullInfoReq
+	7,   // 42: OpenIMChat.chat.chat.FindUserFullInfo:input_type -> OpenIMChat.chat.FindUserFullInfoReq
+	9,   // 43: OpenIMChat.chat.chat.SendVerifyCode:input_type -> OpenIMChat.chat.SendVerifyCodeReq
+	11,  // 44: OpenIMChat.chat.chat.GetCaptcha:input_type -> OpenIMChat.chat.GetCaptchaReq
+	13,  // 45: OpenIMChat.chat.chat.VerifyCaptcha:input_type -> OpenIMChat.chat.VerifyCaptchaReq
+	15,  // 46: OpenIMChat.chat.chat.VerifyCode:input_type -> OpenIMChat.chat.VerifyCodeReq
+	18,  // 47: OpenIMChat.chat.chat.RegisterUser:input_type -> OpenIMChat.chat.RegisterUserReq
+	20,  // 48: OpenIMChat.chat.chat.Login:input_type -> OpenIMChat.chat.LoginReq
+	60,  // 49: OpenIMChat.chat.chat.ResetPassword:input_type -> OpenIMChat.chat.ResetPasswordReq
+	62,  // 50: OpenIMChat.chat.chat.ChangePassword:input_type -> OpenIMChat.chat.ChangePasswordReq
+	64,  // 51: OpenIMChat.chat.chat.ChangeContact:input_type -> OpenIMChat.chat.ChangeContactReq
+	66,  // 52: OpenIMChat.chat.chat.BindIdentifier:input_type -> OpenIMChat.chat.BindIdentifierReq
+	68,  // 53: OpenIMChat.chat.chat.UnbindIdentifier:input_type -> OpenIMChat.chat.UnbindIdentifierReq
+	23,  // 54: OpenIMChat.chat.chat.EnrollTOTP:input_type -> OpenIMChat.chat.EnrollTOTPReq
+	25,  // 55: OpenIMChat.chat.chat.ConfirmTOTP:input_type -> OpenIMChat.chat.ConfirmTOTPReq
+	27,  // 56: OpenIMChat.chat.chat.DisableTOTP:input_type -> OpenIMChat.chat.DisableTOTPReq
+	29,  // 57: OpenIMChat.chat.chat.VerifyTOTPLogin:input_type -> OpenIMChat.chat.VerifyTOTPLoginReq
+	31,  // 58: OpenIMChat.chat.chat.BeginPasskeyRegistration:input_type -> OpenIMChat.chat.BeginPasskeyRegistrationReq
+	33,  // 59: OpenIMChat.chat.chat.FinishPasskeyRegistration:input_type -> OpenIMChat.chat.FinishPasskeyRegistrationReq
+	35,  // 60: OpenIMChat.chat.chat.BeginPasskeyLogin:input_type -> OpenIMChat.chat.BeginPasskeyLoginReq
+	38,  // 61: OpenIMChat.chat.chat.FindPasskey:input_type -> OpenIMChat.chat.FindPasskeyReq
+	40,  // 62: OpenIMChat.chat.chat.DelPasskey:input_type -> OpenIMChat.chat.DelPasskeyReq
+	42,  // 63: OpenIMChat.chat.chat.CreateQRLogin:input_type -> OpenIMChat.chat.CreateQRLoginReq
+	44,  // 64: OpenIMChat.chat.chat.GetQRLoginStatus:input_type -> OpenIMChat.chat.GetQRLoginStatusReq
+	46,  // 65: OpenIMChat.chat.chat.ScanQRLogin:input_type -> OpenIMChat.chat.ScanQRLoginReq
+	48,  // 66: OpenIMChat.chat.chat.ConfirmQRLogin:input_type -> OpenIMChat.chat.ConfirmQRLoginReq
+	50,  // 67: OpenIMChat.chat.chat.UnlockUserLogin:input_type -> OpenIMChat.chat.UnlockUserLoginReq
+	52,  // 68: OpenIMChat.chat.chat.DeleteAccount:input_type -> OpenIMChat.chat.DeleteAccountReq
+	54,  // 69: OpenIMChat.chat.chat.CancelDeleteAccount:input_type -> OpenIMChat.chat.CancelDeleteAccountReq
+	56,  // 70: OpenIMChat.chat.chat.GetDeleteAccountStatus:input_type -> OpenIMChat.chat.GetDeleteAccountStatusReq
+	58,  // 71: OpenIMChat.chat.chat.CheckUserActive:input_type -> OpenIMChat.chat.CheckUserActiveReq
+	70,  // 72: OpenIMChat.chat.chat.FindUserAccount:input_type -> OpenIMChat.chat.FindUserAccountReq
+	72,  // 73: OpenIMChat.chat.chat.FindAccountUser:input_type -> OpenIMChat.chat.FindAccountUserReq
+	75,  // 74: OpenIMChat.chat.chat.AddSignalRecord:input_type -> OpenIMChat.chat.AddSignalRecordReq
+	77,  // 75: OpenIMChat.chat.chat.GetSignalRecords:input_type -> OpenIMChat.chat.GetSignalRecordsReq
+	79,  // 76: OpenIMChat.chat.chat.OpenIMCallback:input_type -> OpenIMChat.chat.OpenIMCallbackReq
+	82,  // 77: OpenIMChat.chat.chat.UpdateVerifyCodeStatus:input_type -> OpenIMChat.chat.UpdateVerifyCodeStatusReq
+	85,  // 78: OpenIMChat.chat.chat.SearchVerifyCode:input_type -> OpenIMChat.chat.SearchVerifyCodeReq
+	89,  // 79: OpenIMChat.chat.chat.UserLoginCount:input_type -> OpenIMChat.chat.UserLoginCountReq
+	92,  // 80: OpenIMChat.chat.chat.UploadLogs:input_type -> OpenIMChat.chat.UploadLogsReq
+	94,  // 81: OpenIMChat.chat.chat.DeleteLogs:input_type -> OpenIMChat.chat.DeleteLogsReq
+	96,  // 82: OpenIMChat.chat.chat.SearchLogs:input_type -> OpenIMChat.chat.SearchLogsReq
+	98,  // 83: OpenIMChat.chat.chat.SearchUserInfo:input_type -> OpenIMChat.chat.SearchUserInfoReq
+	2,   // 84: OpenIMChat.chat.chat.UpdateUserInfo:output_type -> OpenIMChat.chat.UpdateUserInfoResp
+	6,   // 85: OpenIMChat.chat.chat.SearchUserPublicInfo:output_type -> OpenIMChat.chat.SearchUserPublicInfoResp
+	4,   // 86: OpenIMChat.chat.chat.FindUserPublicInfo:output_type -> OpenIMChat.chat.FindUserPublicInfoResp
+	88,  // 87: OpenIMChat.chat.chat.SearchUserFullInfo:output_type -> OpenIMChat.chat.SearchUserFullInfoResp
+	8,   // 88: OpenIMChat.chat.chat.FindUserFullInfo:output_type -> OpenIMChat.chat.FindUserFullInfoResp
+	10,  // 89: OpenIMChat.chat.chat.SendVerifyCode:output_type -> OpenIMChat.chat.SendVerifyCodeResp
+	12,  // 90: OpenIMChat.chat.chat.GetCaptcha:output_type -> OpenIMChat.chat.GetCaptchaResp
+	14,  // 91: OpenIMChat.chat.chat.VerifyCaptcha:output_type -> OpenIMChat.chat.VerifyCaptchaResp
+	16,  // 92: OpenIMChat.chat.chat.VerifyCode:output_type -> OpenIMChat.chat.VerifyCodeResp
+	19,  // 93: OpenIMChat.chat.chat.RegisterUser:output_type -> OpenIMChat.chat.RegisterUserResp
+	22,  // 94: OpenIMChat.chat.chat.Login:output_type -> OpenIMChat.chat.LoginResp
+	61,  // 95: OpenIMChat.chat.chat.ResetPassword:output_type -> OpenIMChat.chat.ResetPasswordResp
+	63,  // 96: OpenIMChat.chat.chat.ChangePassword:output_type -> OpenIMChat.chat.ChangePasswordResp
+	65,  // 97: OpenIMChat.chat.chat.ChangeContact:output_type -> OpenIMChat.chat.ChangeContactResp
+	67,  // 98: OpenIMChat.chat.chat.BindIdentifier:output_type -> OpenIMChat.chat.BindIdentifierResp
+	69,  // 99: OpenIMChat.chat.chat.UnbindIdentifier:output_type -> OpenIMChat.chat.UnbindIdentifierResp
+	24,  // 100: OpenIMChat.chat.chat.EnrollTOTP:output_type -> OpenIMChat.chat.EnrollTOTPResp
+	26,  // 101: OpenIMChat.chat.chat.ConfirmTOTP:output_type -> OpenIMChat.chat.ConfirmTOTPResp
+	28,  // 102: OpenIMChat.chat.chat.DisableTOTP:output_type -> OpenIMChat.chat.DisableTOTPResp
+	30,  // 103: OpenIMChat.chat.chat.VerifyTOTPLogin:output_type -> OpenIMChat.chat.VerifyTOTPLoginResp
+	32,  // 104: OpenIMChat.chat.chat.BeginPasskeyRegistration:output_type -> OpenIMChat.chat.BeginPasskeyRegistrationResp
+	34,  // 105: OpenIMChat.chat.chat.FinishPasskeyRegistration:output_type -> OpenIMChat.chat.FinishPasskeyRegistrationResp
+	36,  // 106: OpenIMChat.chat.chat.BeginPasskeyLogin:output_type -> OpenIMChat.chat.BeginPasskeyLoginResp
+	39,  // 107: OpenIMChat.chat.chat.FindPasskey:output_type -> OpenIMChat.chat.FindPasskeyResp
+	41,  // 108: OpenIMChat.chat.chat.DelPasskey:output_type -> OpenIMChat.chat.DelPasskeyResp
+	43,  // 109: OpenIMChat.chat.chat.CreateQRLogin:output_type -> OpenIMChat.chat.CreateQRLoginResp
+	45,  // 110: OpenIMChat.chat.chat.GetQRLoginStatus:output_type -> OpenIMChat.chat.GetQRLoginStatusResp
+	47,  // 111: OpenIMChat.chat.chat.ScanQRLogin:output_type -> OpenIMChat.chat.ScanQRLoginResp
+	49,  // 112: OpenIMChat.chat.chat.ConfirmQRLogin:output_type -> OpenIMChat.chat.ConfirmQRLoginResp
+	51,  // 113: OpenIMChat.chat.chat.UnlockUserLogin:output_type -> OpenIMChat.chat.UnlockUserLoginResp
+	53,  // 114: OpenIMChat.chat.chat.DeleteAccount:output_type -> OpenIMChat.chat.DeleteAccountResp
+	55,  // 115: OpenIMChat.chat.chat.CancelDeleteAccount:output_type -> OpenIMChat.chat.CancelDeleteAccountResp
+	57,  // 116: OpenIMChat.chat.chat.GetDeleteAccountStatus:output_type -> OpenIMChat.chat.GetDeleteAccountStatusResp
+	59,  // 117: OpenIMChat.chat.chat.CheckUserActive:output_type -> OpenIMChat.chat.CheckUserActiveResp
+	71,  // 118: OpenIMChat.chat.chat.FindUserAccount:output_type -> OpenIMChat.chat.FindUserAccountResp
+	73,  // 119: OpenIMChat.chat.chat.FindAccountUser:output_type -> OpenIMChat.chat.FindAccountUserResp
+	76,  // 120: OpenIMChat.chat.chat.AddSignalRecord:output_type -> OpenIMChat.chat.AddSignalRecordResp
+	78,  // 121: OpenIMChat.chat.chat.GetSignalRecords:output_type -> OpenIMChat.chat.GetSignalRecordsResp
+	80,  // 122: OpenIMChat.chat.chat.OpenIMCallback:output_type -> OpenIMChat.chat.OpenIMCallbackResp
+	83,  // 123: OpenIMChat.chat.chat.UpdateVerifyCodeStatus:output_type -> OpenIMChat.chat.UpdateVerifyCodeStatusResp
+	86,  // 124: OpenIMChat.chat.chat.SearchVerifyCode:output_type -> OpenIMChat.chat.SearchVerifyCodeResp
+	90,  // 125: OpenIMChat.chat.chat.UserLoginCount:output_type -> OpenIMChat.chat.UserLoginCountResp
+	93,  // 126: OpenIMChat.chat.chat.UploadLogs:output_type -> OpenIMChat.chat.UploadLogsResp
+	95,  // 127: OpenIMChat.chat.chat.DeleteLogs:output_type -> OpenIMChat.chat.DeleteLogsResp
+	97,  // 128: OpenIMChat.chat.chat.SearchLogs:output_type -> OpenIMChat.chat.SearchLogsResp
+	99,  // 129: OpenIMChat.chat.chat.SearchUserInfo:output_type -> OpenIMChat.chat.SearchUserInfoResp
+	84,  // [84:130] is the sub-list for method output_type
+	38,  // [38:84] is the sub-list for method input_type
+	38,  // [38:38] is the sub-list for extension type_name
+	38,  // [38:38] is the sub-list for extension extendee
+	0,   // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_chat_chat_proto_init() }
+func file_chat_chat_proto_init() {
+	if File_chat_chat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chat_chat_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserFullInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindUserFullInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendVerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCaptchaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCaptchaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCaptchaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCaptchaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterUserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebAuthnAssertion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnrollTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTOTPLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyTOTPLoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginPasskeyRegistrationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginPasskeyRegistrationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishPasskeyRegistrationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FinishPasskeyRegistrationResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginPasskeyLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3624,8 +7819,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserInfoReq); i {
+		file_chat_chat_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeginPasskeyLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3636,8 +7831,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateUserInfoResp); i {
+		file_chat_chat_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PasskeyInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3648,8 +7843,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPasskeyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3660,8 +7855,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindPasskeyResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3672,8 +7867,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelPasskeyReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3684,8 +7879,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelPasskeyResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3696,8 +7891,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserFullInfoReq); i {
+		file_chat_chat_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQRLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3708,8 +7903,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FindUserFullInfoResp); i {
+		file_chat_chat_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateQRLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3720,8 +7915,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendVerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQRLoginStatusReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3732,8 +7927,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendVerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQRLoginStatusResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3744,8 +7939,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanQRLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3756,8 +7951,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanQRLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3768,8 +7963,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterUserInfo); i {
+		file_chat_chat_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmQRLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3780,8 +7975,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterUserReq); i {
+		file_chat_chat_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfirmQRLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3792,8 +7987,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegisterUserResp); i {
+		file_chat_chat_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUserLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3804,8 +7999,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginReq); i {
+		file_chat_chat_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockUserLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3816,8 +8011,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoginResp); i {
+		file_chat_chat_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3828,7 +8023,91 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeleteAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelDeleteAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeleteAccountStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeleteAccountStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserActiveReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckUserActiveResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetPasswordReq); i {
 			case 0:
 				return &v.state
@@ -3840,7 +8119,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ResetPasswordResp); i {
 			case 0:
 				return &v.state
@@ -3852,7 +8131,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangePasswordReq); i {
 			case 0:
 				return &v.state
@@ -3864,7 +8143,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangePasswordResp); i {
 			case 0:
 				return &v.state
@@ -3876,7 +8155,79 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeContactReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeContactResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindIdentifierReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BindIdentifierResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbindIdentifierReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbindIdentifierResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindUserAccountReq); i {
 			case 0:
 				return &v.state
@@ -3888,7 +8239,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindUserAccountResp); i {
 			case 0:
 				return &v.state
@@ -3900,7 +8251,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAccountUserReq); i {
 			case 0:
 				return &v.state
@@ -3912,7 +8263,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FindAccountUserResp); i {
 			case 0:
 				return &v.state
@@ -3924,7 +8275,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SignalRecord); i {
 			case 0:
 				return &v.state
@@ -3936,7 +8287,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSignalRecordReq); i {
 			case 0:
 				return &v.state
@@ -3948,7 +8299,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddSignalRecordResp); i {
 			case 0:
 				return &v.state
@@ -3960,7 +8311,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSignalRecordsReq); i {
 			case 0:
 				return &v.state
@@ -3972,7 +8323,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSignalRecordsResp); i {
 			case 0:
 				return &v.state
@@ -3984,7 +8335,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OpenIMCallbackReq); i {
 			case 0:
 				return &v.state
@@ -3996,7 +8347,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OpenIMCallbackResp); i {
 			case 0:
 				return &v.state
@@ -4008,7 +8359,79 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCodeReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVerifyCodeStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVerifyCodeStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchVerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserFullInfoReq); i {
 			case 0:
 				return &v.state
@@ -4020,7 +8443,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserFullInfoResp); i {
 			case 0:
 				return &v.state
@@ -4032,7 +8455,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserLoginCountReq); i {
 			case 0:
 				return &v.state
@@ -4044,7 +8467,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserLoginCountResp); i {
 			case 0:
 				return &v.state
@@ -4056,7 +8479,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FileURL); i {
 			case 0:
 				return &v.state
@@ -4068,7 +8491,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadLogsReq); i {
 			case 0:
 				return &v.state
@@ -4080,7 +8503,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadLogsResp); i {
 			case 0:
 				return &v.state
@@ -4092,7 +8515,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteLogsReq); i {
 			case 0:
 				return &v.state
@@ -4104,7 +8527,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteLogsResp); i {
 			case 0:
 				return &v.state
@@ -4116,7 +8539,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchLogsReq); i {
 			case 0:
 				return &v.state
@@ -4128,7 +8551,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchLogsResp); i {
 			case 0:
 				return &v.state
@@ -4140,7 +8563,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserInfoReq); i {
 			case 0:
 				return &v.state
@@ -4152,7 +8575,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_chat_chat_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchUserInfoResp); i {
 			case 0:
 				return &v.state
@@ -4171,7 +8594,7 @@ func file_chat_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chat_chat_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4206,16 +8629,49 @@ type ChatClient interface {
 	SearchUserFullInfo(ctx context.Context, in *SearchUserFullInfoReq, opts ...grpc.CallOption) (*SearchUserFullInfoResp, error)
 	FindUserFullInfo(ctx context.Context, in *FindUserFullInfoReq, opts ...grpc.CallOption) (*FindUserFullInfoResp, error)
 	SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq, opts ...grpc.CallOption) (*SendVerifyCodeResp, error)
+	// 人机验证
+	GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error)
+	VerifyCaptcha(ctx context.Context, in *VerifyCaptchaReq, opts ...grpc.CallOption) (*VerifyCaptchaResp, error)
 	VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error)
 	RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
+	ChangeContact(ctx context.Context, in *ChangeContactReq, opts ...grpc.CallOption) (*ChangeContactResp, error)
+	BindIdentifier(ctx context.Context, in *BindIdentifierReq, opts ...grpc.CallOption) (*BindIdentifierResp, error)
+	UnbindIdentifier(ctx context.Context, in *UnbindIdentifierReq, opts ...grpc.CallOption) (*UnbindIdentifierResp, error)
+	// 二次验证
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error)
+	VerifyTOTPLogin(ctx context.Context, in *VerifyTOTPLoginReq, opts ...grpc.CallOption) (*VerifyTOTPLoginResp, error)
+	// 通行密钥
+	BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationReq, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResp, error)
+	FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationReq, opts ...grpc.CallOption) (*FinishPasskeyRegistrationResp, error)
+	BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginReq, opts ...grpc.CallOption) (*BeginPasskeyLoginResp, error)
+	FindPasskey(ctx context.Context, in *FindPasskeyReq, opts ...grpc.CallOption) (*FindPasskeyResp, error)
+	DelPasskey(ctx context.Context, in *DelPasskeyReq, opts ...grpc.CallOption) (*DelPasskeyResp, error)
+	// 扫码登录
+	CreateQRLogin(ctx context.Context, in *CreateQRLoginReq, opts ...grpc.CallOption) (*CreateQRLoginResp, error)
+	GetQRLoginStatus(ctx context.Context, in *GetQRLoginStatusReq, opts ...grpc.CallOption) (*GetQRLoginStatusResp, error)
+	ScanQRLogin(ctx context.Context, in *ScanQRLoginReq, opts ...grpc.CallOption) (*ScanQRLoginResp, error)
+	ConfirmQRLogin(ctx context.Context, in *ConfirmQRLoginReq, opts ...grpc.CallOption) (*ConfirmQRLoginResp, error)
+	// 解除登录锁定
+	UnlockUserLogin(ctx context.Context, in *UnlockUserLoginReq, opts ...grpc.CallOption) (*UnlockUserLoginResp, error)
+	// 注销账号
+	DeleteAccount(ctx context.Context, in *DeleteAccountReq, opts ...grpc.CallOption) (*DeleteAccountResp, error)
+	CancelDeleteAccount(ctx context.Context, in *CancelDeleteAccountReq, opts ...grpc.CallOption) (*CancelDeleteAccountResp, error)
+	GetDeleteAccountStatus(ctx context.Context, in *GetDeleteAccountStatusReq, opts ...grpc.CallOption) (*GetDeleteAccountStatusResp, error)
+	// 账号已注销或注销申请已到期时返回错误, 刷新token前校验
+	CheckUserActive(ctx context.Context, in *CheckUserActiveReq, opts ...grpc.CallOption) (*CheckUserActiveResp, error)
 	FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error)
 	FindAccountUser(ctx context.Context, in *FindAccountUserReq, opts ...grpc.CallOption) (*FindAccountUserResp, error)
 	AddSignalRecord(ctx context.Context, in *AddSignalRecordReq, opts ...grpc.CallOption) (*AddSignalRecordResp, error)
 	GetSignalRecords(ctx context.Context, in *GetSignalRecordsReq, opts ...grpc.CallOption) (*GetSignalRecordsResp, error)
 	OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq, opts ...grpc.CallOption) (*OpenIMCallbackResp, error)
+	// 验证码发送回执
+	UpdateVerifyCodeStatus(ctx context.Context, in *UpdateVerifyCodeStatusReq, opts ...grpc.CallOption) (*UpdateVerifyCodeStatusResp, error)
+	SearchVerifyCode(ctx context.Context, in *SearchVerifyCodeReq, opts ...grpc.CallOption) (*SearchVerifyCodeResp, error)
 	// 统计
 	UserLoginCount(ctx context.Context, in *UserLoginCountReq, opts ...grpc.CallOption) (*UserLoginCountResp, error)
 	// 日志
@@ -4229,495 +8685,1242 @@ type chatClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewChatClient(cc grpc.ClientConnInterface) ChatClient {
-	return &chatClient{cc}
+func NewChatClient(cc grpc.ClientConnInterface) ChatClient {
+	return &chatClient{cc}
+}
+
+func (c *chatClient) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error) {
+	out := new(UpdateUserInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UpdateUserInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchUserPublicInfo(ctx context.Context, in *SearchUserPublicInfoReq, opts ...grpc.CallOption) (*SearchUserPublicInfoResp, error) {
+	out := new(SearchUserPublicInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserPublicInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindUserPublicInfo(ctx context.Context, in *FindUserPublicInfoReq, opts ...grpc.CallOption) (*FindUserPublicInfoResp, error) {
+	out := new(FindUserPublicInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserPublicInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SearchUserFullInfo(ctx context.Context, in *SearchUserFullInfoReq, opts ...grpc.CallOption) (*SearchUserFullInfoResp, error) {
+	out := new(SearchUserFullInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserFullInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindUserFullInfo(ctx context.Context, in *FindUserFullInfoReq, opts ...grpc.CallOption) (*FindUserFullInfoResp, error) {
+	out := new(FindUserFullInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserFullInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq, opts ...grpc.CallOption) (*SendVerifyCodeResp, error) {
+	out := new(SendVerifyCodeResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SendVerifyCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetCaptcha(ctx context.Context, in *GetCaptchaReq, opts ...grpc.CallOption) (*GetCaptchaResp, error) {
+	out := new(GetCaptchaResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/GetCaptcha", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) VerifyCaptcha(ctx context.Context, in *VerifyCaptchaReq, opts ...grpc.CallOption) (*VerifyCaptchaResp, error) {
+	out := new(VerifyCaptchaResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/VerifyCaptcha", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error) {
+	out := new(VerifyCodeResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/VerifyCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error) {
+	out := new(RegisterUserResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/RegisterUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
+	out := new(ResetPasswordResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ResetPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
+	out := new(ChangePasswordResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeContact(ctx context.Context, in *ChangeContactReq, opts ...grpc.CallOption) (*ChangeContactResp, error) {
+	out := new(ChangeContactResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ChangeContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) BindIdentifier(ctx context.Context, in *BindIdentifierReq, opts ...grpc.CallOption) (*BindIdentifierResp, error) {
+	out := new(BindIdentifierResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/BindIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) UnbindIdentifier(ctx context.Context, in *UnbindIdentifierReq, opts ...grpc.CallOption) (*UnbindIdentifierResp, error) {
+	out := new(UnbindIdentifierResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UnbindIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error) {
+	out := new(ConfirmTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error) {
+	out := new(DisableTOTPResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) VerifyTOTPLogin(ctx context.Context, in *VerifyTOTPLoginReq, opts ...grpc.CallOption) (*VerifyTOTPLoginResp, error) {
+	out := new(VerifyTOTPLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/VerifyTOTPLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationReq, opts ...grpc.CallOption) (*BeginPasskeyRegistrationResp, error) {
+	out := new(BeginPasskeyRegistrationResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/BeginPasskeyRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationReq, opts ...grpc.CallOption) (*FinishPasskeyRegistrationResp, error) {
+	out := new(FinishPasskeyRegistrationResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FinishPasskeyRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginReq, opts ...grpc.CallOption) (*BeginPasskeyLoginResp, error) {
+	out := new(BeginPasskeyLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/BeginPasskeyLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindPasskey(ctx context.Context, in *FindPasskeyReq, opts ...grpc.CallOption) (*FindPasskeyResp, error) {
+	out := new(FindPasskeyResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindPasskey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DelPasskey(ctx context.Context, in *DelPasskeyReq, opts ...grpc.CallOption) (*DelPasskeyResp, error) {
+	out := new(DelPasskeyResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/DelPasskey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CreateQRLogin(ctx context.Context, in *CreateQRLoginReq, opts ...grpc.CallOption) (*CreateQRLoginResp, error) {
+	out := new(CreateQRLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/CreateQRLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetQRLoginStatus(ctx context.Context, in *GetQRLoginStatusReq, opts ...grpc.CallOption) (*GetQRLoginStatusResp, error) {
+	out := new(GetQRLoginStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/GetQRLoginStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ScanQRLogin(ctx context.Context, in *ScanQRLoginReq, opts ...grpc.CallOption) (*ScanQRLoginResp, error) {
+	out := new(ScanQRLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ScanQRLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConfirmQRLogin(ctx context.Context, in *ConfirmQRLoginReq, opts ...grpc.CallOption) (*ConfirmQRLoginResp, error) {
+	out := new(ConfirmQRLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ConfirmQRLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) UnlockUserLogin(ctx context.Context, in *UnlockUserLoginReq, opts ...grpc.CallOption) (*UnlockUserLoginResp, error) {
+	out := new(UnlockUserLoginResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UnlockUserLogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DeleteAccount(ctx context.Context, in *DeleteAccountReq, opts ...grpc.CallOption) (*DeleteAccountResp, error) {
+	out := new(DeleteAccountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CancelDeleteAccount(ctx context.Context, in *CancelDeleteAccountReq, opts ...grpc.CallOption) (*CancelDeleteAccountResp, error) {
+	out := new(CancelDeleteAccountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/CancelDeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetDeleteAccountStatus(ctx context.Context, in *GetDeleteAccountStatusReq, opts ...grpc.CallOption) (*GetDeleteAccountStatusResp, error) {
+	out := new(GetDeleteAccountStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/GetDeleteAccountStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CheckUserActive(ctx context.Context, in *CheckUserActiveReq, opts ...grpc.CallOption) (*CheckUserActiveResp, error) {
+	out := new(CheckUserActiveResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/CheckUserActive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error) {
+	out := new(FindUserAccountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) FindAccountUser(ctx context.Context, in *FindAccountUserReq, opts ...grpc.CallOption) (*FindAccountUserResp, error) {
+	out := new(FindAccountUserResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindAccountUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *chatClient) UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error) {
-	out := new(UpdateUserInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UpdateUserInfo", in, out, opts...)
+func (c *chatClient) AddSignalRecord(ctx context.Context, in *AddSignalRecordReq, opts ...grpc.CallOption) (*AddSignalRecordResp, error) {
+	out := new(AddSignalRecordResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/AddSignalRecord", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) SearchUserPublicInfo(ctx context.Context, in *SearchUserPublicInfoReq, opts ...grpc.CallOption) (*SearchUserPublicInfoResp, error) {
-	out := new(SearchUserPublicInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserPublicInfo", in, out, opts...)
+func (c *chatClient) GetSignalRecords(ctx context.Context, in *GetSignalRecordsReq, opts ...grpc.CallOption) (*GetSignalRecordsResp, error) {
+	out := new(GetSignalRecordsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/GetSignalRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) FindUserPublicInfo(ctx context.Context, in *FindUserPublicInfoReq, opts ...grpc.CallOption) (*FindUserPublicInfoResp, error) {
-	out := new(FindUserPublicInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserPublicInfo", in, out, opts...)
+func (c *chatClient) OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq, opts ...grpc.CallOption) (*OpenIMCallbackResp, error) {
+	out := new(OpenIMCallbackResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/OpenIMCallback", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) SearchUserFullInfo(ctx context.Context, in *SearchUserFullInfoReq, opts ...grpc.CallOption) (*SearchUserFullInfoResp, error) {
-	out := new(SearchUserFullInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserFullInfo", in, out, opts...)
+func (c *chatClient) UpdateVerifyCodeStatus(ctx context.Context, in *UpdateVerifyCodeStatusReq, opts ...grpc.CallOption) (*UpdateVerifyCodeStatusResp, error) {
+	out := new(UpdateVerifyCodeStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UpdateVerifyCodeStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) FindUserFullInfo(ctx context.Context, in *FindUserFullInfoReq, opts ...grpc.CallOption) (*FindUserFullInfoResp, error) {
-	out := new(FindUserFullInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserFullInfo", in, out, opts...)
+func (c *chatClient) SearchVerifyCode(ctx context.Context, in *SearchVerifyCodeReq, opts ...grpc.CallOption) (*SearchVerifyCodeResp, error) {
+	out := new(SearchVerifyCodeResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchVerifyCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) SendVerifyCode(ctx context.Context, in *SendVerifyCodeReq, opts ...grpc.CallOption) (*SendVerifyCodeResp, error) {
-	out := new(SendVerifyCodeResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SendVerifyCode", in, out, opts...)
+func (c *chatClient) UserLoginCount(ctx context.Context, in *UserLoginCountReq, opts ...grpc.CallOption) (*UserLoginCountResp, error) {
+	out := new(UserLoginCountResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UserLoginCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) VerifyCode(ctx context.Context, in *VerifyCodeReq, opts ...grpc.CallOption) (*VerifyCodeResp, error) {
-	out := new(VerifyCodeResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/VerifyCode", in, out, opts...)
+func (c *chatClient) UploadLogs(ctx context.Context, in *UploadLogsReq, opts ...grpc.CallOption) (*UploadLogsResp, error) {
+	out := new(UploadLogsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UploadLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error) {
-	out := new(RegisterUserResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/RegisterUser", in, out, opts...)
+func (c *chatClient) DeleteLogs(ctx context.Context, in *DeleteLogsReq, opts ...grpc.CallOption) (*DeleteLogsResp, error) {
+	out := new(DeleteLogsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/DeleteLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
-	out := new(LoginResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/Login", in, out, opts...)
+func (c *chatClient) SearchLogs(ctx context.Context, in *SearchLogsReq, opts ...grpc.CallOption) (*SearchLogsResp, error) {
+	out := new(SearchLogsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
-	out := new(ResetPasswordResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ResetPassword", in, out, opts...)
+func (c *chatClient) SearchUserInfo(ctx context.Context, in *SearchUserInfoReq, opts ...grpc.CallOption) (*SearchUserInfoResp, error) {
+	out := new(SearchUserInfoResp)
+	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error) {
-	out := new(ChangePasswordResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/ChangePassword", in, out, opts...)
-	if err != nil {
+// ChatServer is the server API for Chat service.
+type ChatServer interface {
+	// 编辑个人资料 自己或者管理员调用
+	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
+	// 获取用户的公开信息   陌生人调用
+	SearchUserPublicInfo(context.Context, *SearchUserPublicInfoReq) (*SearchUserPublicInfoResp, error)
+	FindUserPublicInfo(context.Context, *FindUserPublicInfoReq) (*FindUserPublicInfoResp, error)
+	// 搜索用户信息 管理员调用 其他用户调用时返回public字段
+	SearchUserFullInfo(context.Context, *SearchUserFullInfoReq) (*SearchUserFullInfoResp, error)
+	FindUserFullInfo(context.Context, *FindUserFullInfoReq) (*FindUserFullInfoResp, error)
+	SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error)
+	// 人机验证
+	GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error)
+	VerifyCaptcha(context.Context, *VerifyCaptchaReq) (*VerifyCaptchaResp, error)
+	VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error)
+	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
+	Login(context.Context, *LoginReq) (*LoginResp, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
+	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
+	ChangeContact(context.Context, *ChangeContactReq) (*ChangeContactResp, error)
+	BindIdentifier(context.Context, *BindIdentifierReq) (*BindIdentifierResp, error)
+	UnbindIdentifier(context.Context, *UnbindIdentifierReq) (*UnbindIdentifierResp, error)
+	// 二次验证
+	EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error)
+	DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error)
+	VerifyTOTPLogin(context.Context, *VerifyTOTPLoginReq) (*VerifyTOTPLoginResp, error)
+	// 通行密钥
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationReq) (*BeginPasskeyRegistrationResp, error)
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationReq) (*FinishPasskeyRegistrationResp, error)
+	BeginPasskeyLogin(context.Context, *BeginPasskeyLoginReq) (*BeginPasskeyLoginResp, error)
+	FindPasskey(context.Context, *FindPasskeyReq) (*FindPasskeyResp, error)
+	DelPasskey(context.Context, *DelPasskeyReq) (*DelPasskeyResp, error)
+	// 扫码登录
+	CreateQRLogin(context.Context, *CreateQRLoginReq) (*CreateQRLoginResp, error)
+	GetQRLoginStatus(context.Context, *GetQRLoginStatusReq) (*GetQRLoginStatusResp, error)
+	ScanQRLogin(context.Context, *ScanQRLoginReq) (*ScanQRLoginResp, error)
+	ConfirmQRLogin(context.Context, *ConfirmQRLoginReq) (*ConfirmQRLoginResp, error)
+	// 解除登录锁定
+	UnlockUserLogin(context.Context, *UnlockUserLoginReq) (*UnlockUserLoginResp, error)
+	// 注销账号
+	DeleteAccount(context.Context, *DeleteAccountReq) (*DeleteAccountResp, error)
+	CancelDeleteAccount(context.Context, *CancelDeleteAccountReq) (*CancelDeleteAccountResp, error)
+	GetDeleteAccountStatus(context.Context, *GetDeleteAccountStatusReq) (*GetDeleteAccountStatusResp, error)
+	// 账号已注销或注销申请已到期时返回错误, 刷新token前校验
+	CheckUserActive(context.Context, *CheckUserActiveReq) (*CheckUserActiveResp, error)
+	FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error)
+	FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error)
+	AddSignalRecord(context.Context, *AddSignalRecordReq) (*AddSignalRecordResp, error)
+	GetSignalRecords(context.Context, *GetSignalRecordsReq) (*GetSignalRecordsResp, error)
+	OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error)
+	// 验证码发送回执
+	UpdateVerifyCodeStatus(context.Context, *UpdateVerifyCodeStatusReq) (*UpdateVerifyCodeStatusResp, error)
+	SearchVerifyCode(context.Context, *SearchVerifyCodeReq) (*SearchVerifyCodeResp, error)
+	// 统计
+	UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error)
+	// 日志
+	UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error)
+	DeleteLogs(context.Context, *DeleteLogsReq) (*DeleteLogsResp, error)
+	SearchLogs(context.Context, *SearchLogsReq) (*SearchLogsResp, error)
+	SearchUserInfo(context.Context, *SearchUserInfoReq) (*SearchUserInfoResp, error)
+}
+
+// UnimplementedChatServer can be embedded to have forward compatible implementations.
+type UnimplementedChatServer struct {
+}
+
+func (*UnimplementedChatServer) UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+}
+func (*UnimplementedChatServer) SearchUserPublicInfo(context.Context, *SearchUserPublicInfoReq) (*SearchUserPublicInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserPublicInfo not implemented")
+}
+func (*UnimplementedChatServer) FindUserPublicInfo(context.Context, *FindUserPublicInfoReq) (*FindUserPublicInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserPublicInfo not implemented")
+}
+func (*UnimplementedChatServer) SearchUserFullInfo(context.Context, *SearchUserFullInfoReq) (*SearchUserFullInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserFullInfo not implemented")
+}
+func (*UnimplementedChatServer) FindUserFullInfo(context.Context, *FindUserFullInfoReq) (*FindUserFullInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserFullInfo not implemented")
+}
+func (*UnimplementedChatServer) SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendVerifyCode not implemented")
+}
+func (*UnimplementedChatServer) GetCaptcha(context.Context, *GetCaptchaReq) (*GetCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCaptcha not implemented")
+}
+func (*UnimplementedChatServer) VerifyCaptcha(context.Context, *VerifyCaptchaReq) (*VerifyCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCaptcha not implemented")
+}
+func (*UnimplementedChatServer) VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCode not implemented")
+}
+func (*UnimplementedChatServer) RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
+}
+func (*UnimplementedChatServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (*UnimplementedChatServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (*UnimplementedChatServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (*UnimplementedChatServer) ChangeContact(context.Context, *ChangeContactReq) (*ChangeContactResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeContact not implemented")
+}
+func (*UnimplementedChatServer) BindIdentifier(context.Context, *BindIdentifierReq) (*BindIdentifierResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindIdentifier not implemented")
+}
+func (*UnimplementedChatServer) UnbindIdentifier(context.Context, *UnbindIdentifierReq) (*UnbindIdentifierResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindIdentifier not implemented")
+}
+func (*UnimplementedChatServer) EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedChatServer) ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedChatServer) DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedChatServer) VerifyTOTPLogin(context.Context, *VerifyTOTPLoginReq) (*VerifyTOTPLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTOTPLogin not implemented")
+}
+func (*UnimplementedChatServer) BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationReq) (*BeginPasskeyRegistrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyRegistration not implemented")
+}
+func (*UnimplementedChatServer) FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationReq) (*FinishPasskeyRegistrationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishPasskeyRegistration not implemented")
+}
+func (*UnimplementedChatServer) BeginPasskeyLogin(context.Context, *BeginPasskeyLoginReq) (*BeginPasskeyLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginPasskeyLogin not implemented")
+}
+func (*UnimplementedChatServer) FindPasskey(context.Context, *FindPasskeyReq) (*FindPasskeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindPasskey not implemented")
+}
+func (*UnimplementedChatServer) DelPasskey(context.Context, *DelPasskeyReq) (*DelPasskeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelPasskey not implemented")
+}
+func (*UnimplementedChatServer) CreateQRLogin(context.Context, *CreateQRLoginReq) (*CreateQRLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQRLogin not implemented")
+}
+func (*UnimplementedChatServer) GetQRLoginStatus(context.Context, *GetQRLoginStatusReq) (*GetQRLoginStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQRLoginStatus not implemented")
+}
+func (*UnimplementedChatServer) ScanQRLogin(context.Context, *ScanQRLoginReq) (*ScanQRLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanQRLogin not implemented")
+}
+func (*UnimplementedChatServer) ConfirmQRLogin(context.Context, *ConfirmQRLoginReq) (*ConfirmQRLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmQRLogin not implemented")
+}
+func (*UnimplementedChatServer) UnlockUserLogin(context.Context, *UnlockUserLoginReq) (*UnlockUserLoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUserLogin not implemented")
+}
+func (*UnimplementedChatServer) DeleteAccount(context.Context, *DeleteAccountReq) (*DeleteAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (*UnimplementedChatServer) CancelDeleteAccount(context.Context, *CancelDeleteAccountReq) (*CancelDeleteAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeleteAccount not implemented")
+}
+func (*UnimplementedChatServer) GetDeleteAccountStatus(context.Context, *GetDeleteAccountStatusReq) (*GetDeleteAccountStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeleteAccountStatus not implemented")
+}
+func (*UnimplementedChatServer) CheckUserActive(context.Context, *CheckUserActiveReq) (*CheckUserActiveResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserActive not implemented")
+}
+func (*UnimplementedChatServer) FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUserAccount not implemented")
+}
+func (*UnimplementedChatServer) FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindAccountUser not implemented")
+}
+func (*UnimplementedChatServer) AddSignalRecord(context.Context, *AddSignalRecordReq) (*AddSignalRecordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSignalRecord not implemented")
+}
+func (*UnimplementedChatServer) GetSignalRecords(context.Context, *GetSignalRecordsReq) (*GetSignalRecordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignalRecords not implemented")
+}
+func (*UnimplementedChatServer) OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenIMCallback not implemented")
+}
+func (*UnimplementedChatServer) UpdateVerifyCodeStatus(context.Context, *UpdateVerifyCodeStatusReq) (*UpdateVerifyCodeStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVerifyCodeStatus not implemented")
+}
+func (*UnimplementedChatServer) SearchVerifyCode(context.Context, *SearchVerifyCodeReq) (*SearchVerifyCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchVerifyCode not implemented")
+}
+func (*UnimplementedChatServer) UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserLoginCount not implemented")
+}
+func (*UnimplementedChatServer) UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadLogs not implemented")
+}
+func (*UnimplementedChatServer) DeleteLogs(context.Context, *DeleteLogsReq) (*DeleteLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogs not implemented")
+}
+func (*UnimplementedChatServer) SearchLogs(context.Context, *SearchLogsReq) (*SearchLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+}
+func (*UnimplementedChatServer) SearchUserInfo(context.Context, *SearchUserInfoReq) (*SearchUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUserInfo not implemented")
+}
+
+func RegisterChatServer(s *grpc.Server, srv ChatServer) {
+	s.RegisterService(&_Chat_serviceDesc, srv)
+}
+
+func _Chat_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/UpdateUserInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserPublicInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchUserPublicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/SearchUserPublicInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchUserPublicInfo(ctx, req.(*SearchUserPublicInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserPublicInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/FindUserPublicInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserFullInfoReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/SearchUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error) {
-	out := new(FindUserAccountResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindUserAccount", in, out, opts...)
-	if err != nil {
+func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserFullInfoReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/FindUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) FindAccountUser(ctx context.Context, in *FindAccountUserReq, opts ...grpc.CallOption) (*FindAccountUserResp, error) {
-	out := new(FindAccountUserResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/FindAccountUser", in, out, opts...)
-	if err != nil {
+func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerifyCodeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).SendVerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/SendVerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) AddSignalRecord(ctx context.Context, in *AddSignalRecordReq, opts ...grpc.CallOption) (*AddSignalRecordResp, error) {
-	out := new(AddSignalRecordResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/AddSignalRecord", in, out, opts...)
-	if err != nil {
+func _Chat_GetCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCaptchaReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).GetCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/GetCaptcha",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetCaptcha(ctx, req.(*GetCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) GetSignalRecords(ctx context.Context, in *GetSignalRecordsReq, opts ...grpc.CallOption) (*GetSignalRecordsResp, error) {
-	out := new(GetSignalRecordsResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/GetSignalRecords", in, out, opts...)
-	if err != nil {
+func _Chat_VerifyCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCaptchaReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).VerifyCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/VerifyCaptcha",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).VerifyCaptcha(ctx, req.(*VerifyCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq, opts ...grpc.CallOption) (*OpenIMCallbackResp, error) {
-	out := new(OpenIMCallbackResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/OpenIMCallback", in, out, opts...)
-	if err != nil {
+func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCodeReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).VerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/VerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) UserLoginCount(ctx context.Context, in *UserLoginCountReq, opts ...grpc.CallOption) (*UserLoginCountResp, error) {
-	out := new(UserLoginCountResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UserLoginCount", in, out, opts...)
-	if err != nil {
+func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/RegisterUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) UploadLogs(ctx context.Context, in *UploadLogsReq, opts ...grpc.CallOption) (*UploadLogsResp, error) {
-	out := new(UploadLogsResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/UploadLogs", in, out, opts...)
-	if err != nil {
+func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) DeleteLogs(ctx context.Context, in *DeleteLogsReq, opts ...grpc.CallOption) (*DeleteLogsResp, error) {
-	out := new(DeleteLogsResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/DeleteLogs", in, out, opts...)
-	if err != nil {
+func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/ResetPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) SearchLogs(ctx context.Context, in *SearchLogsReq, opts ...grpc.CallOption) (*SearchLogsResp, error) {
-	out := new(SearchLogsResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchLogs", in, out, opts...)
-	if err != nil {
+func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ChatServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *chatClient) SearchUserInfo(ctx context.Context, in *SearchUserInfoReq, opts ...grpc.CallOption) (*SearchUserInfoResp, error) {
-	out := new(SearchUserInfoResp)
-	err := c.cc.Invoke(ctx, "/OpenIMChat.chat.chat/SearchUserInfo", in, out, opts...)
-	if err != nil {
+func _Chat_ChangeContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeContactReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// ChatServer is the server API for Chat service.
-type ChatServer interface {
-	// 编辑个人资料 自己或者管理员调用
-	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
-	// 获取用户的公开信息   陌生人调用
-	SearchUserPublicInfo(context.Context, *SearchUserPublicInfoReq) (*SearchUserPublicInfoResp, error)
-	FindUserPublicInfo(context.Context, *FindUserPublicInfoReq) (*FindUserPublicInfoResp, error)
-	// 搜索用户信息 管理员调用 其他用户调用时返回public字段
-	SearchUserFullInfo(context.Context, *SearchUserFullInfoReq) (*SearchUserFullInfoResp, error)
-	FindUserFullInfo(context.Context, *FindUserFullInfoReq) (*FindUserFullInfoResp, error)
-	SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error)
-	VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error)
-	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
-	Login(context.Context, *LoginReq) (*LoginResp, error)
-	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
-	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
-	FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error)
-	FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error)
-	AddSignalRecord(context.Context, *AddSignalRecordReq) (*AddSignalRecordResp, error)
-	GetSignalRecords(context.Context, *GetSignalRecordsReq) (*GetSignalRecordsResp, error)
-	OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error)
-	// 统计
-	UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error)
-	// 日志
-	UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error)
-	DeleteLogs(context.Context, *DeleteLogsReq) (*DeleteLogsResp, error)
-	SearchLogs(context.Context, *SearchLogsReq) (*SearchLogsResp, error)
-	SearchUserInfo(context.Context, *SearchUserInfoReq) (*SearchUserInfoResp, error)
-}
-
-// UnimplementedChatServer can be embedded to have forward compatible implementations.
-type UnimplementedChatServer struct {
-}
-
-func (*UnimplementedChatServer) UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
-}
-func (*UnimplementedChatServer) SearchUserPublicInfo(context.Context, *SearchUserPublicInfoReq) (*SearchUserPublicInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUserPublicInfo not implemented")
-}
-func (*UnimplementedChatServer) FindUserPublicInfo(context.Context, *FindUserPublicInfoReq) (*FindUserPublicInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindUserPublicInfo not implemented")
-}
-func (*UnimplementedChatServer) SearchUserFullInfo(context.Context, *SearchUserFullInfoReq) (*SearchUserFullInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUserFullInfo not implemented")
-}
-func (*UnimplementedChatServer) FindUserFullInfo(context.Context, *FindUserFullInfoReq) (*FindUserFullInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindUserFullInfo not implemented")
-}
-func (*UnimplementedChatServer) SendVerifyCode(context.Context, *SendVerifyCodeReq) (*SendVerifyCodeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendVerifyCode not implemented")
-}
-func (*UnimplementedChatServer) VerifyCode(context.Context, *VerifyCodeReq) (*VerifyCodeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyCode not implemented")
-}
-func (*UnimplementedChatServer) RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
-}
-func (*UnimplementedChatServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
-}
-func (*UnimplementedChatServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
-}
-func (*UnimplementedChatServer) ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
-}
-func (*UnimplementedChatServer) FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindUserAccount not implemented")
-}
-func (*UnimplementedChatServer) FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindAccountUser not implemented")
+	if interceptor == nil {
+		return srv.(ChatServer).ChangeContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/ChangeContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChangeContact(ctx, req.(*ChangeContactReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) AddSignalRecord(context.Context, *AddSignalRecordReq) (*AddSignalRecordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddSignalRecord not implemented")
+
+func _Chat_BindIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindIdentifierReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).BindIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/BindIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).BindIdentifier(ctx, req.(*BindIdentifierReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) GetSignalRecords(context.Context, *GetSignalRecordsReq) (*GetSignalRecordsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSignalRecords not implemented")
+
+func _Chat_UnbindIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbindIdentifierReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UnbindIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/UnbindIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UnbindIdentifier(ctx, req.(*UnbindIdentifierReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OpenIMCallback not implemented")
+
+func _Chat_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).EnrollTOTP(ctx, req.(*EnrollTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) UserLoginCount(context.Context, *UserLoginCountReq) (*UserLoginCountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserLoginCount not implemented")
+
+func _Chat_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ConfirmTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/ConfirmTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) UploadLogs(context.Context, *UploadLogsReq) (*UploadLogsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadLogs not implemented")
+
+func _Chat_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DisableTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/DisableTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DisableTOTP(ctx, req.(*DisableTOTPReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) DeleteLogs(context.Context, *DeleteLogsReq) (*DeleteLogsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogs not implemented")
+
+func _Chat_VerifyTOTPLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTOTPLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).VerifyTOTPLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/VerifyTOTPLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).VerifyTOTPLogin(ctx, req.(*VerifyTOTPLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) SearchLogs(context.Context, *SearchLogsReq) (*SearchLogsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+
+func _Chat_BeginPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyRegistrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).BeginPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/BeginPasskeyRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedChatServer) SearchUserInfo(context.Context, *SearchUserInfoReq) (*SearchUserInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SearchUserInfo not implemented")
+
+func _Chat_FinishPasskeyRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishPasskeyRegistrationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FinishPasskeyRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/FinishPasskeyRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterChatServer(s *grpc.Server, srv ChatServer) {
-	s.RegisterService(&_Chat_serviceDesc, srv)
+func _Chat_BeginPasskeyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginPasskeyLoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).BeginPasskeyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/BeginPasskeyLogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).BeginPasskeyLogin(ctx, req.(*BeginPasskeyLoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserInfoReq)
+func _Chat_FindPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindPasskeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).UpdateUserInfo(ctx, in)
+		return srv.(ChatServer).FindPasskey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/UpdateUserInfo",
+		FullMethod: "/OpenIMChat.chat.chat/FindPasskey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+		return srv.(ChatServer).FindPasskey(ctx, req.(*FindPasskeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SearchUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserPublicInfoReq)
+func _Chat_DelPasskey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelPasskeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SearchUserPublicInfo(ctx, in)
+		return srv.(ChatServer).DelPasskey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/SearchUserPublicInfo",
+		FullMethod: "/OpenIMChat.chat.chat/DelPasskey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SearchUserPublicInfo(ctx, req.(*SearchUserPublicInfoReq))
+		return srv.(ChatServer).DelPasskey(ctx, req.(*DelPasskeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserPublicInfoReq)
+func _Chat_CreateQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQRLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+		return srv.(ChatServer).CreateQRLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/FindUserPublicInfo",
+		FullMethod: "/OpenIMChat.chat.chat/CreateQRLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+		return srv.(ChatServer).CreateQRLogin(ctx, req.(*CreateQRLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserFullInfoReq)
+func _Chat_GetQRLoginStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQRLoginStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+		return srv.(ChatServer).GetQRLoginStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/SearchUserFullInfo",
+		FullMethod: "/OpenIMChat.chat.chat/GetQRLoginStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+		return srv.(ChatServer).GetQRLoginStatus(ctx, req.(*GetQRLoginStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserFullInfoReq)
+func _Chat_ScanQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanQRLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+		return srv.(ChatServer).ScanQRLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/FindUserFullInfo",
+		FullMethod: "/OpenIMChat.chat.chat/ScanQRLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+		return srv.(ChatServer).ScanQRLogin(ctx, req.(*ScanQRLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendVerifyCodeReq)
+func _Chat_ConfirmQRLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmQRLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SendVerifyCode(ctx, in)
+		return srv.(ChatServer).ConfirmQRLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/SendVerifyCode",
+		FullMethod: "/OpenIMChat.chat.chat/ConfirmQRLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+		return srv.(ChatServer).ConfirmQRLogin(ctx, req.(*ConfirmQRLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyCodeReq)
+func _Chat_UnlockUserLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).VerifyCode(ctx, in)
+		return srv.(ChatServer).UnlockUserLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/VerifyCode",
+		FullMethod: "/OpenIMChat.chat.chat/UnlockUserLogin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+		return srv.(ChatServer).UnlockUserLogin(ctx, req.(*UnlockUserLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterUserReq)
+func _Chat_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).RegisterUser(ctx, in)
+		return srv.(ChatServer).DeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/RegisterUser",
+		FullMethod: "/OpenIMChat.chat.chat/DeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+		return srv.(ChatServer).DeleteAccount(ctx, req.(*DeleteAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Chat_CancelDeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelDeleteAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).Login(ctx, in)
+		return srv.(ChatServer).CancelDeleteAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/Login",
+		FullMethod: "/OpenIMChat.chat.chat/CancelDeleteAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+		return srv.(ChatServer).CancelDeleteAccount(ctx, req.(*CancelDeleteAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordReq)
+func _Chat_GetDeleteAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeleteAccountStatusReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ResetPassword(ctx, in)
+		return srv.(ChatServer).GetDeleteAccountStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/ResetPassword",
+		FullMethod: "/OpenIMChat.chat.chat/GetDeleteAccountStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+		return srv.(ChatServer).GetDeleteAccountStatus(ctx, req.(*GetDeleteAccountStatusReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _Chat_CheckUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserActiveReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ChangePassword(ctx, in)
+		return srv.(ChatServer).CheckUserActive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/OpenIMChat.chat.chat/ChangePassword",
+		FullMethod: "/OpenIMChat.chat.chat/CheckUserActive",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(ChatServer).CheckUserActive(ctx, req.(*CheckUserActiveReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4812,6 +10015,42 @@ func _Chat_OpenIMCallback_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_UpdateVerifyCodeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVerifyCodeStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).UpdateVerifyCodeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/UpdateVerifyCodeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).UpdateVerifyCodeStatus(ctx, req.(*UpdateVerifyCodeStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchVerifyCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchVerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMChat.chat.chat/SearchVerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchVerifyCode(ctx, req.(*SearchVerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_UserLoginCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserLoginCountReq)
 	if err := dec(in); err != nil {
@@ -4930,6 +10169,14 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendVerifyCode",
 			Handler:    _Chat_SendVerifyCode_Handler,
 		},
+		{
+			MethodName: "GetCaptcha",
+			Handler:    _Chat_GetCaptcha_Handler,
+		},
+		{
+			MethodName: "VerifyCaptcha",
+			Handler:    _Chat_VerifyCaptcha_Handler,
+		},
 		{
 			MethodName: "VerifyCode",
 			Handler:    _Chat_VerifyCode_Handler,
@@ -4950,6 +10197,90 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _Chat_ChangePassword_Handler,
 		},
+		{
+			MethodName: "ChangeContact",
+			Handler:    _Chat_ChangeContact_Handler,
+		},
+		{
+			MethodName: "BindIdentifier",
+			Handler:    _Chat_BindIdentifier_Handler,
+		},
+		{
+			MethodName: "UnbindIdentifier",
+			Handler:    _Chat_UnbindIdentifier_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Chat_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Chat_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Chat_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "VerifyTOTPLogin",
+			Handler:    _Chat_VerifyTOTPLogin_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyRegistration",
+			Handler:    _Chat_BeginPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "FinishPasskeyRegistration",
+			Handler:    _Chat_FinishPasskeyRegistration_Handler,
+		},
+		{
+			MethodName: "BeginPasskeyLogin",
+			Handler:    _Chat_BeginPasskeyLogin_Handler,
+		},
+		{
+			MethodName: "FindPasskey",
+			Handler:    _Chat_FindPasskey_Handler,
+		},
+		{
+			MethodName: "DelPasskey",
+			Handler:    _Chat_DelPasskey_Handler,
+		},
+		{
+			MethodName: "CreateQRLogin",
+			Handler:    _Chat_CreateQRLogin_Handler,
+		},
+		{
+			MethodName: "GetQRLoginStatus",
+			Handler:    _Chat_GetQRLoginStatus_Handler,
+		},
+		{
+			MethodName: "ScanQRLogin",
+			Handler:    _Chat_ScanQRLogin_Handler,
+		},
+		{
+			MethodName: "ConfirmQRLogin",
+			Handler:    _Chat_ConfirmQRLogin_Handler,
+		},
+		{
+			MethodName: "UnlockUserLogin",
+			Handler:    _Chat_UnlockUserLogin_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _Chat_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "CancelDeleteAccount",
+			Handler:    _Chat_CancelDeleteAccount_Handler,
+		},
+		{
+			MethodName: "GetDeleteAccountStatus",
+			Handler:    _Chat_GetDeleteAccountStatus_Handler,
+		},
+		{
+			MethodName: "CheckUserActive",
+			Handler:    _Chat_CheckUserActive_Handler,
+		},
 		{
 			MethodName: "FindUserAccount",
 			Handler:    _Chat_FindUserAccount_Handler,
@@ -4970,6 +10301,14 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenIMCallback",
 			Handler:    _Chat_OpenIMCallback_Handler,
 		},
+		{
+			MethodName: "UpdateVerifyCodeStatus",
+			Handler:    _Chat_UpdateVerifyCodeStatus_Handler,
+		},
+		{
+			MethodName: "SearchVerifyCode",
+			Handler:    _Chat_SearchVerifyCode_Handler,
+		},
 		{
 			MethodName: "UserLoginCount",
 			Handler:    _Chat_UserLoginCount_Handler,
@@ -21,6 +21,8 @@ import (
 
 	"github.com/OpenIMSDK/chat/pkg/common/config"
 	"github.com/OpenIMSDK/chat/pkg/common/mctx"
+	"github.com/OpenIMSDK/chat/pkg/common/mw"
+	"github.com/OpenIMSDK/chat/pkg/common/rbac"
 	"github.com/OpenIMSDK/chat/pkg/eerrs"
 	"github.com/OpenIMSDK/chat/pkg/proto/admin"
 )
@@ -90,8 +92,52 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32, session *admin.TokenSession) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType, Session: session})
+}
+
+// CheckPermission 校验ctx中的管理员是否拥有权限
+func (o *AdminClient) CheckPermission(ctx context.Context, permission rbac.Permission) error {
+	_, err := o.client.CheckPermission(ctx, &admin.CheckPermissionReq{Permission: string(permission)})
+	return err
+}
+
+// ForbidUser 永久封禁用户, 可重复调用, 已有的临时封禁会被替换为永久封禁
+func (o *AdminClient) ForbidUser(ctx context.Context, userID string, category string, reason string) error {
+	resp, err := o.client.FindUserBlockInfo(ctx, &admin.FindUserBlockInfoReq{UserIDs: []string{userID}})
+	if err != nil {
+		return err
+	}
+	if len(resp.Blocks) > 0 {
+		if resp.Blocks[0].ExpireTime == 0 {
+			return nil
+		}
+		if _, err := o.client.UnblockUser(ctx, &admin.UnblockUserReq{UserIDs: []string{userID}}); err != nil {
+			return err
+		}
+	}
+	_, err = o.client.BlockUser(ctx, &admin.BlockUserReq{UserID: userID, Category: category, Reason: reason})
+	return err
+}
+
+// InvalidateToken 使用户所有token失效
+func (o *AdminClient) InvalidateToken(ctx context.Context, userID string) error {
+	_, err := o.client.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: userID})
+	return err
+}
+
+// AddAuditLog 记录管理员操作, 操作者和ip取自ctx
+func (o *AdminClient) AddAuditLog(ctx context.Context, record *mw.AuditRecord) error {
+	_, err := o.client.AddAuditLog(ctx, &admin.AddAuditLogReq{
+		Action:     record.Action,
+		Target:     record.Target,
+		Request:    record.Request,
+		Previous:   record.Previous,
+		ErrCode:    record.ErrCode,
+		ErrMsg:     record.ErrMsg,
+		CreateTime: record.Time.UnixMilli(),
+	})
+	return err
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
@@ -110,3 +110,9 @@ func (o *ChatClient) UpdateUser(ctx context.Context, req *chat.UpdateUserInfoReq
 	_, err := o.client.UpdateUserInfo(ctx, req)
 	return err
 }
+
+// CheckUserActive 账号已注销或注销申请已到期时返回错误
+func (o *ChatClient) CheckUserActive(ctx context.Context, userID string) error {
+	_, err := o.client.CheckUserActive(ctx, &chat.CheckUserActiveReq{UserID: userID})
+	return err
+}
@@ -17,6 +17,7 @@ package sms
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/OpenIMSDK/tools/errs"
 	aliconf "github.com/alibabacloud-go/darabonba-openapi/client"
@@ -44,22 +45,41 @@ type ali struct {
 }
 
 func (a *ali) Name() string {
-	return "ali-sms"
+	return aliName
 }
 
-func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+func (a *ali) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
 	data, err := json.Marshal(&struct {
 		Code string `json:"code"`
 	}{Code: verifyCode})
 	if err != nil {
-		return errs.Wrap(err)
+		return "", errs.Wrap(err)
+	}
+	conf := config.Config.VerifyCode.Ali
+	signName, templateCode := conf.SignName, conf.VerificationCodeTemplateCode
+	if i := conf.Templates.Match(usedFor, language); i >= 0 {
+		if tpl := conf.Templates[i]; tpl.TemplateCode != "" {
+			templateCode = tpl.TemplateCode
+			if tpl.SignName != "" {
+				signName = tpl.SignName
+			}
+		}
 	}
 	req := &dysmsapi.SendSmsRequest{
 		PhoneNumbers:  tea.String(areaCode + phoneNumber),
-		SignName:      tea.String(config.Config.VerifyCode.Ali.SignName),
-		TemplateCode:  tea.String(config.Config.VerifyCode.Ali.VerificationCodeTemplateCode),
+		SignName:      tea.String(signName),
+		TemplateCode:  tea.String(templateCode),
 		TemplateParam: tea.String(string(data)),
 	}
-	_, err = a.client.SendSms(req)
-	return errs.Wrap(err)
+	resp, err := a.client.SendSms(req)
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	if resp.Body == nil {
+		return "", errs.ErrInternalServer.Wrap("ali sms response is empty")
+	}
+	if code := tea.StringValue(resp.Body.Code); code != "OK" {
+		return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("ali sms error %s: %s", code, tea.StringValue(resp.Body.Message)))
+	}
+	return tea.StringValue(resp.Body.BizId), nil
 }
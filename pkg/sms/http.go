@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+const maxResponseBody = 64 * 1024
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// templateData 短信内容及webhook请求体模板可用的变量.
+type templateData struct {
+	AreaCode    string
+	PhoneNumber string
+	Code        string
+	UsedFor     int32
+	Language    string
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	},
+}
+
+func parseTemplate(name string, text string) (*template.Template, error) {
+	t, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, errs.Wrap(err, name+" template is invalid")
+	}
+	return t, nil
+}
+
+func executeTemplate(t *template.Template, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	var sb strings.Builder
+	data := &templateData{AreaCode: areaCode, PhoneNumber: phoneNumber, Code: verifyCode, UsedFor: usedFor, Language: language}
+	if err := t.Execute(&sb, data); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return sb.String(), nil
+}
+
+// parseEndpoint 未配置时使用def, 未指定协议时默认https
+func parseEndpoint(endpoint string, def string) (*url.URL, error) {
+	if endpoint == "" {
+		endpoint = def
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errs.Wrap(err, "endpoint is invalid")
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("endpoint `%s` host is empty", endpoint)
+	}
+	return u, nil
+}
+
+// doRequest 返回响应体, 非2xx状态码视为失败
+func doRequest(req *http.Request) ([]byte, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if resp.StatusCode/100 != 2 {
+		return body, errs.ErrInternalServer.Wrap(fmt.Sprintf("sms http status %d: %s", resp.StatusCode, string(body)))
+	}
+	return body, nil
+}
@@ -0,0 +1,187 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/constant"
+)
+
+// Receipt 服务商推送的发送回执, Provider与SMS.Name一致.
+type Receipt struct {
+	Provider  string
+	MessageID string
+	Status    int32
+	Desc      string
+}
+
+// ParseReceipts 解析服务商推送的回执, use为配置中的服务名, 无法识别的状态及缺少消息ID的回执会被忽略
+func ParseReceipts(use string, body []byte) ([]*Receipt, error) {
+	var (
+		receipts []*Receipt
+		err      error
+	)
+	switch strings.ToLower(use) {
+	case "ali":
+		receipts, err = parseAliReceipts(body)
+	case "twilio":
+		receipts, err = parseTwilioReceipts(body)
+	case "tencent":
+		receipts, err = parseTencentReceipts(body)
+	case "webhook":
+		receipts, err = parseWebhookReceipts(body)
+	default:
+		return nil, errs.ErrArgs.Wrap(fmt.Sprintf("not support sms receipt: `%s`", use))
+	}
+	if err != nil {
+		return nil, err
+	}
+	res := receipts[:0]
+	for _, receipt := range receipts {
+		if receipt.MessageID != "" {
+			res = append(res, receipt)
+		}
+	}
+	return res, nil
+}
+
+// ReceiptAck 回执接收成功时返回给服务商的响应, 返回nil时使用默认响应
+func ReceiptAck(use string) any {
+	switch strings.ToLower(use) {
+	case "ali":
+		return map[string]any{"code": 0, "msg": "成功"}
+	case "tencent":
+		return map[string]any{"result": 0, "errmsg": "OK"}
+	default:
+		return nil
+	}
+}
+
+// parseAliReceipts 短信发送状态报告, 批量推送JSON数组
+func parseAliReceipts(body []byte) ([]*Receipt, error) {
+	var reports []struct {
+		BizID   string `json:"biz_id"`
+		Success bool   `json:"success"`
+		ErrCode string `json:"err_code"`
+		ErrMsg  string `json:"err_msg"`
+	}
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, errs.ErrArgs.Wrap("ali receipt is invalid")
+	}
+	res := make([]*Receipt, 0, len(reports))
+	for _, r := range reports {
+		receipt := &Receipt{Provider: aliName, MessageID: r.BizID, Status: constant.VerifyCodeStatusDelivered, Desc: r.ErrMsg}
+		if !r.Success {
+			receipt.Status = constant.VerifyCodeStatusFailed
+			receipt.Desc = joinDesc(r.ErrCode, r.ErrMsg)
+		}
+		res = append(res, receipt)
+	}
+	return res, nil
+}
+
+// parseTwilioReceipts StatusCallback, 表单格式, 每条消息状态变化推送一次
+func parseTwilioReceipts(body []byte) ([]*Receipt, error) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, errs.ErrArgs.Wrap("twilio receipt is invalid")
+	}
+	receipt := &Receipt{Provider: twilioName, MessageID: form.Get("MessageSid")}
+	switch form.Get("MessageStatus") {
+	case "sent":
+		receipt.Status = constant.VerifyCodeStatusSent
+	case "delivered":
+		receipt.Status = constant.VerifyCodeStatusDelivered
+	case "undelivered", "failed":
+		receipt.Status = constant.VerifyCodeStatusFailed
+		receipt.Desc = joinDesc(form.Get("ErrorCode"), form.Get("ErrorMessage"))
+	default:
+		return nil, nil
+	}
+	return []*Receipt{receipt}, nil
+}
+
+// parseTencentReceipts 短信下发状态回调, 批量推送JSON数组
+func parseTencentReceipts(body []byte) ([]*Receipt, error) {
+	var reports []struct {
+		Sid          string `json:"sid"`
+		ReportStatus string `json:"report_status"`
+		ErrMsg       string `json:"errmsg"`
+		Description  string `json:"description"`
+	}
+	if err := json.Unmarshal(body, &reports); err != nil {
+		return nil, errs.ErrArgs.Wrap("tencent receipt is invalid")
+	}
+	res := make([]*Receipt, 0, len(reports))
+	for _, r := range reports {
+		receipt := &Receipt{Provider: tencentName, MessageID: r.Sid, Status: constant.VerifyCodeStatusDelivered}
+		if !strings.EqualFold(r.ReportStatus, "SUCCESS") {
+			receipt.Status = constant.VerifyCodeStatusFailed
+			receipt.Desc = joinDesc(r.ErrMsg, r.Description)
+		}
+		res = append(res, receipt)
+	}
+	return res, nil
+}
+
+// parseWebhookReceipts 自定义服务的回执, 单个对象或数组 {"messageID": "", "status": "sent|delivered|failed", "desc": ""}
+func parseWebhookReceipts(body []byte) ([]*Receipt, error) {
+	type report struct {
+		MessageID string `json:"messageID"`
+		Status    string `json:"status"`
+		Desc      string `json:"desc"`
+	}
+	var reports []report
+	if err := json.Unmarshal(body, &reports); err != nil {
+		var r report
+		if err := json.Unmarshal(body, &r); err != nil {
+			return nil, errs.ErrArgs.Wrap("webhook receipt is invalid")
+		}
+		reports = []report{r}
+	}
+	res := make([]*Receipt, 0, len(reports))
+	for _, r := range reports {
+		receipt := &Receipt{Provider: webhookName, MessageID: r.MessageID, Desc: r.Desc}
+		switch strings.ToLower(r.Status) {
+		case "sent":
+			receipt.Status = constant.VerifyCodeStatusSent
+		case "delivered":
+			receipt.Status = constant.VerifyCodeStatusDelivered
+		case "failed":
+			receipt.Status = constant.VerifyCodeStatusFailed
+		default:
+			continue
+		}
+		res = append(res, receipt)
+	}
+	return res, nil
+}
+
+func joinDesc(code string, msg string) string {
+	switch {
+	case code == "":
+		return msg
+	case msg == "":
+		return code
+	default:
+		return code + ": " + msg
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+// counted 记录单个短信服务的发送成功与失败次数.
+type counted struct {
+	SMS
+	success int64
+	failure int64
+}
+
+// router 按区号选择短信服务, 发送失败时依次尝试下一个.
+type router struct {
+	routes      map[string][]*counted
+	defaultUses []*counted
+}
+
+func newRouter(routes []config.SMSRoute) (*router, error) {
+	providers := make(map[string]*counted)
+	load := func(uses []string) ([]*counted, error) {
+		if len(uses) == 0 {
+			return nil, errors.New("sms route use is empty")
+		}
+		res := make([]*counted, 0, len(uses))
+		for _, use := range uses {
+			use = strings.ToLower(use)
+			p, ok := providers[use]
+			if !ok {
+				s, err := newProvider(use)
+				if err != nil {
+					return nil, err
+				}
+				p = &counted{SMS: s}
+				providers[use] = p
+			}
+			res = append(res, p)
+		}
+		return res, nil
+	}
+	r := &router{routes: make(map[string][]*counted)}
+	for _, route := range routes {
+		uses, err := load(route.Use)
+		if err != nil {
+			return nil, err
+		}
+		if len(route.AreaCode) == 0 {
+			if r.defaultUses != nil {
+				return nil, errors.New("sms route default is duplicated")
+			}
+			r.defaultUses = uses
+			continue
+		}
+		for _, areaCode := range route.AreaCode {
+			if _, ok := r.routes[areaCode]; ok {
+				return nil, fmt.Errorf("sms route area code `%s` is duplicated", areaCode)
+			}
+			r.routes[areaCode] = uses
+		}
+	}
+	return r, nil
+}
+
+func (r *router) Name() string {
+	return "router-sms"
+}
+
+func (r *router) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	_, messageID, err := r.deliver(ctx, areaCode, phoneNumber, verifyCode, usedFor, language)
+	return messageID, err
+}
+
+func (r *router) deliver(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, string, error) {
+	uses, ok := r.routes[areaCode]
+	if !ok {
+		uses = r.defaultUses
+	}
+	if len(uses) == 0 {
+		return "", "", errs.ErrInternalServer.Wrap(fmt.Sprintf("no sms route for area code %s", areaCode))
+	}
+	var err error
+	for _, p := range uses {
+		var messageID string
+		if messageID, err = p.SendCode(ctx, areaCode, phoneNumber, verifyCode, usedFor, language); err == nil {
+			success := atomic.AddInt64(&p.success, 1)
+			log.ZDebug(ctx, "sms send success", "provider", p.Name(), "areaCode", areaCode, "success", success, "failure", atomic.LoadInt64(&p.failure))
+			return p.Name(), messageID, nil
+		}
+		failure := atomic.AddInt64(&p.failure, 1)
+		log.ZWarn(ctx, "sms send failed", err, "provider", p.Name(), "areaCode", areaCode, "success", atomic.LoadInt64(&p.success), "failure", failure)
+	}
+	return "", "", err
+}
@@ -21,20 +21,59 @@ import (
 	"strings"
 )
 
+const (
+	aliName     = "ali-sms"
+	twilioName  = "twilio-sms"
+	tencentName = "tencent-sms"
+	webhookName = "webhook-sms"
+)
+
 func New() (SMS, error) {
-	switch strings.ToLower(config.Config.VerifyCode.Use) {
+	if len(config.Config.VerifyCode.Routes) > 0 {
+		return newRouter(config.Config.VerifyCode.Routes)
+	}
+	return newProvider(config.Config.VerifyCode.Use)
+}
+
+func newProvider(use string) (SMS, error) {
+	switch strings.ToLower(use) {
 	case "":
 		return empty{}, nil
 	case "ali":
 		return newAli()
+	case "twilio":
+		return newTwilio()
+	case "tencent":
+		return newTencent()
+	case "webhook":
+		return newWebhook()
 	default:
-		return nil, fmt.Errorf("not support sms: `%s`", config.Config.VerifyCode.Use)
+		return nil, fmt.Errorf("not support sms: `%s`", use)
 	}
 }
 
+// Deliver 发送验证码并返回实际发送的服务名及消息ID
+func Deliver(ctx context.Context, s SMS, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, string, error) {
+	if r, ok := s.(*router); ok {
+		return r.deliver(ctx, areaCode, phoneNumber, verifyCode, usedFor, language)
+	}
+	messageID, err := s.SendCode(ctx, areaCode, phoneNumber, verifyCode, usedFor, language)
+	return s.Name(), messageID, err
+}
+
 type SMS interface {
 	Name() string
-	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error
+	// SendCode 返回服务商的消息ID, 用于匹配发送回执
+	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error)
+}
+
+// Enabled 未配置短信服务时返回false
+func Enabled(s SMS) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.(empty)
+	return !ok
 }
 
 type empty struct{}
@@ -43,6 +82,6 @@ func (e empty) Name() string {
 	return "empty-sms"
 }
 
-func (e empty) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
-	return nil
+func (e empty) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	return "", nil
 }
@@ -0,0 +1,185 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const (
+	tencentService = "sms"
+	tencentAction  = "SendSms"
+	tencentVersion = "2021-01-11"
+)
+
+func newTencent() (SMS, error) {
+	conf := config.Config.VerifyCode.Tencent
+	if conf.SecretId == "" || conf.SecretKey == "" {
+		return nil, errors.New("tencent secretId or secretKey is empty")
+	}
+	if conf.SdkAppId == "" || conf.TemplateId == "" {
+		return nil, errors.New("tencent sdkAppId or templateId is empty")
+	}
+	endpoint, err := parseEndpoint(conf.Endpoint, "https://sms.tencentcloudapi.com")
+	if err != nil {
+		return nil, err
+	}
+	region := conf.Region
+	if region == "" {
+		region = "ap-guangzhou"
+	}
+	return &tencent{
+		url:        endpoint.String(),
+		host:       endpoint.Host,
+		region:     region,
+		secretId:   conf.SecretId,
+		secretKey:  conf.SecretKey,
+		sdkAppId:   conf.SdkAppId,
+		signName:   conf.SignName,
+		templateId: conf.TemplateId,
+		templates:  conf.Templates,
+	}, nil
+}
+
+type tencent struct {
+	url        string
+	host       string
+	region     string
+	secretId   string
+	secretKey  string
+	sdkAppId   string
+	signName   string
+	templateId string
+	templates  config.VerifyCodeTemplates
+}
+
+func (t *tencent) Name() string {
+	return tencentName
+}
+
+func (t *tencent) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	signName, templateId := t.signName, t.templateId
+	if i := t.templates.Match(usedFor, language); i >= 0 {
+		if tpl := t.templates[i]; tpl.TemplateCode != "" {
+			templateId = tpl.TemplateCode
+			if tpl.SignName != "" {
+				signName = tpl.SignName
+			}
+		}
+	}
+	payload, err := json.Marshal(map[string]any{
+		"PhoneNumberSet":   []string{areaCode + phoneNumber},
+		"SmsSdkAppId":      t.sdkAppId,
+		"SignName":         signName,
+		"TemplateId":       templateId,
+		"TemplateParamSet": []string{verifyCode},
+	})
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(payload))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("X-TC-Action", tencentAction)
+	req.Header.Set("X-TC-Version", tencentVersion)
+	req.Header.Set("X-TC-Region", t.region)
+	req.Header.Set("X-TC-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("Authorization", t.authorization(payload, now))
+	data, err := doRequest(req)
+	if err != nil {
+		return "", err
+	}
+	var resp struct {
+		Response struct {
+			Error *struct {
+				Code    string `json:"Code"`
+				Message string `json:"Message"`
+			} `json:"Error"`
+			SendStatusSet []struct {
+				SerialNo string `json:"SerialNo"`
+				Code     string `json:"Code"`
+				Message  string `json:"Message"`
+			} `json:"SendStatusSet"`
+		} `json:"Response"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", errs.Wrap(err, "tencent sms response is invalid")
+	}
+	if e := resp.Response.Error; e != nil {
+		return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("tencent sms error %s: %s", e.Code, e.Message))
+	}
+	var serialNo string
+	for _, status := range resp.Response.SendStatusSet {
+		if !strings.EqualFold(status.Code, "Ok") {
+			return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("tencent sms send failed %s: %s", status.Code, status.Message))
+		}
+		serialNo = status.SerialNo
+	}
+	return serialNo, nil
+}
+
+// authorization TC3-HMAC-SHA256签名, 签名头为content-type和host
+func (t *tencent) authorization(payload []byte, now time.Time) string {
+	date := now.UTC().Format("2006-01-02")
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		"content-type:application/json; charset=utf-8\nhost:" + t.host + "\n",
+		"content-type;host",
+		sha256Hex(payload),
+	}, "\n")
+	scope := date + "/" + tencentService + "/tc3_request"
+	stringToSign := strings.Join([]string{
+		"TC3-HMAC-SHA256",
+		strconv.FormatInt(now.Unix(), 10),
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+	secretDate := hmacSHA256([]byte("TC3"+t.secretKey), date)
+	secretService := hmacSHA256(secretDate, tencentService)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+	return fmt.Sprintf("TC3-HMAC-SHA256 Credential=%s/%s, SignedHeaders=content-type;host, Signature=%s", t.secretId, scope, signature)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
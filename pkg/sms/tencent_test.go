@@ -0,0 +1,168 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func newTestTencent(t *testing.T, url string) SMS {
+	conf := &config.Config.VerifyCode.Tencent
+	conf.Endpoint = url
+	conf.Region = ""
+	conf.SecretId = "AKIDtest"
+	conf.SecretKey = "testkey"
+	conf.SdkAppId = "1400000000"
+	conf.SignName = "默认签名"
+	conf.TemplateId = "100"
+	conf.Templates = config.VerifyCodeTemplates{{Language: "en", SignName: "Sign", TemplateCode: "200"}}
+	s, err := newTencent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// tc3Signature 按腾讯云文档逐步计算签名, 与服务端实际收到的请求比对
+func tc3Signature(t *testing.T, r *http.Request, body []byte, secretKey string) (string, string) {
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-TC-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Unix(timestamp, 0).UTC().Format("2006-01-02")
+	hash := func(data string) string {
+		sum := sha256.Sum256([]byte(data))
+		return hex.EncodeToString(sum[:])
+	}
+	mac := func(key []byte, data string) []byte {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(data))
+		return h.Sum(nil)
+	}
+	canonicalHeaders := "content-type:" + r.Header.Get("Content-Type") + "\nhost:" + r.Host + "\n"
+	canonicalRequest := r.Method + "\n" + r.URL.Path + "\n" + r.URL.RawQuery + "\n" + canonicalHeaders + "\ncontent-type;host\n" + hash(string(body))
+	scope := date + "/sms/tc3_request"
+	stringToSign := "TC3-HMAC-SHA256\n" + strconv.FormatInt(timestamp, 10) + "\n" + scope + "\n" + hash(canonicalRequest)
+	key := mac(mac(mac([]byte("TC3"+secretKey), date), "sms"), "tc3_request")
+	return scope, hex.EncodeToString(mac(key, stringToSign))
+}
+
+func TestTencentSendCode(t *testing.T) {
+	var payload struct {
+		PhoneNumberSet   []string
+		SmsSdkAppId      string
+		SignName         string
+		TemplateId       string
+		TemplateParamSet []string
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		for k, v := range map[string]string{
+			"X-TC-Action":  "SendSms",
+			"X-TC-Version": "2021-01-11",
+			"X-TC-Region":  "ap-guangzhou",
+			"Content-Type": "application/json; charset=utf-8",
+		} {
+			if got := r.Header.Get(k); got != v {
+				t.Errorf("header %s = %q, expected %q", k, got, v)
+			}
+		}
+		scope, signature := tc3Signature(t, r, body, "testkey")
+		want := "TC3-HMAC-SHA256 Credential=AKIDtest/" + scope + ", SignedHeaders=content-type;host, Signature=" + signature
+		if got := r.Header.Get("Authorization"); got != want {
+			t.Errorf("authorization %q, expected %q", got, want)
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(`{"Response":{"SendStatusSet":[{"SerialNo":"2028:0001","PhoneNumber":"+8613800000000","Code":"Ok","Message":"send success"}],"RequestId":"r1"}}`))
+	}))
+	defer srv.Close()
+
+	s := newTestTencent(t, srv.URL)
+	serialNo, err := s.SendCode(context.Background(), "+86", "13800000000", "123456", 1, "zh-CN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serialNo != "2028:0001" {
+		t.Fatalf("serialNo %q", serialNo)
+	}
+	if len(payload.PhoneNumberSet) != 1 || payload.PhoneNumberSet[0] != "+8613800000000" {
+		t.Errorf("phone numbers %v", payload.PhoneNumberSet)
+	}
+	if payload.SmsSdkAppId != "1400000000" || payload.SignName != "默认签名" || payload.TemplateId != "100" {
+		t.Errorf("payload %+v", payload)
+	}
+	if len(payload.TemplateParamSet) != 1 || payload.TemplateParamSet[0] != "123456" {
+		t.Errorf("template params %v", payload.TemplateParamSet)
+	}
+
+	// 按语言匹配模板
+	if _, err := s.SendCode(context.Background(), "+1", "5550001111", "123456", 1, "en-US"); err != nil {
+		t.Fatal(err)
+	}
+	if payload.SignName != "Sign" || payload.TemplateId != "200" {
+		t.Errorf("template payload %+v", payload)
+	}
+}
+
+func TestTencentError(t *testing.T) {
+	cases := []struct {
+		name string
+		resp string
+		want string
+	}{
+		{
+			name: "api",
+			resp: `{"Response":{"Error":{"Code":"AuthFailure.SignatureFailure","Message":"signature mismatch"},"RequestId":"r1"}}`,
+			want: "AuthFailure.SignatureFailure",
+		},
+		{
+			name: "status",
+			resp: `{"Response":{"SendStatusSet":[{"SerialNo":"","Code":"LimitExceeded.PhoneNumberDailyLimit","Message":"daily limit"}],"RequestId":"r1"}}`,
+			want: "LimitExceeded.PhoneNumberDailyLimit",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(c.resp))
+			}))
+			defer srv.Close()
+
+			s := newTestTencent(t, srv.URL)
+			_, err := s.SendCode(context.Background(), "+86", "13800000000", "123456", 1, "")
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Fatalf("error %v, expected %q", err, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+const defaultTwilioBody = "Your verification code is {{.Code}}"
+
+func newTwilio() (SMS, error) {
+	conf := config.Config.VerifyCode.Twilio
+	if conf.AccountSid == "" || conf.AuthToken == "" {
+		return nil, errors.New("twilio accountSid or authToken is empty")
+	}
+	if conf.From == "" && conf.MessagingServiceSid == "" {
+		return nil, errors.New("twilio from or messagingServiceSid must be set")
+	}
+	endpoint, err := parseEndpoint(conf.Endpoint, "https://api.twilio.com")
+	if err != nil {
+		return nil, err
+	}
+	endpoint.Path = strings.TrimSuffix(endpoint.Path, "/") + "/2010-04-01/Accounts/" + url.PathEscape(conf.AccountSid) + "/Messages.json"
+	body := conf.Body
+	if body == "" {
+		body = defaultTwilioBody
+	}
+	t, err := parseTemplate("twilio body", body)
+	if err != nil {
+		return nil, err
+	}
+	bodies := make([]*template.Template, len(conf.Templates))
+	for i, tpl := range conf.Templates {
+		if tpl.Body == "" {
+			continue
+		}
+		if bodies[i], err = parseTemplate("twilio body", tpl.Body); err != nil {
+			return nil, err
+		}
+	}
+	return &twilio{
+		url:                 endpoint.String(),
+		accountSid:          conf.AccountSid,
+		authToken:           conf.AuthToken,
+		from:                conf.From,
+		messagingServiceSid: conf.MessagingServiceSid,
+		statusCallback:      conf.StatusCallback,
+		body:                t,
+		templates:           conf.Templates,
+		bodies:              bodies,
+	}, nil
+}
+
+type twilio struct {
+	url                 string
+	accountSid          string
+	authToken           string
+	from                string
+	messagingServiceSid string
+	statusCallback      string
+	body                *template.Template
+	templates           config.VerifyCodeTemplates
+	bodies              []*template.Template // 与templates一一对应, 未配置body时为nil
+}
+
+func (t *twilio) Name() string {
+	return twilioName
+}
+
+func (t *twilio) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	tpl := t.body
+	if i := t.templates.Match(usedFor, language); i >= 0 && t.bodies[i] != nil {
+		tpl = t.bodies[i]
+	}
+	body, err := executeTemplate(tpl, areaCode, phoneNumber, verifyCode, usedFor, language)
+	if err != nil {
+		return "", err
+	}
+	form := url.Values{}
+	form.Set("To", areaCode+phoneNumber)
+	form.Set("Body", body)
+	if t.messagingServiceSid != "" {
+		form.Set("MessagingServiceSid", t.messagingServiceSid)
+	} else {
+		form.Set("From", t.from)
+	}
+	if t.statusCallback != "" {
+		form.Set("StatusCallback", t.statusCallback)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.SetBasicAuth(t.accountSid, t.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	data, err := doRequest(req)
+	var resp struct {
+		Sid     string `json:"sid"`
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err != nil {
+		if json.Unmarshal(data, &resp) == nil && resp.Message != "" {
+			return "", errs.ErrInternalServer.Wrap(fmt.Sprintf("twilio error %d: %s", resp.Code, resp.Message))
+		}
+		return "", err
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", errs.Wrap(err, "twilio response is invalid")
+	}
+	return resp.Sid, nil
+}
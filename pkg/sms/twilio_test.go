@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func newTestTwilio(t *testing.T, url string) SMS {
+	conf := &config.Config.VerifyCode.Twilio
+	conf.Endpoint = url
+	conf.AccountSid = "AC123"
+	conf.AuthToken = "secret"
+	conf.From = "+15550001111"
+	conf.MessagingServiceSid = ""
+	conf.StatusCallback = "https://example.com/receipt"
+	conf.Body = "code {{.Code}}"
+	conf.Templates = config.VerifyCodeTemplates{{UsedFor: []int32{2}, Body: "reset {{.Code}} for {{.AreaCode}}{{.PhoneNumber}}"}}
+	s, err := newTwilio()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTwilioSendCode(t *testing.T) {
+	var form map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2010-04-01/Accounts/AC123/Messages.json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "AC123" || pass != "secret" {
+			t.Errorf("basic auth %q %q %v", user, pass, ok)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+		}
+		form = map[string]string{}
+		for k := range r.PostForm {
+			form[k] = r.PostForm.Get(k)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"sid":"SM0001","status":"queued"}`))
+	}))
+	defer srv.Close()
+
+	s := newTestTwilio(t, srv.URL)
+	sid, err := s.SendCode(context.Background(), "+86", "13800000000", "123456", 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sid != "SM0001" {
+		t.Fatalf("sid %q", sid)
+	}
+	want := map[string]string{
+		"To":             "+8613800000000",
+		"Body":           "code 123456",
+		"From":           "+15550001111",
+		"StatusCallback": "https://example.com/receipt",
+	}
+	for k, v := range want {
+		if form[k] != v {
+			t.Errorf("form %s = %q, expected %q", k, form[k], v)
+		}
+	}
+
+	// 按用途匹配模板
+	if _, err := s.SendCode(context.Background(), "+86", "13800000000", "654321", 2, ""); err != nil {
+		t.Fatal(err)
+	}
+	if body := form["Body"]; body != "reset 654321 for +8613800000000" {
+		t.Fatalf("template body %q", body)
+	}
+}
+
+func TestTwilioError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":21211,"message":"The 'To' number is not a valid phone number.","status":400}`))
+	}))
+	defer srv.Close()
+
+	s := newTestTwilio(t, srv.URL)
+	_, err := s.SendCode(context.Background(), "+86", "1", "123456", 1, "")
+	if err == nil || !strings.Contains(err.Error(), "twilio error 21211") {
+		t.Fatalf("error %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func newWebhook() (SMS, error) {
+	conf := config.Config.VerifyCode.Webhook
+	if conf.Url == "" {
+		return nil, errors.New("webhook url is empty")
+	}
+	endpoint, err := parseEndpoint(conf.Url, "")
+	if err != nil {
+		return nil, err
+	}
+	method := strings.ToUpper(conf.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	contentType := conf.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	t, err := parseTemplate("webhook body", conf.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &webhook{
+		url:         endpoint.String(),
+		method:      method,
+		contentType: contentType,
+		headers:     conf.Headers,
+		body:        t,
+	}, nil
+}
+
+// webhook 按模板生成请求体调用自定义http接口, 2xx状态码视为发送成功.
+type webhook struct {
+	url         string
+	method      string
+	contentType string
+	headers     map[string]string
+	body        *template.Template
+}
+
+func (w *webhook) Name() string {
+	return webhookName
+}
+
+func (w *webhook) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string, usedFor int32, language string) (string, error) {
+	body, err := executeTemplate(w.body, areaCode, phoneNumber, verifyCode, usedFor, language)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, w.method, w.url, strings.NewReader(body))
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", w.contentType)
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	data, err := doRequest(req)
+	if err != nil {
+		return "", err
+	}
+	// 响应为JSON且包含messageID时用于匹配发送回执
+	var resp struct {
+		MessageID string `json:"messageID"`
+	}
+	_ = json.Unmarshal(data, &resp)
+	return resp.MessageID, nil
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OpenIMSDK/chat/pkg/common/config"
+)
+
+func newTestWebhook(t *testing.T, url string) SMS {
+	conf := &config.Config.VerifyCode.Webhook
+	conf.Url = url + "/sms/send"
+	conf.Method = "put"
+	conf.ContentType = ""
+	conf.Headers = map[string]string{"X-Api-Key": "key"}
+	conf.Body = `{"to":{{json (print .AreaCode .PhoneNumber)}},"code":{{json .Code}},"usedFor":{{.UsedFor}},"lang":{{json .Language}}}`
+	s, err := newWebhook()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestWebhookSendCode(t *testing.T) {
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/sms/send" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Content-Type"); got != "application/json" {
+			t.Errorf("content type %q", got)
+		}
+		if got := r.Header.Get("X-Api-Key"); got != "key" {
+			t.Errorf("api key %q", got)
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("body %s is not json: %v", data, err)
+		}
+		_, _ = w.Write([]byte(`{"messageID":"m-1"}`))
+	}))
+	defer srv.Close()
+
+	s := newTestWebhook(t, srv.URL)
+	messageID, err := s.SendCode(context.Background(), "+86", "13800000000", `12"34`, 3, "zh-CN")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messageID != "m-1" {
+		t.Fatalf("messageID %q", messageID)
+	}
+	want := map[string]any{"to": "+8613800000000", "code": `12"34`, "usedFor": float64(3), "lang": "zh-CN"}
+	for k, v := range want {
+		if body[k] != v {
+			t.Errorf("body %s = %v, expected %v", k, body[k], v)
+		}
+	}
+}
+
+func TestWebhookResponse(t *testing.T) {
+	cases := []struct {
+		name      string
+		status    int
+		resp      string
+		messageID string
+		wantErr   string
+	}{
+		{name: "plain", status: http.StatusOK, resp: "ok"},
+		{name: "error", status: http.StatusBadGateway, resp: "upstream down", wantErr: "sms http status 502"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(c.status)
+				_, _ = w.Write([]byte(c.resp))
+			}))
+			defer srv.Close()
+
+			s := newTestWebhook(t, srv.URL)
+			messageID, err := s.SendCode(context.Background(), "+86", "13800000000", "123456", 1, "")
+			if c.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+					t.Fatalf("error %v, expected %q", err, c.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if messageID != c.messageID {
+				t.Fatalf("messageID %q", messageID)
+			}
+		})
+	}
+}